@@ -1,18 +1,25 @@
 package client
 
 import (
+	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gorilla/schema"
+	"github.com/gorilla/websocket"
 	"github.com/porter-dev/porter/api/types"
 	"k8s.io/client-go/util/homedir"
 )
@@ -72,127 +79,296 @@ func NewClientWithToken(baseURL, token string) *Client {
 	return client
 }
 
-func (c *Client) getRequest(relPath string, data interface{}, response interface{}) error {
+// the number of times a retryable request is retried, and the bounds of the delay between
+// attempts
+const (
+	maxRetries      = 4
+	retryBaseDelay  = 500 * time.Millisecond
+	retryMaxDelay   = 15 * time.Second
+	retryAfterLimit = 2 * time.Minute
+)
+
+// retryableStatusCodes are the status codes of responses which are retried, as they are
+// returned by the API and the proxies in front of it when it is temporarily unavailable
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+func (c *Client) getRequest(ctx context.Context, relPath string, data interface{}, response interface{}) error {
 	vals := make(map[string][]string)
-	err := schema.NewEncoder().Encode(data, vals)
 
-	urlVals := url.Values(vals)
-	encodedURLVals := urlVals.Encode()
-	var req *http.Request
+	// fields which cannot be encoded are left out of the query
+	schema.NewEncoder().Encode(data, vals)
 
-	if encodedURLVals != "" {
-		req, err = http.NewRequest(
-			"GET",
-			fmt.Sprintf("%s%s?%s", c.BaseURL, relPath, encodedURLVals),
-			nil,
-		)
-	} else {
-		req, err = http.NewRequest(
-			"GET",
-			fmt.Sprintf("%s%s", c.BaseURL, relPath),
-			nil,
-		)
+	if encodedURLVals := url.Values(vals).Encode(); encodedURLVals != "" {
+		relPath = fmt.Sprintf("%s?%s", relPath, encodedURLVals)
 	}
 
-	if err != nil {
-		return err
+	return c.doRequest(ctx, "GET", relPath, nil, response, true)
+}
+
+type postRequestOpts struct {
+	// retryable marks a POST request as safe to retry, since POST requests are not
+	// retried by default
+	retryable bool
+}
+
+func (c *Client) postRequest(ctx context.Context, relPath string, data interface{}, response interface{}, opts ...postRequestOpts) error {
+	retryable := false
+
+	for _, opt := range opts {
+		retryable = opt.retryable
 	}
 
-	if httpErr, err := c.sendRequest(req, response, true); httpErr != nil || err != nil {
-		if httpErr != nil {
-			return fmt.Errorf("%v", httpErr.Error)
-		}
+	strData, err := json.Marshal(data)
 
+	if err != nil {
 		return err
 	}
 
-	return nil
-}
-
-type postRequestOpts struct {
-	retryCount uint
+	return c.doRequest(ctx, "POST", relPath, strData, response, retryable)
 }
 
-func (c *Client) postRequest(relPath string, data interface{}, response interface{}, opts ...postRequestOpts) error {
-	var retryCount uint = 1
+func (c *Client) deleteRequest(ctx context.Context, relPath string, data interface{}, response interface{}) error {
+	strData, err := json.Marshal(data)
 
-	if len(opts) > 0 {
-		for _, opt := range opts {
-			retryCount = opt.retryCount
-		}
+	if err != nil {
+		return err
 	}
 
-	var httpErr *types.ExternalError
-	var err error
-
-	for i := 0; i < int(retryCount); i++ {
-		strData, err := json.Marshal(data)
+	return c.doRequest(ctx, "DELETE", relPath, strData, response, true)
+}
 
-		if err != nil {
-			return nil
+// doRequest sends a request to the API. If retryable is set, requests which fail with a
+// network error or a retryable status code are retried with jittered exponential backoff,
+// or after the delay requested by the Retry-After header of the response.
+func (c *Client) doRequest(
+	ctx context.Context,
+	method, relPath string,
+	body []byte,
+	response interface{},
+	retryable bool,
+) error {
+	for attempt := 0; ; attempt++ {
+		var bodyReader io.Reader
+
+		if body != nil {
+			bodyReader = bytes.NewReader(body)
 		}
 
-		req, err := http.NewRequest(
-			"POST",
+		req, err := http.NewRequestWithContext(
+			ctx,
+			method,
 			fmt.Sprintf("%s%s", c.BaseURL, relPath),
-			strings.NewReader(string(strData)),
+			bodyReader,
 		)
 
 		if err != nil {
 			return err
 		}
 
-		httpErr, err = c.sendRequest(req, response, true)
+		err = c.sendRequest(req, response, true)
 
-		if httpErr == nil && err == nil {
-			return nil
+		if err == nil || !retryable || attempt >= maxRetries || !isRetryableError(ctx, err) {
+			return err
 		}
 
-		if i != int(retryCount)-1 {
-			if httpErr != nil {
-				fmt.Printf("Error: %s (status code %d), retrying request...\n", httpErr.Error, httpErr.Code)
-			} else {
-				fmt.Printf("Error: %v, retrying request...\n", err)
-			}
+		delay := getRetryDelay(attempt, err)
+
+		fmt.Fprintf(os.Stderr, "Error: %v, retrying request in %s...\n", err, delay.Round(time.Millisecond))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
 		}
 	}
+}
 
-	if httpErr != nil {
-		return fmt.Errorf("%v", httpErr.Error)
+func isRetryableError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
 	}
 
-	return err
+	var reqErr *RequestError
+
+	if errors.As(err, &reqErr) {
+		return retryableStatusCodes[reqErr.StatusCode]
+	}
+
+	// errors which occur while sending the request, such as connection resets and timeouts,
+	// are returned by the HTTP client as url errors
+	var urlErr *url.Error
+
+	return errors.As(err, &urlErr)
 }
 
-func (c *Client) deleteRequest(relPath string, data interface{}, response interface{}) error {
-	strData, err := json.Marshal(data)
+// getRetryDelay returns the delay before the next attempt of a request. The delay doubles
+// on every attempt, and is jittered so that clients which failed at the same time don't
+// retry at the same time.
+func getRetryDelay(attempt int, err error) time.Duration {
+	var reqErr *RequestError
 
-	if err != nil {
-		return nil
+	if errors.As(err, &reqErr) && reqErr.RetryAfter > 0 {
+		if reqErr.RetryAfter > retryAfterLimit {
+			return retryAfterLimit
+		}
+
+		return reqErr.RetryAfter
 	}
 
-	req, err := http.NewRequest(
-		"DELETE",
+	delay := retryBaseDelay << attempt
+
+	if delay <= 0 || delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of
+// seconds or an HTTP date
+func parseRetryAfter(header string, now time.Time) time.Duration {
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(header); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+
+	return 0
+}
+
+// uploadRequest streams a request body to the API, without the timeout of the HTTP client, since
+// uploads can be arbitrarily large
+func (c *Client) uploadRequest(ctx context.Context, method, relPath string, body io.Reader, response interface{}) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		method,
 		fmt.Sprintf("%s%s", c.BaseURL, relPath),
-		strings.NewReader(string(strData)),
+		body,
 	)
 
 	if err != nil {
 		return err
 	}
 
-	if httpErr, err := c.sendRequest(req, response, true); httpErr != nil || err != nil {
-		if httpErr != nil {
-			return fmt.Errorf("%v", httpErr.Error)
+	uploadClient := *c
+	uploadClient.HTTPClient = &http.Client{}
+
+	return uploadClient.sendRequest(req, response, true)
+}
+
+// streamRequest opens a websocket to the API and calls onMessage with every message sent by
+// the server, until the server closes the websocket or the context is cancelled
+func (c *Client) streamRequest(ctx context.Context, relPath string, data interface{}, onMessage func(msg []byte) error) error {
+	vals := make(map[string][]string)
+
+	if data != nil {
+		if err := schema.NewEncoder().Encode(data, vals); err != nil {
+			return err
 		}
+	}
 
+	wsURL, err := url.Parse(fmt.Sprintf("%s%s", c.BaseURL, relPath))
+
+	if err != nil {
 		return err
 	}
 
-	return nil
+	wsURL.RawQuery = url.Values(vals).Encode()
+
+	// the server only accepts websockets which originate from the dashboard
+	origin := fmt.Sprintf("%s://%s", wsURL.Scheme, wsURL.Host)
+
+	if wsURL.Scheme == "https" {
+		wsURL.Scheme = "wss"
+	} else {
+		wsURL.Scheme = "ws"
+	}
+
+	header := http.Header{}
+	header.Set("Origin", origin)
+
+	if c.Token != "" {
+		header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	} else if cookie, _ := c.getCookie(); cookie != nil {
+		header.Set("Cookie", cookie.String())
+	}
+
+	if c.cfToken != "" {
+		header.Set("cf-access-token", c.cfToken)
+	}
+
+	conn, res, err := websocket.DefaultDialer.DialContext(ctx, wsURL.String(), header)
+
+	if err != nil {
+		if res != nil {
+			defer res.Body.Close()
+
+			var errRes types.ExternalError
+
+			if decodeErr := json.NewDecoder(res.Body).Decode(&errRes); decodeErr == nil {
+				return &RequestError{
+					ExternalError: &errRes,
+					StatusCode:    res.StatusCode,
+				}
+			}
+		}
+
+		return err
+	}
+
+	defer conn.Close()
+
+	// close the websocket when the context is cancelled, which unblocks ReadMessage
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	for {
+		_, msg, err := conn.ReadMessage()
+
+		if err != nil {
+			if ctx.Err() != nil || websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				return nil
+			}
+
+			// the server closes the underlying connection once it has finished streaming
+			if _, ok := err.(*websocket.CloseError); ok || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		if err := onMessage(msg); err != nil {
+			return err
+		}
+	}
 }
 
-func (c *Client) sendRequest(req *http.Request, v interface{}, useCookie bool) (*types.ExternalError, error) {
+func (c *Client) sendRequest(req *http.Request, v interface{}, useCookie bool) error {
 	req.Header.Set("Content-Type", "application/json; charset=utf-8")
 	req.Header.Set("Accept", "application/json; charset=utf-8")
 
@@ -210,7 +386,7 @@ func (c *Client) sendRequest(req *http.Request, v interface{}, useCookie bool) (
 	res, err := c.HTTPClient.Do(req)
 
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	defer res.Body.Close()
@@ -220,21 +396,31 @@ func (c *Client) sendRequest(req *http.Request, v interface{}, useCookie bool) (
 	}
 
 	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		reqErr := &RequestError{
+			StatusCode: res.StatusCode,
+			RetryAfter: parseRetryAfter(res.Header.Get("Retry-After"), time.Now()),
+		}
+
 		var errRes types.ExternalError
+
 		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil {
-			return &errRes, nil
+			reqErr.ExternalError = &errRes
+		} else {
+			reqErr.ExternalError = &types.ExternalError{
+				Error: fmt.Sprintf("unknown error, status code: %d", res.StatusCode),
+			}
 		}
 
-		return nil, fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+		return reqErr
 	}
 
 	if v != nil {
 		if err = json.NewDecoder(res.Body).Decode(v); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	return nil, nil
+	return nil
 }
 
 // CookieStorage for temporary fs-based cookie storage before jwt tokens
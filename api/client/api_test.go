@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRequestRetries(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		w.Write([]byte(`{"id":1}`))
+	}))
+
+	defer server.Close()
+
+	client := NewClientWithToken(server.URL, "token")
+
+	resp := &struct {
+		ID uint `json:"id"`
+	}{}
+
+	if err := client.getRequest(context.Background(), "/", nil, resp); err != nil {
+		t.Fatalf("expected GET to succeed after retrying, got %v", err)
+	}
+
+	if attempts != 2 || resp.ID != 1 {
+		t.Fatalf("expected 2 attempts and id 1, got %d attempts and id %d", attempts, resp.ID)
+	}
+
+	// POST requests are not retried unless they are marked as retryable
+	attempts = 0
+
+	err := client.postRequest(context.Background(), "/", nil, nil)
+
+	if attempts != 1 || err == nil {
+		t.Fatalf("expected POST to fail after 1 attempt, got %d attempts", attempts)
+	}
+
+	var reqErr *RequestError
+
+	if !errors.As(err, &reqErr) || reqErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("expected request error with status 502, got %v", err)
+	}
+}
+
+func TestRequestErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"deployment not found"}`))
+	}))
+
+	defer server.Close()
+
+	client := NewClientWithToken(server.URL, "token")
+
+	err := client.getRequest(context.Background(), "/", nil, nil)
+
+	if !errors.Is(err, ErrNotFound) || errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	if err.Error() != "deployment not found" {
+		t.Errorf("expected error message of the response, got %q", err.Error())
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]time.Duration{
+		"":                              0,
+		"120":                           2 * time.Minute,
+		"-1":                            0,
+		"Sat, 01 Jan 2022 00:00:30 GMT": 30 * time.Second,
+		"Fri, 31 Dec 2021 23:59:00 GMT": 0,
+		"invalid":                       0,
+	}
+
+	for header, expected := range tests {
+		if delay := parseRetryAfter(header, now); delay != expected {
+			t.Errorf("parseRetryAfter(%q): expected %s, got %s", header, expected, delay)
+		}
+	}
+}
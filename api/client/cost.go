@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// GetClusterCost gets the cost of a cluster over a reporting window, attributed
+// to namespaces or releases
+func (c *Client) GetClusterCost(
+	ctx context.Context,
+	projectID, clusterID uint,
+	req *types.GetCostAllocationRequest,
+) (*types.GetCostAllocationResponse, error) {
+	resp := &types.GetCostAllocationResponse{}
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/cost",
+			projectID, clusterID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// GetProjectCost gets the cost of every cluster in a project over a reporting window
+func (c *Client) GetProjectCost(
+	ctx context.Context,
+	projectID uint,
+	req *types.GetProjectCostRequest,
+) (*types.GetCostAllocationResponse, error) {
+	resp := &types.GetCostAllocationResponse{}
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/cost",
+			projectID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// GetClusterCostConfig gets the node prices used to compute the cost of a cluster
+func (c *Client) GetClusterCostConfig(
+	ctx context.Context,
+	projectID, clusterID uint,
+) (*types.GetClusterCostConfigResponse, error) {
+	resp := &types.GetClusterCostConfigResponse{}
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/cost/config",
+			projectID, clusterID,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// UpdateClusterCostConfig replaces the node prices used to compute the cost of a cluster
+func (c *Client) UpdateClusterCostConfig(
+	ctx context.Context,
+	projectID, clusterID uint,
+	req *types.UpdateClusterCostConfigRequest,
+) (*types.GetClusterCostConfigResponse, error) {
+	resp := &types.GetClusterCostConfigResponse{}
+
+	err := c.postRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/cost/config",
+			projectID, clusterID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// ListCustomMetrics lists the custom metrics saved for a release
+func (c *Client) ListCustomMetrics(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+) (types.ListCustomMetricsResponse, error) {
+	resp := make(types.ListCustomMetricsResponse, 0)
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/metrics/custom",
+			projectID, clusterID,
+			namespace, name,
+		),
+		nil,
+		&resp,
+	)
+
+	return resp, err
+}
+
+// CreateCustomMetric saves a custom metric for a release, replacing the custom metric with the
+// same name if it exists
+func (c *Client) CreateCustomMetric(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	req *types.CreateCustomMetricRequest,
+) (*types.CustomMetric, error) {
+	resp := &types.CustomMetric{}
+
+	err := c.postRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/metrics/custom",
+			projectID, clusterID,
+			namespace, name,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// DeleteCustomMetric deletes a custom metric of a release
+func (c *Client) DeleteCustomMetric(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	req *types.DeleteCustomMetricRequest,
+) error {
+	return c.deleteRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/metrics/custom",
+			projectID, clusterID,
+			namespace, name,
+		),
+		req,
+		nil,
+	)
+}
+
+// QueryCustomMetric runs a custom metric of a release over a time range
+func (c *Client) QueryCustomMetric(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	req *types.QueryCustomMetricRequest,
+) (*types.QueryCustomMetricResponse, error) {
+	resp := &types.QueryCustomMetricResponse{}
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/metrics/custom/query",
+			projectID, clusterID,
+			namespace, name,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// ListReleaseMetricNames lists the names of the metrics available for the pods of a release
+func (c *Client) ListReleaseMetricNames(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	req *types.ListReleaseMetricNamesRequest,
+) (types.ListReleaseMetricNamesResponse, error) {
+	resp := make(types.ListReleaseMetricNamesResponse, 0)
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/metrics/names",
+			projectID, clusterID,
+			namespace, name,
+		),
+		req,
+		&resp,
+	)
+
+	return resp, err
+}
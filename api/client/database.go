@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// ListDatabases lists the databases of a cluster
+func (c *Client) ListDatabases(
+	ctx context.Context,
+	projectID, clusterID uint,
+) (types.ListDatabaseResponse, error) {
+	resp := make(types.ListDatabaseResponse, 0)
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/databases",
+			projectID, clusterID,
+		),
+		nil,
+		&resp,
+	)
+
+	return resp, err
+}
+
+// ListDatabaseSnapshots lists the snapshots of a database
+func (c *Client) ListDatabaseSnapshots(
+	ctx context.Context,
+	projectID, clusterID, databaseID uint,
+) (types.ListDatabaseSnapshotsResponse, error) {
+	resp := make(types.ListDatabaseSnapshotsResponse, 0)
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/databases/%d/snapshots",
+			projectID, clusterID, databaseID,
+		),
+		nil,
+		&resp,
+	)
+
+	return resp, err
+}
+
+// CreateDatabaseSnapshot starts an operation which creates a snapshot of a database
+func (c *Client) CreateDatabaseSnapshot(
+	ctx context.Context,
+	projectID, clusterID, databaseID uint,
+	req *types.CreateDatabaseSnapshotRequest,
+) (*types.CreateDatabaseSnapshotResponse, error) {
+	resp := &types.CreateDatabaseSnapshotResponse{}
+
+	err := c.postRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/databases/%d/snapshots",
+			projectID, clusterID, databaseID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// RestoreDatabaseSnapshot starts an operation which restores a database from a snapshot
+func (c *Client) RestoreDatabaseSnapshot(
+	ctx context.Context,
+	projectID, clusterID, databaseID, snapshotID uint,
+) (*types.Operation, error) {
+	resp := &types.Operation{}
+
+	err := c.postRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/databases/%d/snapshots/%d/restore",
+			projectID, clusterID, databaseID, snapshotID,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
@@ -16,6 +16,7 @@ func (c *Client) GetReleaseWebhook(
 	resp := &types.PorterRelease{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/webhook",
 			projID,
@@ -37,6 +38,7 @@ func (c *Client) DeployWithWebhook(
 	req *types.WebhookRequest,
 ) error {
 	return c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/webhooks/deploy/%s",
 			webhook,
@@ -55,6 +57,7 @@ func (c *Client) UpdateBatchImage(
 	req *types.UpdateImageBatchRequest,
 ) error {
 	return c.postRequest(
+		ctx,
 		fmt.Sprintf("/projects/%d/clusters/%d/namespaces/%s/releases/image/batch", projID, clusterID, namespace),
 		req,
 		nil,
@@ -68,6 +71,7 @@ func (c *Client) DeployTemplate(
 	req *types.CreateReleaseRequest,
 ) error {
 	return c.postRequest(
+		ctx,
 		fmt.Sprintf("/projects/%d/clusters/%d/namespaces/%s/releases", projID, clusterID, namespace),
 		req,
 		nil,
@@ -81,6 +85,7 @@ func (c *Client) DeployAddon(
 	req *types.CreateAddonRequest,
 ) error {
 	return c.postRequest(
+		ctx,
 		fmt.Sprintf("/projects/%d/clusters/%d/namespaces/%s/addons", projID, clusterID, namespace),
 		req,
 		nil,
@@ -95,6 +100,7 @@ func (c *Client) UpgradeRelease(
 	req *types.UpgradeReleaseRequest,
 ) error {
 	return c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/upgrade",
 			projID, clusterID,
@@ -103,7 +109,7 @@ func (c *Client) UpgradeRelease(
 		req,
 		nil,
 		postRequestOpts{
-			retryCount: 3,
+			retryable: true,
 		},
 	)
 }
@@ -115,6 +121,7 @@ func (c *Client) DeleteRelease(
 	namespace, name string,
 ) error {
 	return c.deleteRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0",
 			projID, clusterID,
@@ -16,6 +16,7 @@ func (c *Client) CreateDNSRecord(
 	resp := &types.DNSRecord{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/subdomain",
 			projID, clusterID,
@@ -16,6 +16,7 @@ func (c *Client) CreateDeployment(
 	resp := &types.Deployment{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/gitrepos/%d/%s/%s/clusters/%d/deployment",
 			projID, gitInstallationID, gitRepoOwner, gitRepoName, clusterID,
@@ -36,6 +37,7 @@ func (c *Client) GetDeployment(
 	resp := &types.Deployment{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/gitrepos/%d/%s/%s/clusters/%d/deployment",
 			projID, gitInstallationID, gitRepoOwner, gitRepoName, clusterID,
@@ -56,6 +58,7 @@ func (c *Client) UpdateDeployment(
 	resp := &types.Deployment{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/gitrepos/%d/%s/%s/clusters/%d/deployment/update",
 			projID, gitInstallationID, gitRepoOwner, gitRepoName, clusterID,
@@ -76,6 +79,7 @@ func (c *Client) UpdateDeploymentStatus(
 	resp := &types.Deployment{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/gitrepos/%d/%s/%s/clusters/%d/deployment/update/status",
 			projID, gitInstallationID, gitRepoOwner, gitRepoName, clusterID,
@@ -96,6 +100,7 @@ func (c *Client) FinalizeDeployment(
 	resp := &types.Deployment{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/gitrepos/%d/%s/%s/clusters/%d/deployment/finalize",
 			projID, gitInstallationID, gitRepoOwner, gitRepoName, clusterID,
@@ -112,6 +117,7 @@ func (c *Client) DeleteDeployment(
 	projID, clusterID, deploymentID uint,
 ) error {
 	return c.deleteRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d/deployments/%d",
 			projID, clusterID, deploymentID,
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// Errors which a RequestError matches with errors.Is, depending on its status code, for
+// example errors.Is(err, client.ErrNotFound)
+var (
+	ErrForbidden = errors.New("forbidden")
+	ErrNotFound  = errors.New("not found")
+	ErrConflict  = errors.New("conflict")
+)
+
+// RequestError is returned when the API responds to a request with an error status code
+type RequestError struct {
+	*types.ExternalError
+
+	StatusCode int
+
+	// RetryAfter is the delay requested by the Retry-After header of the response, if set
+	RetryAfter time.Duration
+}
+
+func (e *RequestError) Error() string {
+	return e.ExternalError.Error
+}
+
+func (e *RequestError) Is(target error) bool {
+	switch target {
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	}
+
+	return false
+}
@@ -15,6 +15,7 @@ func (c *Client) CreateEvent(
 	req *types.UpdateReleaseStepsRequest,
 ) error {
 	return c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/steps",
 			projID, clusterID,
@@ -24,3 +25,25 @@ func (c *Client) CreateEvent(
 		nil,
 	)
 }
+
+// GetReleaseSteps returns the build and deploy steps recorded for a release
+func (c *Client) GetReleaseSteps(
+	ctx context.Context,
+	projID, clusterID uint,
+	namespace, name string,
+) (*types.GetReleaseStepsResponse, error) {
+	resp := &types.GetReleaseStepsResponse{}
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/steps",
+			projID, clusterID,
+			namespace, name,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
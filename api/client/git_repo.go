@@ -15,6 +15,7 @@ func (c *Client) ListGitInstallationIDs(
 	resp := &types.ListGitInstallationIDsResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/gitrepos",
 			projID,
@@ -35,6 +36,7 @@ func (c *Client) ListGitRepos(
 	resp := &types.ListReposResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/gitrepos/%d/repos",
 			projID,
@@ -56,6 +58,7 @@ func (c *Client) GetRepoZIPDownloadURL(
 	resp := &types.GetTarballURLResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/gitrepos/%d/repos/%s/%s/%s/%s/tarball_url",
 			projID, gitInstallationID,
@@ -16,6 +16,7 @@ func (c *Client) CreateAWSIntegration(
 	resp := &types.CreateAWSResponse{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/integrations/aws",
 			projectID,
@@ -36,6 +37,7 @@ func (c *Client) CreateGCPIntegration(
 	resp := &types.CreateGCPResponse{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/integrations/gcp",
 			projectID,
@@ -56,6 +58,7 @@ func (c *Client) CreateBasicAuthIntegration(
 	resp := &types.CreateBasicResponse{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/integrations/basic",
 			projectID,
@@ -75,6 +78,7 @@ func (c *Client) ListOAuthIntegrations(
 	resp := &types.ListOAuthResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/integrations/oauth",
 			projectID,
@@ -85,3 +89,44 @@ func (c *Client) ListOAuthIntegrations(
 
 	return resp, err
 }
+
+// ListGitlabIntegrations lists the GitLab integrations in a project
+func (c *Client) ListGitlabIntegrations(
+	ctx context.Context,
+	projectID uint,
+) (*types.ListGitlabIntegrationsResponse, error) {
+	resp := &types.ListGitlabIntegrationsResponse{}
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/integrations/gitlab",
+			projectID,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// CreateGitlabCI writes a GitLab CI pipeline which deploys a release on every push to a branch
+func (c *Client) CreateGitlabCI(
+	ctx context.Context,
+	projectID, gitlabIntegrationID uint,
+	req *types.CreateGitlabCIRequest,
+) (*types.CreateGitlabCIResponse, error) {
+	resp := &types.CreateGitlabCIResponse{}
+
+	err := c.postRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/integrations/gitlab/%d/ci",
+			projectID, gitlabIntegrationID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
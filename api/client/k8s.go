@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/porter-dev/porter/api/types"
@@ -17,6 +18,7 @@ func (c *Client) GetK8sNamespaces(
 	resp := &types.ListNamespacesResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d/namespaces",
 			projectID, clusterID,
@@ -38,6 +40,7 @@ func (c *Client) CreateNewK8sNamespace(
 	resp := &types.CreateNamespaceResponse{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d/namespaces/create",
 			projectID, clusterID,
@@ -59,6 +62,7 @@ func (c *Client) GetKubeconfig(
 	resp := &types.GetTemporaryKubeconfigResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d/kubeconfig",
 			projectID, clusterID,
@@ -79,6 +83,7 @@ func (c *Client) GetEnvGroup(
 	resp := &types.EnvGroup{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d/namespaces/%s/envgroup",
 			projectID, clusterID,
@@ -100,6 +105,7 @@ func (c *Client) CreateEnvGroup(
 	resp := &types.EnvGroup{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d/namespaces/%s/envgroup/create",
 			projectID, clusterID,
@@ -121,6 +127,7 @@ func (c *Client) CloneEnvGroup(
 	resp := &types.EnvGroup{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d/namespaces/%s/envgroup/clone",
 			projectID, clusterID,
@@ -141,6 +148,7 @@ func (c *Client) GetRelease(
 	resp := &types.GetReleaseResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0",
 			projectID, clusterID,
@@ -163,6 +171,7 @@ func (c *Client) GetJobs(
 	resp := &respArr
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/jobs",
 			projectID, clusterID,
@@ -184,6 +193,7 @@ func (c *Client) GetK8sAllPods(
 	resp := &types.GetReleaseAllPodsResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/pods/all",
 			projectID, clusterID,
@@ -195,3 +205,309 @@ func (c *Client) GetK8sAllPods(
 
 	return resp, err
 }
+
+// StreamReleaseLogs streams the logs of every pod of a release, and calls onLine with every
+// line until the stream ends or the context is cancelled
+func (c *Client) StreamReleaseLogs(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	req *types.StreamReleaseLogsRequest,
+	onLine func(line *types.ReleaseLogLine) error,
+) error {
+	return c.streamRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/logs/stream",
+			projectID, clusterID,
+			namespace, name,
+		),
+		req,
+		func(msg []byte) error {
+			line := &types.ReleaseLogLine{}
+
+			if err := json.Unmarshal(msg, line); err != nil {
+				return err
+			}
+
+			return onLine(line)
+		},
+	)
+}
+
+// SearchReleaseLogs searches the historical logs of a release
+func (c *Client) SearchReleaseLogs(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	req *types.SearchLogsRequest,
+) (*types.SearchLogsResponse, error) {
+	resp := &types.SearchLogsResponse{}
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/logs/search",
+			projectID, clusterID,
+			namespace, name,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// SearchNamespaceLogs searches the historical logs of every pod in a namespace
+func (c *Client) SearchNamespaceLogs(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace string,
+	req *types.SearchLogsRequest,
+) (*types.SearchLogsResponse, error) {
+	resp := &types.SearchLogsResponse{}
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/logs/search",
+			projectID, clusterID,
+			namespace,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// GetReleaseTopology gets the live resource graph for a given release
+func (c *Client) GetReleaseTopology(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+) (*types.GetReleaseTopologyResponse, error) {
+	resp := &types.GetReleaseTopologyResponse{}
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/topology",
+			projectID, clusterID,
+			namespace, name,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// GetReleaseDrift compares the manifest of a given release with the live objects in the cluster
+func (c *Client) GetReleaseDrift(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+) (*types.GetReleaseDriftResponse, error) {
+	resp := &types.GetReleaseDriftResponse{}
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/drift",
+			projectID, clusterID,
+			namespace, name,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// CreateBuildAttestation records the digest and supply chain artifacts of an image built
+// for a release
+func (c *Client) CreateBuildAttestation(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	req *types.CreateBuildAttestationRequest,
+) (*types.CreateBuildAttestationResponse, error) {
+	resp := &types.CreateBuildAttestationResponse{}
+
+	err := c.postRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/attestations",
+			projectID, clusterID,
+			namespace, name,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// ListBuildAttestations lists the build attestations for a release. If revision is non-zero,
+// only the attestations of the image deployed by that revision are returned.
+func (c *Client) ListBuildAttestations(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	revision uint,
+) (*types.ListBuildAttestationsResponse, error) {
+	resp := &types.ListBuildAttestationsResponse{}
+
+	path := fmt.Sprintf(
+		"/projects/%d/clusters/%d/namespaces/%s/releases/%s/attestations",
+		projectID, clusterID,
+		namespace, name,
+	)
+
+	if revision != 0 {
+		path = fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/%d/attestations",
+			projectID, clusterID,
+			namespace, name, revision,
+		)
+	}
+
+	err := c.getRequest(ctx, path, nil, resp)
+
+	return resp, err
+}
+
+func (c *Client) GetNamespacePolicy(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace string,
+) (*types.GetNamespacePolicyResponse, error) {
+	resp := &types.GetNamespacePolicyResponse{}
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/policy",
+			projectID, clusterID,
+			namespace,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+func (c *Client) UpdateNamespacePolicy(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace string,
+	req *types.UpdateNamespacePolicyRequest,
+) (*types.GetNamespacePolicyResponse, error) {
+	resp := &types.GetNamespacePolicyResponse{}
+
+	err := c.postRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/policy",
+			projectID, clusterID,
+			namespace,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// GetReleaseHooks returns the pre-deploy and post-deploy hooks of a release
+func (c *Client) GetReleaseHooks(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+) (*types.GetReleaseHooksResponse, error) {
+	resp := &types.GetReleaseHooksResponse{}
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/hooks",
+			projectID, clusterID,
+			namespace, name,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// UpdateReleaseHooks sets the pre-deploy and post-deploy hooks of a release. A hook which is
+// not set in the request is removed.
+func (c *Client) UpdateReleaseHooks(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	req *types.UpdateReleaseHooksRequest,
+) (*types.GetReleaseHooksResponse, error) {
+	resp := &types.GetReleaseHooksResponse{}
+
+	err := c.postRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/hooks",
+			projectID, clusterID,
+			namespace, name,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// GetAutoRollback returns the auto-rollback config of a release
+func (c *Client) GetAutoRollback(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+) (*types.GetAutoRollbackResponse, error) {
+	resp := &types.GetAutoRollbackResponse{}
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/auto_rollback",
+			projectID, clusterID,
+			namespace, name,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// UpdateAutoRollback sets the auto-rollback config of a release
+func (c *Client) UpdateAutoRollback(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	req *types.UpdateAutoRollbackRequest,
+) (*types.GetAutoRollbackResponse, error) {
+	resp := &types.GetAutoRollbackResponse{}
+
+	err := c.postRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/auto_rollback",
+			projectID, clusterID,
+			namespace, name,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
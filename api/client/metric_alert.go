@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// ListMetricAlertRules lists the metric alert rules of a release
+func (c *Client) ListMetricAlertRules(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+) (types.ListMetricAlertRulesResponse, error) {
+	resp := make(types.ListMetricAlertRulesResponse, 0)
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/alerts",
+			projectID, clusterID,
+			namespace, name,
+		),
+		nil,
+		&resp,
+	)
+
+	return resp, err
+}
+
+// CreateMetricAlertRule creates a metric alert rule for a release
+func (c *Client) CreateMetricAlertRule(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	req *types.CreateMetricAlertRuleRequest,
+) (*types.MetricAlertRule, error) {
+	resp := &types.MetricAlertRule{}
+
+	err := c.postRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/alerts",
+			projectID, clusterID,
+			namespace, name,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// UpdateMetricAlertRule updates a metric alert rule of a release
+func (c *Client) UpdateMetricAlertRule(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	ruleID uint,
+	req *types.UpdateMetricAlertRuleRequest,
+) (*types.MetricAlertRule, error) {
+	resp := &types.MetricAlertRule{}
+
+	err := c.postRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/alerts/%d",
+			projectID, clusterID,
+			namespace, name,
+			ruleID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// DeleteMetricAlertRule deletes a metric alert rule of a release
+func (c *Client) DeleteMetricAlertRule(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	ruleID uint,
+) error {
+	return c.deleteRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/alerts/%d",
+			projectID, clusterID,
+			namespace, name,
+			ruleID,
+		),
+		nil,
+		nil,
+	)
+}
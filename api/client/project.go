@@ -15,6 +15,7 @@ func (c *Client) GetProject(
 	resp := &types.ReadProjectResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d",
 			projectID,
@@ -35,6 +36,7 @@ func (c *Client) GetProjectCluster(
 	resp := &types.ClusterGetResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d",
 			projectID, clusterID,
@@ -54,6 +56,7 @@ func (c *Client) ListProjectClusters(
 	resp := &types.ListClusterResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters",
 			projectID,
@@ -73,6 +76,7 @@ func (c *Client) CreateProject(
 	resp := &types.CreateProjectResponse{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects",
 		),
@@ -93,6 +97,7 @@ func (c *Client) CreateProjectCandidates(
 	resp := &types.CreateClusterCandidateResponse{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/candidates",
 			projectID,
@@ -113,6 +118,7 @@ func (c *Client) GetProjectCandidates(
 	resp := &types.ListClusterCandidateResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/candidates",
 			projectID,
@@ -134,6 +140,7 @@ func (c *Client) CreateProjectCluster(
 	resp := &types.Cluster{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/candidates/%d/resolve",
 			projectID,
@@ -153,6 +160,7 @@ func (c *Client) DeleteProjectCluster(
 	clusterID uint,
 ) error {
 	return c.deleteRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d",
 			projectID,
@@ -169,6 +177,7 @@ func (c *Client) DeleteProject(
 	projectID uint,
 ) error {
 	return c.deleteRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d",
 			projectID,
@@ -177,3 +186,62 @@ func (c *Client) DeleteProject(
 		nil,
 	)
 }
+
+// GetImagePolicy retrieves the image policy of a cluster, or of the project if the
+// cluster id is 0
+func (c *Client) GetImagePolicy(
+	ctx context.Context,
+	projectID, clusterID uint,
+) (*types.GetImagePolicyResponse, error) {
+	resp := &types.GetImagePolicyResponse{}
+
+	err := c.getRequest(
+		ctx,
+		getImagePolicyPath(projectID, clusterID),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// UpdateImagePolicy sets the image policy of a cluster, or of the project if the
+// cluster id is 0
+func (c *Client) UpdateImagePolicy(
+	ctx context.Context,
+	projectID, clusterID uint,
+	req *types.UpdateImagePolicyRequest,
+) (*types.GetImagePolicyResponse, error) {
+	resp := &types.GetImagePolicyResponse{}
+
+	err := c.postRequest(
+		ctx,
+		getImagePolicyPath(projectID, clusterID),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// DeleteImagePolicy removes the image policy of a cluster, or of the project if the
+// cluster id is 0
+func (c *Client) DeleteImagePolicy(
+	ctx context.Context,
+	projectID, clusterID uint,
+) error {
+	return c.deleteRequest(
+		ctx,
+		getImagePolicyPath(projectID, clusterID),
+		nil,
+		nil,
+	)
+}
+
+func getImagePolicyPath(projectID, clusterID uint) string {
+	if clusterID == 0 {
+		return fmt.Sprintf("/projects/%d/image_policy", projectID)
+	}
+
+	return fmt.Sprintf("/projects/%d/clusters/%d/image_policy", projectID, clusterID)
+}
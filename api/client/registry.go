@@ -16,6 +16,7 @@ func (c *Client) CreateRegistry(
 	resp := &types.Registry{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/registries",
 			projectID,
@@ -36,6 +37,7 @@ func (c *Client) CreateHelmRepo(
 	resp := &types.HelmRepo{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/helmrepos",
 			projectID,
@@ -55,6 +57,7 @@ func (c *Client) ListRegistries(
 	resp := &types.RegistryListResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/registries",
 			projectID,
@@ -73,6 +76,7 @@ func (c *Client) DeleteProjectRegistry(
 	registryID uint,
 ) error {
 	return c.deleteRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/registries/%d",
 			projectID,
@@ -92,6 +96,7 @@ func (c *Client) GetECRAuthorizationToken(
 	resp := &types.GetRegistryTokenResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/registries/ecr/token",
 			projectID,
@@ -112,6 +117,7 @@ func (c *Client) GetGCRAuthorizationToken(
 	resp := &types.GetRegistryTokenResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/registries/gcr/token",
 			projectID,
@@ -131,6 +137,7 @@ func (c *Client) GetACRAuthorizationToken(
 	resp := &types.GetRegistryTokenResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/registries/acr/token",
 			projectID,
@@ -150,6 +157,7 @@ func (c *Client) GetDockerhubAuthorizationToken(
 	resp := &types.GetRegistryTokenResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/registries/dockerhub/token",
 			projectID,
@@ -170,6 +178,7 @@ func (c *Client) GetDOCRAuthorizationToken(
 	resp := &types.GetRegistryTokenResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/registries/docr/token",
 			projectID,
@@ -190,6 +199,7 @@ func (c *Client) ListRegistryRepositories(
 	resp := &types.ListRegistryRepositoryResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/registries/%d/repositories",
 			projectID,
@@ -212,6 +222,7 @@ func (c *Client) ListImages(
 	resp := &types.ListImageResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/registries/%d/repositories/%s",
 			projectID,
@@ -231,6 +242,7 @@ func (c *Client) CreateRepository(
 	req *types.CreateRegistryRepositoryRequest,
 ) error {
 	return c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/registries/%d/repository",
 			projectID,
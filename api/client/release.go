@@ -17,6 +17,7 @@ func (c *Client) ListReleases(
 	resp := make([]*release.Release, 0)
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects/%d/clusters/%d/namespaces/%s/releases",
 			projectID, clusterID,
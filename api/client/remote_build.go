@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// CreateRemoteBuild creates an image build in the cluster, which starts once its build
+// context is uploaded with UploadRemoteBuildContext
+func (c *Client) CreateRemoteBuild(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace string,
+	req *types.CreateRemoteBuildRequest,
+) (*types.CreateRemoteBuildResponse, error) {
+	resp := &types.CreateRemoteBuildResponse{}
+
+	err := c.postRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/builds",
+			projectID, clusterID,
+			namespace,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// GetRemoteBuild returns the status of an image build in the cluster
+func (c *Client) GetRemoteBuild(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, buildID string,
+) (*types.GetRemoteBuildResponse, error) {
+	resp := &types.GetRemoteBuildResponse{}
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/builds/%s",
+			projectID, clusterID,
+			namespace, buildID,
+		),
+		nil,
+		resp,
+	)
+
+	return resp, err
+}
+
+// UploadRemoteBuildContext uploads a gzipped tarball of the build context of an image build
+func (c *Client) UploadRemoteBuildContext(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, buildID string,
+	buildContext io.Reader,
+) (*types.GetRemoteBuildResponse, error) {
+	resp := &types.GetRemoteBuildResponse{}
+
+	err := c.uploadRequest(
+		ctx,
+		"PUT",
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/builds/%s/context",
+			projectID, clusterID,
+			namespace, buildID,
+		),
+		buildContext,
+		resp,
+	)
+
+	return resp, err
+}
+
+// StreamRemoteBuildLogs writes the logs of an image build to w until the build finishes
+func (c *Client) StreamRemoteBuildLogs(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, buildID string,
+	w io.Writer,
+) error {
+	return c.streamRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/builds/%s/logs",
+			projectID, clusterID,
+			namespace, buildID,
+		),
+		nil,
+		func(msg []byte) error {
+			_, err := w.Write(msg)
+			return err
+		},
+	)
+}
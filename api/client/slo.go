@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// ListSLOs lists the SLOs of a release
+func (c *Client) ListSLOs(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+) (types.ListSLOsResponse, error) {
+	resp := make(types.ListSLOsResponse, 0)
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/slos",
+			projectID, clusterID,
+			namespace, name,
+		),
+		nil,
+		&resp,
+	)
+
+	return resp, err
+}
+
+// CreateSLO creates an SLO for a release
+func (c *Client) CreateSLO(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	req *types.CreateSLORequest,
+) (*types.SLO, error) {
+	resp := &types.SLO{}
+
+	err := c.postRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/slos",
+			projectID, clusterID,
+			namespace, name,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// UpdateSLO updates an SLO of a release
+func (c *Client) UpdateSLO(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	sloID uint,
+	req *types.UpdateSLORequest,
+) (*types.SLO, error) {
+	resp := &types.SLO{}
+
+	err := c.postRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/slos/%d",
+			projectID, clusterID,
+			namespace, name,
+			sloID,
+		),
+		req,
+		resp,
+	)
+
+	return resp, err
+}
+
+// DeleteSLO deletes an SLO of a release
+func (c *Client) DeleteSLO(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+	sloID uint,
+) error {
+	return c.deleteRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/slos/%d",
+			projectID, clusterID,
+			namespace, name,
+			sloID,
+		),
+		nil,
+		nil,
+	)
+}
+
+// GetSLOStatuses returns the error budget and the burn rates of every SLO of a release
+func (c *Client) GetSLOStatuses(
+	ctx context.Context,
+	projectID, clusterID uint,
+	namespace, name string,
+) (types.GetSLOStatusesResponse, error) {
+	resp := make(types.GetSLOStatusesResponse, 0)
+
+	err := c.getRequest(
+		ctx,
+		fmt.Sprintf(
+			"/projects/%d/clusters/%d/namespaces/%s/releases/%s/0/slos/status",
+			projectID, clusterID,
+			namespace, name,
+		),
+		nil,
+		&resp,
+	)
+
+	return resp, err
+}
@@ -14,6 +14,7 @@ func (c *Client) ListTemplates(
 	resp := &types.ListTemplatesResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/templates",
 		),
@@ -32,6 +33,7 @@ func (c *Client) GetTemplate(
 	resp := &types.GetTemplateResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/templates/%s/%s",
 			name, version,
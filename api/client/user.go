@@ -12,6 +12,7 @@ func (c *Client) AuthCheck(ctx context.Context) (*types.GetAuthenticatedUserResp
 	resp := &types.GetAuthenticatedUserResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/users/current",
 		),
@@ -27,6 +28,7 @@ func (c *Client) Login(ctx context.Context, req *types.LoginUserRequest) (*types
 	resp := &types.GetAuthenticatedUserResponse{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/login",
 		),
@@ -40,6 +42,7 @@ func (c *Client) Login(ctx context.Context, req *types.LoginUserRequest) (*types
 // Logout logs the user out and deauthorizes the cookie-based session
 func (c *Client) Logout(ctx context.Context) error {
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/logout",
 		),
@@ -63,6 +66,7 @@ func (c *Client) CreateUser(
 	resp := &types.CreateUserResponse{}
 
 	err := c.postRequest(
+		ctx,
 		fmt.Sprintf(
 			"/users",
 		),
@@ -78,6 +82,7 @@ func (c *Client) ListUserProjects(ctx context.Context) (*types.ListUserProjectsR
 	resp := &types.ListUserProjectsResponse{}
 
 	err := c.getRequest(
+		ctx,
 		fmt.Sprintf(
 			"/projects",
 		),
@@ -93,6 +98,7 @@ func (c *Client) DeleteUser(
 	ctx context.Context,
 ) error {
 	return c.deleteRequest(
+		ctx,
 		fmt.Sprintf(
 			"/users/current",
 		),
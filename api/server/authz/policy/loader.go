@@ -13,13 +13,19 @@ type PolicyDocumentLoader interface {
 	LoadPolicyDocuments(userID, projectID uint) ([]*types.PolicyDocument, apierrors.RequestError)
 }
 
-// BasicPolicyDocumentLoader loads policy documents simply depending on the
+// BasicPolicyDocumentLoader loads policy documents simply depending on the role kind of
+// the user in the project, and the role kinds of the teams the user is a member of. The
+// policy documents are the union of the policy documents of each role kind.
 type BasicPolicyDocumentLoader struct {
 	projRepo repository.ProjectRepository
+	teamRepo repository.TeamRepository
 }
 
-func NewBasicPolicyDocumentLoader(projRepo repository.ProjectRepository) *BasicPolicyDocumentLoader {
-	return &BasicPolicyDocumentLoader{projRepo}
+func NewBasicPolicyDocumentLoader(
+	projRepo repository.ProjectRepository,
+	teamRepo repository.TeamRepository,
+) *BasicPolicyDocumentLoader {
+	return &BasicPolicyDocumentLoader{projRepo, teamRepo}
 }
 
 func (b *BasicPolicyDocumentLoader) LoadPolicyDocuments(
@@ -36,19 +42,60 @@ func (b *BasicPolicyDocumentLoader) LoadPolicyDocuments(
 		return nil, apierrors.NewErrInternal(err)
 	}
 
+	rolePolicy, ok := getRoleKindPolicy(role.Kind)
+
+	if !ok {
+		return nil, apierrors.NewErrForbidden(
+			fmt.Errorf("%s role not supported for user %d, project %d", string(role.Kind), userID, projectID),
+		)
+	}
+
+	res := append([]*types.PolicyDocument{}, rolePolicy...)
+
+	// users inherit the policies of all of their teams
+	teams, err := b.teamRepo.ListTeamsByUserID(projectID, userID)
+
+	if err != nil {
+		return nil, apierrors.NewErrInternal(err)
+	}
+
+	for _, team := range teams {
+		teamPolicy, ok := getRoleKindPolicy(team.Kind)
+
+		if !ok {
+			return nil, apierrors.NewErrForbidden(
+				fmt.Errorf("%s role not supported for team %d, project %d", string(team.Kind), team.ID, projectID),
+			)
+		}
+
+		res = append(res, teamPolicy...)
+	}
+
+	if len(res) == 0 {
+		return nil, apierrors.NewErrForbidden(
+			fmt.Errorf("user %d does not have any permissions in project %d", userID, projectID),
+		)
+	}
+
+	return res, nil
+}
+
+// getRoleKindPolicy returns the policy documents for a role kind, and false if the role
+// kind is not supported
+func getRoleKindPolicy(kind types.RoleKind) ([]*types.PolicyDocument, bool) {
 	// load role based on role kind
-	switch role.Kind {
+	switch kind {
 	case types.RoleAdmin:
-		return AdminPolicy, nil
+		return AdminPolicy, true
 	case types.RoleDeveloper:
-		return DeveloperPolicy, nil
+		return DeveloperPolicy, true
 	case types.RoleViewer:
-		return ViewerPolicy, nil
-	default:
-		return nil, apierrors.NewErrForbidden(
-			fmt.Errorf("%s role not supported for user %d, project %d", string(role.Kind), userID, projectID),
-		)
+		return ViewerPolicy, true
+	case types.RoleMember:
+		return []*types.PolicyDocument{}, true
 	}
+
+	return nil, false
 }
 
 var AdminPolicy = []*types.PolicyDocument{
@@ -53,7 +53,7 @@ func TestBasicPolicyDocumentLoader(t *testing.T) {
 	for _, basicTest := range basicLoaderTests {
 		// use the in-memory project repo
 		projRepo := test.NewProjectRepository(true)
-		loader := policy.NewBasicPolicyDocumentLoader(projRepo)
+		loader := policy.NewBasicPolicyDocumentLoader(projRepo, test.NewTeamRepository(true))
 
 		project := &models.Project{
 			Name: "test-project",
@@ -123,7 +123,7 @@ func TestErrorForbiddenInvalidRole(t *testing.T) {
 
 	// use the in-memory project repo
 	projRepo := test.NewProjectRepository(true)
-	loader := policy.NewBasicPolicyDocumentLoader(projRepo)
+	loader := policy.NewBasicPolicyDocumentLoader(projRepo, test.NewTeamRepository(true))
 
 	project := &models.Project{
 		Name: "test-project",
@@ -174,7 +174,7 @@ func TestErrorCannotQuery(t *testing.T) {
 
 	// use the in-memory project repo
 	projRepo := test.NewProjectRepository(false)
-	loader := policy.NewBasicPolicyDocumentLoader(projRepo)
+	loader := policy.NewBasicPolicyDocumentLoader(projRepo, test.NewTeamRepository(true))
 
 	_, reqErr := loader.LoadPolicyDocuments(2, 1)
 
@@ -189,3 +189,84 @@ func TestErrorCannotQuery(t *testing.T) {
 		"status is not status internal",
 	)
 }
+
+func TestTeamPolicyDocumentLoader(t *testing.T) {
+	assert := assert.New(t)
+
+	projRepo := test.NewProjectRepository(true)
+	teamRepo := test.NewTeamRepository(true)
+	loader := policy.NewBasicPolicyDocumentLoader(projRepo, teamRepo)
+
+	project, err := projRepo.CreateProject(&models.Project{
+		Name: "test-project",
+	})
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// user 1 only has the permissions of their teams, user 2 is a viewer with no teams
+	for userID, kind := range map[uint]types.RoleKind{1: types.RoleMember, 2: types.RoleViewer} {
+		_, err = projRepo.CreateProjectRole(project, &models.Role{
+			Role: types.Role{
+				UserID:    userID,
+				ProjectID: project.ID,
+				Kind:      kind,
+			},
+		})
+
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+	}
+
+	// a member without teams has no permissions
+	_, reqErr := loader.LoadPolicyDocuments(1, project.ID)
+
+	if reqErr == nil {
+		t.Fatalf("Expected forbidden error for member without teams")
+	}
+
+	assert.Equal(http.StatusForbidden, reqErr.GetStatusCode(), "status is not status forbidden")
+
+	for _, kind := range []types.RoleKind{types.RoleViewer, types.RoleDeveloper} {
+		team, err := teamRepo.CreateTeam(&models.Team{
+			ProjectID: project.ID,
+			Name:      string(kind),
+			Kind:      kind,
+		})
+
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+
+		if _, err := teamRepo.AddTeamMember(team, 1); err != nil {
+			t.Fatalf("%v", err)
+		}
+	}
+
+	docs, reqErr := loader.LoadPolicyDocuments(1, project.ID)
+
+	if reqErr != nil {
+		t.Fatalf("%v", reqErr)
+	}
+
+	expPolicy := append(append([]*types.PolicyDocument{}, policy.ViewerPolicy...), policy.DeveloperPolicy...)
+
+	if diff := deep.Equal(expPolicy, docs); diff != nil {
+		t.Errorf("policy documents are not the union of the team policies:")
+		t.Error(diff)
+	}
+
+	// the policies of a user without teams are unchanged
+	docs, reqErr = loader.LoadPolicyDocuments(2, project.ID)
+
+	if reqErr != nil {
+		t.Fatalf("%v", reqErr)
+	}
+
+	if diff := deep.Equal(policy.ViewerPolicy, docs); diff != nil {
+		t.Errorf("policy documents not equal:")
+		t.Error(diff)
+	}
+}
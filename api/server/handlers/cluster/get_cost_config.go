@@ -0,0 +1,41 @@
+package cluster
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/cost"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type GetCostConfigHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewGetCostConfigHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *GetCostConfigHandler {
+	return &GetCostConfigHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *GetCostConfigHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+
+	conf, err := cost.GetClusterCostConfig(c.Repo(), cluster.ProjectID, cluster.ID)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := types.GetClusterCostConfigResponse(*conf)
+
+	c.WriteResult(w, r, &res)
+}
@@ -0,0 +1,77 @@
+package cluster
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+)
+
+type UpdateCostConfigHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewUpdateCostConfigHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *UpdateCostConfigHandler {
+	return &UpdateCostConfigHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *UpdateCostConfigHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+
+	request := &types.UpdateClusterCostConfigRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	nodePrices := make([]models.NodeTypePrice, 0)
+
+	for _, price := range request.NodePrices {
+		nodePrices = append(nodePrices, models.NodeTypePrice{
+			InstanceType: price.InstanceType,
+			HourlyPrice:  price.HourlyPrice,
+		})
+	}
+
+	// either create a new cost config or replace the current one
+	conf, err := c.Repo().ClusterCostConfig().ReadClusterCostConfig(cluster.ProjectID, cluster.ID)
+
+	if err != nil && err != gorm.ErrRecordNotFound {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if err == gorm.ErrRecordNotFound {
+		conf, err = c.Repo().ClusterCostConfig().CreateClusterCostConfig(&models.ClusterCostConfig{
+			ProjectID:          cluster.ProjectID,
+			ClusterID:          cluster.ID,
+			DefaultHourlyPrice: request.DefaultHourlyPrice,
+			NodePrices:         nodePrices,
+		})
+	} else {
+		conf.DefaultHourlyPrice = request.DefaultHourlyPrice
+		conf.NodePrices = nodePrices
+
+		conf, err = c.Repo().ClusterCostConfig().UpdateClusterCostConfig(conf)
+	}
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := types.GetClusterCostConfigResponse(*conf.ToClusterCostConfigType())
+
+	c.WriteResult(w, r, &res)
+}
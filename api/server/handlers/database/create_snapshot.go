@@ -0,0 +1,132 @@
+package database
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// snapshot names must be valid snapshot identifiers for RDS, Cloud SQL and DigitalOcean
+var snapshotNameRegex = regexp.MustCompile(`^[a-z]([a-z0-9-]*[a-z0-9])?$`)
+
+type DatabaseCreateSnapshotHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewDatabaseCreateSnapshotHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *DatabaseCreateSnapshotHandler {
+	return &DatabaseCreateSnapshotHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (p *DatabaseCreateSnapshotHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+
+	req := &types.CreateDatabaseSnapshotRequest{}
+
+	if ok := p.DecodeAndValidate(w, r, req); !ok {
+		return
+	}
+
+	if !snapshotNameRegex.MatchString(req.Name) {
+		p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("snapshot name must consist of lowercase letters, numbers and hyphens, and must start with a letter"),
+			http.StatusBadRequest,
+		))
+
+		return
+	}
+
+	db, reqErr := readDatabase(r, p.Config(), cluster)
+
+	if reqErr != nil {
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	snapshots, err := p.Repo().DatabaseSnapshot().ListDatabaseSnapshots(db.ProjectID, db.ID)
+
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	// snapshots are declared in the values of the database infra, so the operation applies
+	// the list of all snapshots which were not failed to create
+	snapshotNames := make([]string, 0)
+
+	for _, snapshot := range snapshots {
+		if snapshot.Status == types.DatabaseSnapshotFailed {
+			continue
+		}
+
+		if snapshot.Name == req.Name {
+			p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("snapshot %s already exists", req.Name),
+				http.StatusConflict,
+			))
+
+			return
+		}
+
+		snapshotNames = append(snapshotNames, snapshot.Name)
+	}
+
+	snapshotNames = append(snapshotNames, req.Name)
+
+	// the snapshot is recorded before the operation is applied, so that the operation callback
+	// can always find it
+	snapshot, err := p.Repo().DatabaseSnapshot().CreateDatabaseSnapshot(&models.DatabaseSnapshot{
+		ProjectID:  db.ProjectID,
+		DatabaseID: db.ID,
+		InfraID:    db.InfraID,
+		Name:       req.Name,
+		Status:     types.DatabaseSnapshotPending,
+	})
+
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	op, reqErr := applyDatabaseOperation(p.Config(), db, "snapshot", map[string]interface{}{
+		"snapshots": snapshotNames,
+	})
+
+	if reqErr != nil {
+		snapshot.Status = types.DatabaseSnapshotFailed
+
+		if _, err := p.Repo().DatabaseSnapshot().UpdateDatabaseSnapshot(snapshot); err != nil {
+			p.HandleAPIErrorNoWrite(w, r, apierrors.NewErrInternal(err))
+		}
+
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	snapshot.Status = types.DatabaseSnapshotCreating
+	snapshot.OperationUID = op.UID
+
+	snapshot, err = p.Repo().DatabaseSnapshot().UpdateDatabaseSnapshot(snapshot)
+
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	p.WriteResult(w, r, &types.CreateDatabaseSnapshotResponse{
+		Snapshot:  snapshot.ToDatabaseSnapshotType(),
+		Operation: op,
+	})
+}
@@ -0,0 +1,51 @@
+package database
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type DatabaseListSnapshotsHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewDatabaseListSnapshotsHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *DatabaseListSnapshotsHandler {
+	return &DatabaseListSnapshotsHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (p *DatabaseListSnapshotsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+
+	db, reqErr := readDatabase(r, p.Config(), cluster)
+
+	if reqErr != nil {
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	snapshots, err := p.Repo().DatabaseSnapshot().ListDatabaseSnapshots(db.ProjectID, db.ID)
+
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := make(types.ListDatabaseSnapshotsResponse, 0)
+
+	for _, snapshot := range snapshots {
+		res = append(res, snapshot.ToDatabaseSnapshotType())
+	}
+
+	p.WriteResult(w, r, res)
+}
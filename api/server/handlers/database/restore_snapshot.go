@@ -0,0 +1,71 @@
+package database
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type DatabaseRestoreSnapshotHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewDatabaseRestoreSnapshotHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *DatabaseRestoreSnapshotHandler {
+	return &DatabaseRestoreSnapshotHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (p *DatabaseRestoreSnapshotHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+
+	db, reqErr := readDatabase(r, p.Config(), cluster)
+
+	if reqErr != nil {
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	snapshot, reqErr := readDatabaseSnapshot(r, p.Config(), db)
+
+	if reqErr != nil {
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	if snapshot.Status != types.DatabaseSnapshotAvailable {
+		p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("snapshot %s is not available to restore from", snapshot.Name),
+			http.StatusBadRequest,
+		))
+
+		return
+	}
+
+	op, reqErr := applyDatabaseOperation(p.Config(), db, "restore", map[string]interface{}{
+		"restore_snapshot": snapshot.Name,
+	})
+
+	if reqErr != nil {
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	db.Status = "restoring"
+
+	if _, err := p.Repo().Database().UpdateDatabase(db); err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	p.WriteResult(w, r, op)
+}
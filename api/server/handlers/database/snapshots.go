@@ -0,0 +1,129 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+
+	ptypes "github.com/porter-dev/porter/provisioner/types"
+)
+
+// readDatabase reads the database in the URL, which must belong to the cluster
+func readDatabase(
+	r *http.Request,
+	config *config.Config,
+	cluster *models.Cluster,
+) (*models.Database, apierrors.RequestError) {
+	databaseID, reqErr := requestutils.GetURLParamUint(r, types.URLParamDatabaseID)
+
+	if reqErr != nil {
+		return nil, reqErr
+	}
+
+	db, err := config.Repo.Database().ReadDatabase(cluster.ProjectID, cluster.ID, databaseID)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("database %d not found", databaseID),
+				http.StatusNotFound,
+			)
+		}
+
+		return nil, apierrors.NewErrInternal(err)
+	}
+
+	return db, nil
+}
+
+// readDatabaseSnapshot reads the snapshot in the URL, which must belong to the database
+func readDatabaseSnapshot(
+	r *http.Request,
+	config *config.Config,
+	db *models.Database,
+) (*models.DatabaseSnapshot, apierrors.RequestError) {
+	snapshotID, reqErr := requestutils.GetURLParamUint(r, types.URLParamDatabaseSnapshotID)
+
+	if reqErr != nil {
+		return nil, reqErr
+	}
+
+	snapshot, err := config.Repo.DatabaseSnapshot().ReadDatabaseSnapshot(db.ProjectID, db.ID, snapshotID)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("snapshot %d not found", snapshotID),
+				http.StatusNotFound,
+			)
+		}
+
+		return nil, apierrors.NewErrInternal(err)
+	}
+
+	return snapshot, nil
+}
+
+// applyDatabaseOperation starts an operation on the infra of a database through the
+// provisioner. The operation applies the last applied values of the infra, with the
+// values passed to the function set on top of them.
+func applyDatabaseOperation(
+	config *config.Config,
+	db *models.Database,
+	operationKind string,
+	values map[string]interface{},
+) (*types.Operation, apierrors.RequestError) {
+	infra, err := config.Repo.Infra().ReadInfra(db.ProjectID, db.InfraID)
+
+	if err != nil {
+		return nil, apierrors.NewErrInternal(err)
+	}
+
+	lastOperation, err := config.Repo.Infra().GetLatestOperation(infra)
+
+	if err != nil {
+		return nil, apierrors.NewErrInternal(err)
+	}
+
+	// if the last operation is in a "starting" state, block apply
+	if lastOperation.Status == "starting" {
+		return nil, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("Operation currently in progress. Please try again when latest operation has completed."),
+			http.StatusBadRequest,
+		)
+	}
+
+	vals := make(map[string]interface{})
+
+	if err := json.Unmarshal(lastOperation.LastApplied, &vals); err != nil {
+		return nil, apierrors.NewErrInternal(err)
+	}
+
+	// the snapshot to restore from is only applied by the restore operation which sets it
+	delete(vals, "restore_snapshot")
+
+	for key, val := range values {
+		vals[key] = val
+	}
+
+	resp, err := config.ProvisionerClient.Apply(context.Background(), db.ProjectID, infra.ID, &ptypes.ApplyBaseRequest{
+		Kind:          string(infra.Kind),
+		Values:        vals,
+		OperationKind: operationKind,
+	})
+
+	if err != nil {
+		return nil, apierrors.NewErrInternal(err)
+	}
+
+	return resp, nil
+}
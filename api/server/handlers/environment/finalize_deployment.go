@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/google/go-github/v41/github"
 	"github.com/porter-dev/porter/api/server/handlers"
@@ -66,6 +67,11 @@ func (c *FinalizeDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 	depl.Subdomain = request.Subdomain
 	depl.Status = types.DeploymentStatusCreated
 
+	if err := depl.SetServices(request.Services); err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
 	// update the deployment
 	depl, err = c.Repo().Environment().UpdateDeployment(depl)
 
@@ -106,6 +112,10 @@ func (c *FinalizeDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 
 	// write comment in PR
 	commentBody := fmt.Sprintf("Porter has deployed this pull request to the following URL:\n%s", depl.Subdomain)
+
+	if len(request.Services) > 0 {
+		commentBody += "\n\n" + getServicesTable(request.Services)
+	}
 	prComment := github.IssueComment{
 		Body: &commentBody,
 		User: &github.User{},
@@ -126,3 +136,23 @@ func (c *FinalizeDeploymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 
 	c.WriteResult(w, r, depl.ToDeploymentType())
 }
+
+// getServicesTable returns a markdown table with the status of each service in a deployment
+func getServicesTable(services []*types.DeploymentService) string {
+	rows := []string{
+		"| Service | Kind | Status |",
+		"| --- | --- | --- |",
+	}
+
+	for _, service := range services {
+		status := string(service.Status)
+
+		if service.Message != "" {
+			status = fmt.Sprintf("%s: %s", status, service.Message)
+		}
+
+		rows = append(rows, fmt.Sprintf("| %s | %s | %s |", service.Name, service.Kind, status))
+	}
+
+	return strings.Join(rows, "\n")
+}
@@ -65,6 +65,13 @@ func (c *UpdateDeploymentStatusHandler) ServeHTTP(w http.ResponseWriter, r *http
 
 	depl.Status = types.DeploymentStatus(request.Status)
 
+	if request.Services != nil {
+		if err := depl.SetServices(request.Services); err != nil {
+			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+	}
+
 	// create the deployment
 	depl, err = c.Repo().Environment().UpdateDeployment(depl)
 
@@ -1,16 +1,15 @@
 package gitinstallation
 
 import (
-	"context"
 	"net/http"
 
-	"github.com/google/go-github/v41/github"
 	"github.com/porter-dev/porter/api/server/authz"
 	"github.com/porter-dev/porter/api/server/handlers"
 	"github.com/porter-dev/porter/api/server/shared"
 	"github.com/porter-dev/porter/api/server/shared/apierrors"
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/integrations/git"
 )
 
 type GithubGetContentsHandler struct {
@@ -56,29 +55,12 @@ func (c *GithubGetContentsHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	repoContentOptions := github.RepositoryContentGetOptions{}
-	repoContentOptions.Ref = branch
-	_, directoryContents, _, err := client.Repositories.GetContents(
-		context.Background(),
-		owner,
-		name,
-		request.Dir,
-		&repoContentOptions,
-	)
+	contents, err := git.NewGithubProvider(client).ListContents(r.Context(), owner+"/"+name, branch, request.Dir)
 
 	if err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
 	}
 
-	res := make(types.GetContentsResponse, 0)
-
-	for i := range directoryContents {
-		res = append(res, types.GithubDirectoryItem{
-			Path: *directoryContents[i].Path,
-			Type: *directoryContents[i].Type,
-		})
-	}
-
-	c.WriteResult(w, r, res)
+	c.WriteResult(w, r, types.GetContentsResponse(contents))
 }
@@ -1,18 +1,17 @@
 package gitinstallation
 
 import (
-	"context"
 	"net/http"
 	"regexp"
 	"strings"
 
-	"github.com/google/go-github/v41/github"
 	"github.com/porter-dev/porter/api/server/authz"
 	"github.com/porter-dev/porter/api/server/handlers"
 	"github.com/porter-dev/porter/api/server/shared"
 	"github.com/porter-dev/porter/api/server/shared/apierrors"
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/integrations/git"
 )
 
 var procfileRegex = regexp.MustCompile("^([A-Za-z0-9_]+):\\s*(.+)$")
@@ -60,32 +59,17 @@ func (c *GithubGetProcfileHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	resp, _, _, err := client.Repositories.GetContents(
-		context.TODO(),
-		owner,
-		name,
-		request.Path,
-		&github.RepositoryContentGetOptions{
-			Ref: branch,
-		},
-	)
+	fileData, err := git.NewGithubProvider(client).GetFile(r.Context(), owner+"/"+name, branch, request.Path)
 
 	if err != nil {
 		http.NotFound(w, r)
 		return
 	}
 
-	fileData, err := resp.GetContent()
-
-	if err != nil {
-		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
-		return
-	}
-
 	parsedContents := make(types.GetProcfileResponse)
 
 	// parse the procfile information
-	for _, line := range strings.Split(fileData, "\n") {
+	for _, line := range strings.Split(string(fileData), "\n") {
 		if matches := procfileRegex.FindStringSubmatch(line); matches != nil {
 			parsedContents[matches[1]] = matches[2]
 		}
@@ -0,0 +1,86 @@
+package gitlab_integration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/integrations/gitlab"
+	"github.com/porter-dev/porter/internal/models"
+
+	ints "github.com/porter-dev/porter/internal/models/integrations"
+)
+
+type CreateGitlabIntegrationHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewCreateGitlabIntegrationHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateGitlabIntegrationHandler {
+	return &CreateGitlabIntegrationHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *CreateGitlabIntegrationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	user, _ := r.Context().Value(types.UserScope).(*models.User)
+	project, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	request := &types.CreateGitlabIntegrationRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	if request.InstanceURL == "" {
+		request.InstanceURL = gitlab.DefaultInstanceURL
+	}
+
+	gi := &ints.GitlabIntegration{
+		ProjectID:   project.ID,
+		UserID:      user.ID,
+		InstanceURL: request.InstanceURL,
+	}
+
+	if request.PersonalAccessToken != "" {
+		// verify the token before saving it
+		gitlabUser, err := gitlab.NewTokenClient(request.InstanceURL, request.PersonalAccessToken).GetCurrentUser(context.Background())
+
+		if err != nil {
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("could not authenticate with gitlab using the access token: %w", err),
+				http.StatusBadRequest,
+			))
+
+			return
+		}
+
+		gi.AuthMechanism = types.GitlabAuthPersonalAccessToken
+		gi.AccessToken = []byte(request.PersonalAccessToken)
+		gi.Username = gitlabUser.Username
+	} else {
+		// the tokens are populated when the OAuth flow is completed
+		gi.AuthMechanism = types.GitlabAuthOAuth
+		gi.ClientID = []byte(request.AppClientID)
+		gi.AppClientSecret = []byte(request.AppClientSecret)
+	}
+
+	gi, err := c.Repo().GitlabIntegration().CreateGitlabIntegration(gi)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := types.CreateGitlabIntegrationResponse(*gi.ToGitlabIntegrationType())
+
+	c.WriteResult(w, r, &res)
+}
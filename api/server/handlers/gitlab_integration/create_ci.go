@@ -0,0 +1,102 @@
+package gitlab_integration
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/auth/token"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+
+	gitlabci "github.com/porter-dev/porter/internal/integrations/ci/gitlab"
+)
+
+type CreateGitlabCIHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewCreateGitlabCIHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateGitlabCIHandler {
+	return &CreateGitlabCIHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *CreateGitlabCIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	user, _ := r.Context().Value(types.UserScope).(*models.User)
+	project, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	request := &types.CreateGitlabCIRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	// make sure the cluster belongs to the project
+	if _, err := c.Repo().Cluster().ReadCluster(project.ID, request.ClusterID); errors.Is(err, gorm.ErrRecordNotFound) {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("cluster %d not found", request.ClusterID),
+			http.StatusNotFound,
+		))
+
+		return
+	} else if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	client, _, ok := GetGitlabClientFromRequest(c, w, r)
+
+	if !ok {
+		return
+	}
+
+	// generate porter jwt token
+	jwt, err := token.GetTokenForAPI(user.ID, project.ID)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	encoded, err := jwt.EncodeToken(c.Config().TokenConf)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	ci := &gitlabci.GitlabCI{
+		Client:           client,
+		ServerURL:        c.Config().ServerConf.ServerURL,
+		PorterToken:      encoded,
+		ProjectID:        project.ID,
+		ClusterID:        request.ClusterID,
+		ReleaseName:      request.ReleaseName,
+		ReleaseNamespace: request.ReleaseNamespace,
+		GitRepo:          request.Repo,
+		GitBranch:        request.Branch,
+		DryRun:           request.DryRun,
+	}
+
+	pipelineYAML, err := ci.Setup()
+
+	if err != nil {
+		handleGitlabError(c, w, r, err)
+		return
+	}
+
+	c.WriteResult(w, r, &types.CreateGitlabCIResponse{
+		Path:     ci.GetPipelineFileName(),
+		Contents: string(pipelineYAML),
+	})
+}
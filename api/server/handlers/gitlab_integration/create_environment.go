@@ -0,0 +1,152 @@
+package gitlab_integration
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/auth/token"
+	"github.com/porter-dev/porter/internal/encryption"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+
+	gitlabci "github.com/porter-dev/porter/internal/integrations/ci/gitlab"
+)
+
+type CreateGitlabEnvironmentHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewCreateGitlabEnvironmentHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateGitlabEnvironmentHandler {
+	return &CreateGitlabEnvironmentHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *CreateGitlabEnvironmentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	user, _ := r.Context().Value(types.UserScope).(*models.User)
+	project, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	request := &types.CreateGitlabEnvironmentRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	webhookSecret := c.Config().ServerConf.GitlabIncomingWebhookSecret
+
+	if webhookSecret == "" {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("preview environments for gitlab are not enabled on this instance"),
+			http.StatusBadRequest,
+		))
+
+		return
+	}
+
+	cluster, err := c.Repo().Cluster().ReadCluster(project.ID, request.ClusterID)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("cluster %d not found", request.ClusterID),
+			http.StatusNotFound,
+		))
+
+		return
+	} else if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	client, gi, ok := GetGitlabClientFromRequest(c, w, r)
+
+	if !ok {
+		return
+	}
+
+	gitlabProject, err := client.GetProject(r.Context(), request.Repo)
+
+	if err != nil {
+		handleGitlabError(c, w, r, err)
+		return
+	}
+
+	// create a random webhook id
+	webhookUID, err := encryption.GenerateRandomBytes(32)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	env, err := c.Repo().Environment().CreateEnvironment(&models.Environment{
+		ProjectID:           project.ID,
+		ClusterID:           cluster.ID,
+		GitlabIntegrationID: gi.ID,
+		Name:                request.Name,
+		GitRepoOwner:        gitlabProject.Namespace.FullPath,
+		GitRepoName:         gitlabProject.Path,
+		Mode:                request.Mode,
+		WebhookID:           string(webhookUID),
+	})
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	webhookURL := fmt.Sprintf("%s/api/gitlab/incoming_webhook/%s", c.Config().ServerConf.ServerURL, string(webhookUID))
+
+	if err := client.CreateMergeRequestHook(r.Context(), gitlabProject.PathWithNamespace, webhookURL, webhookSecret); err != nil {
+		c.deleteEnvAndReportError(w, r, env, err)
+		return
+	}
+
+	// generate porter jwt token
+	jwt, err := token.GetTokenForAPI(user.ID, project.ID)
+
+	if err != nil {
+		c.deleteEnvAndReportError(w, r, env, err)
+		return
+	}
+
+	encoded, err := jwt.EncodeToken(c.Config().TokenConf)
+
+	if err != nil {
+		c.deleteEnvAndReportError(w, r, env, err)
+		return
+	}
+
+	err = gitlabci.SetupEnv(&gitlabci.EnvOpts{
+		Client:          client,
+		ServerURL:       c.Config().ServerConf.ServerURL,
+		PorterToken:     encoded,
+		GitRepo:         gitlabProject.PathWithNamespace,
+		EnvironmentName: request.Name,
+		ProjectID:       project.ID,
+		ClusterID:       cluster.ID,
+	})
+
+	if err != nil {
+		c.deleteEnvAndReportError(w, r, env, err)
+		return
+	}
+
+	c.WriteResult(w, r, env.ToEnvironmentType())
+}
+
+func (c *CreateGitlabEnvironmentHandler) deleteEnvAndReportError(
+	w http.ResponseWriter, r *http.Request, env *models.Environment, err error,
+) {
+	c.Repo().Environment().DeleteEnvironment(env)
+	c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+}
@@ -0,0 +1,48 @@
+package gitlab_integration
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/integrations/git"
+)
+
+type GetGitlabContentsHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewGetGitlabContentsHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *GetGitlabContentsHandler {
+	return &GetGitlabContentsHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *GetGitlabContentsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	request := &types.GetGitlabContentsRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	client, _, ok := GetGitlabClientFromRequest(c, w, r)
+
+	if !ok {
+		return
+	}
+
+	contents, err := git.NewGitlabProvider(client).ListContents(r.Context(), request.Repo, request.Branch, request.Dir)
+
+	if err != nil {
+		handleGitlabError(c, w, r, err)
+		return
+	}
+
+	c.WriteResult(w, r, types.GetContentsResponse(contents))
+}
@@ -0,0 +1,83 @@
+package gitlab_integration
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/integrations/gitlab"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+
+	ints "github.com/porter-dev/porter/internal/models/integrations"
+)
+
+// GetGitlabIntegrationFromRequest reads the GitLab integration referenced in the URL, which
+// must belong to the project in the request scope
+func GetGitlabIntegrationFromRequest(c handlers.PorterHandler, w http.ResponseWriter, r *http.Request) (*ints.GitlabIntegration, bool) {
+	project, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	giID, reqErr := requestutils.GetURLParamUint(r, types.URLParamGitlabIntegrationID)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return nil, false
+	}
+
+	gi, err := c.Repo().GitlabIntegration().ReadGitlabIntegration(project.ID, giID)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("gitlab integration %d not found", giID),
+			http.StatusNotFound,
+		))
+
+		return nil, false
+	} else if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return nil, false
+	}
+
+	return gi, true
+}
+
+// GetGitlabClientFromRequest returns a GitLab client for the integration referenced in the URL
+func GetGitlabClientFromRequest(c handlers.PorterHandler, w http.ResponseWriter, r *http.Request) (*gitlab.Client, *ints.GitlabIntegration, bool) {
+	gi, ok := GetGitlabIntegrationFromRequest(c, w, r)
+
+	if !ok {
+		return nil, nil, false
+	}
+
+	client, err := gitlab.NewClientFromIntegration(gi, c.Repo())
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("could not authenticate with gitlab: %w", err),
+			http.StatusBadRequest,
+		))
+
+		return nil, nil, false
+	}
+
+	return client, gi, true
+}
+
+// handleGitlabError returns 404 errors from the GitLab API to the client, and treats any
+// other error as an internal error
+func handleGitlabError(c handlers.PorterHandler, w http.ResponseWriter, r *http.Request, err error) {
+	if gitlab.IsNotFound(err) {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusNotFound))
+		return
+	}
+
+	c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+}
+
+func getOAuthRedirectURL(serverURL string) string {
+	return serverURL + "/api/oauth/gitlab/callback"
+}
@@ -0,0 +1,44 @@
+package gitlab_integration
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type ListGitlabIntegrationsHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewListGitlabIntegrationsHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListGitlabIntegrationsHandler {
+	return &ListGitlabIntegrationsHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *ListGitlabIntegrationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	project, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	gis, err := c.Repo().GitlabIntegration().ListGitlabIntegrationsByProjectID(project.ID)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := make(types.ListGitlabIntegrationsResponse, 0)
+
+	for _, gi := range gis {
+		res = append(res, gi.ToGitlabIntegrationType())
+	}
+
+	c.WriteResult(w, r, res)
+}
@@ -0,0 +1,48 @@
+package gitlab_integration
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/integrations/git"
+)
+
+type ListGitlabBranchesHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewListGitlabBranchesHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *ListGitlabBranchesHandler {
+	return &ListGitlabBranchesHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *ListGitlabBranchesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	request := &types.GitlabRepoRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	client, _, ok := GetGitlabClientFromRequest(c, w, r)
+
+	if !ok {
+		return
+	}
+
+	branches, err := git.NewGitlabProvider(client).ListBranches(r.Context(), request.Repo)
+
+	if err != nil {
+		handleGitlabError(c, w, r, err)
+		return
+	}
+
+	c.WriteResult(w, r, types.ListRepoBranchesResponse(branches))
+}
@@ -0,0 +1,41 @@
+package gitlab_integration
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/integrations/git"
+)
+
+type ListGitlabReposHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewListGitlabReposHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListGitlabReposHandler {
+	return &ListGitlabReposHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *ListGitlabReposHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	client, _, ok := GetGitlabClientFromRequest(c, w, r)
+
+	if !ok {
+		return
+	}
+
+	repos, err := git.NewGitlabProvider(client).ListRepos(r.Context())
+
+	if err != nil {
+		handleGitlabError(c, w, r, err)
+		return
+	}
+
+	c.WriteResult(w, r, types.ListReposResponse(repos))
+}
@@ -0,0 +1,79 @@
+package gitlab_integration
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/integrations/gitlab"
+	"github.com/porter-dev/porter/internal/oauth"
+	"golang.org/x/oauth2"
+)
+
+type GitlabOAuthStartHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewGitlabOAuthStartHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *GitlabOAuthStartHandler {
+	return &GitlabOAuthStartHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *GitlabOAuthStartHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	gi, ok := GetGitlabIntegrationFromRequest(c, w, r)
+
+	if !ok {
+		return
+	}
+
+	if gi.AuthMechanism != types.GitlabAuthOAuth {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("gitlab integration %d uses an access token and cannot be authorized with oauth", gi.ID),
+			http.StatusBadRequest,
+		))
+
+		return
+	}
+
+	state := oauth.CreateRandomState()
+
+	if err := c.PopulateOAuthSession(w, r, state, true); err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	// store the integration in the session, so the callback knows which integration
+	// the tokens belong to
+	session, err := c.Config().Store.Get(r, c.Config().ServerConf.CookieName)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	session.Values["gitlab_integration_id"] = gi.ID
+
+	if err := session.Save(r, w); err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	conf := gitlab.OAuthConfig(
+		gi.InstanceURL,
+		string(gi.ClientID),
+		string(gi.AppClientSecret),
+		getOAuthRedirectURL(c.Config().ServerConf.ServerURL),
+	)
+
+	// specify access type offline to get a refresh token
+	http.Redirect(w, r, conf.AuthCodeURL(state, oauth2.AccessTypeOffline), 302)
+}
@@ -0,0 +1,53 @@
+package image_policy
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+)
+
+// DeleteImagePolicyHandler removes the image policy of a project, or of a cluster if the
+// request is cluster-scoped. A cluster without a policy inherits the project policy.
+type DeleteImagePolicyHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewDeleteImagePolicyHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *DeleteImagePolicyHandler {
+	return &DeleteImagePolicyHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *DeleteImagePolicyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	policy, err := c.Repo().ImagePolicy().ReadImagePolicy(proj.ID, getClusterID(r))
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("image policy not found"),
+			http.StatusNotFound,
+		))
+
+		return
+	} else if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if err := c.Repo().ImagePolicy().DeleteImagePolicy(policy); err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+}
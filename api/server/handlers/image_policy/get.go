@@ -0,0 +1,54 @@
+package image_policy
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/imagepolicy"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// GetImagePolicyHandler returns the image policy of a project, or of a cluster if the
+// request is cluster-scoped
+type GetImagePolicyHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewGetImagePolicyHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *GetImagePolicyHandler {
+	return &GetImagePolicyHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *GetImagePolicyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	policy, inherited, err := imagepolicy.GetPolicy(c.Repo(), proj.ID, getClusterID(r))
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	c.WriteResult(w, r, &types.GetImagePolicyResponse{
+		Policy:    policy,
+		Inherited: inherited,
+	})
+}
+
+// getClusterID returns the id of the cluster in the request scope, or 0 for project-scoped
+// requests
+func getClusterID(r *http.Request) uint {
+	if cluster, ok := r.Context().Value(types.ClusterScope).(*models.Cluster); ok && cluster != nil {
+		return cluster.ID
+	}
+
+	return 0
+}
@@ -0,0 +1,57 @@
+package image_policy
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/imagepolicy"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// UpdateImagePolicyHandler sets the image policy of a project, or of a cluster if the
+// request is cluster-scoped
+type UpdateImagePolicyHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewUpdateImagePolicyHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *UpdateImagePolicyHandler {
+	return &UpdateImagePolicyHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *UpdateImagePolicyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	request := &types.UpdateImagePolicyRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	policy := types.ImagePolicy(*request)
+
+	if err := imagepolicy.ValidatePolicy(&policy); err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	model, err := imagepolicy.SavePolicy(c.Repo(), proj.ID, getClusterID(r), &policy)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	c.WriteResult(w, r, &types.GetImagePolicyResponse{
+		Policy: model.ToImagePolicyType(),
+	})
+}
@@ -0,0 +1,57 @@
+package incident
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/incidents"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type AcknowledgeIncidentHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewAcknowledgeIncidentHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *AcknowledgeIncidentHandler {
+	return &AcknowledgeIncidentHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *AcknowledgeIncidentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	user, _ := r.Context().Value(types.UserScope).(*models.User)
+
+	request := &types.AcknowledgeIncidentRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	incident, ok := readIncident(c, w, r)
+
+	if !ok {
+		return
+	}
+
+	assignee := user
+
+	if request.AssigneeID != 0 && request.AssigneeID != user.ID {
+		if assignee, ok = readAssignee(c, w, r, request.AssigneeID); !ok {
+			return
+		}
+	}
+
+	if err := incidents.Acknowledge(c.Repo(), incident, user, assignee); err != nil {
+		handleIncidentError(c, w, r, err)
+		return
+	}
+
+	c.WriteResult(w, r, incident.ToIncidentRecordType())
+}
@@ -0,0 +1,55 @@
+package incident
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/incidents"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type AssignIncidentHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewAssignIncidentHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *AssignIncidentHandler {
+	return &AssignIncidentHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *AssignIncidentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	user, _ := r.Context().Value(types.UserScope).(*models.User)
+
+	request := &types.AssignIncidentRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	incident, ok := readIncident(c, w, r)
+
+	if !ok {
+		return
+	}
+
+	assignee, ok := readAssignee(c, w, r, request.AssigneeID)
+
+	if !ok {
+		return
+	}
+
+	if err := incidents.Assign(c.Repo(), incident, user, assignee); err != nil {
+		handleIncidentError(c, w, r, err)
+		return
+	}
+
+	c.WriteResult(w, r, incident.ToIncidentRecordType())
+}
@@ -0,0 +1,52 @@
+package incident
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/incidents"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type CreateIncidentNoteHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewCreateIncidentNoteHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateIncidentNoteHandler {
+	return &CreateIncidentNoteHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *CreateIncidentNoteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	user, _ := r.Context().Value(types.UserScope).(*models.User)
+
+	request := &types.CreateIncidentNoteRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	incident, ok := readIncident(c, w, r)
+
+	if !ok {
+		return
+	}
+
+	note, err := incidents.AddNote(c.Repo(), incident, user, request.Text)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	c.WriteResult(w, r, note.ToIncidentNoteType())
+}
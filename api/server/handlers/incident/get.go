@@ -0,0 +1,57 @@
+package incident
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type GetIncidentRecordHandler struct {
+	handlers.PorterHandlerWriter
+	authz.KubernetesAgentGetter
+}
+
+func NewGetIncidentRecordHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *GetIncidentRecordHandler {
+	return &GetIncidentRecordHandler{
+		PorterHandlerWriter:   handlers.NewDefaultPorterHandler(config, nil, writer),
+		KubernetesAgentGetter: authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+func (c *GetIncidentRecordHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+
+	incident, ok := readIncident(c, w, r)
+
+	if !ok {
+		return
+	}
+
+	deployEvents, err := getDeployEvents(c, r, cluster, incident)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := &types.GetIncidentRecordResponse{
+		IncidentRecord: incident.ToIncidentRecordType(),
+		Timeline:       make([]*types.IncidentNote, 0),
+		DeployEvents:   deployEvents,
+	}
+
+	for _, note := range incident.Notes {
+		res.Timeline = append(res.Timeline, note.ToIncidentNoteType())
+	}
+
+	c.WriteResult(w, r, res)
+}
@@ -0,0 +1,50 @@
+package incident
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/incidents"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type GetIncidentPostmortemHandler struct {
+	handlers.PorterHandlerWriter
+	authz.KubernetesAgentGetter
+}
+
+func NewGetIncidentPostmortemHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *GetIncidentPostmortemHandler {
+	return &GetIncidentPostmortemHandler{
+		PorterHandlerWriter:   handlers.NewDefaultPorterHandler(config, nil, writer),
+		KubernetesAgentGetter: authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+func (c *GetIncidentPostmortemHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+
+	incident, ok := readIncident(c, w, r)
+
+	if !ok {
+		return
+	}
+
+	deployEvents, err := getDeployEvents(c, r, cluster, incident)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	c.WriteResult(w, r, &types.GetIncidentPostmortemResponse{
+		Markdown: incidents.GetPostmortem(incident, deployEvents),
+	})
+}
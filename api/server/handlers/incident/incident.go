@@ -0,0 +1,107 @@
+package incident
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/incidents"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+	"helm.sh/helm/v3/pkg/storage/driver"
+)
+
+// readIncident reads the incident in the URL of the request. It writes an error and returns
+// false if the incident cannot be read.
+func readIncident(c handlers.PorterHandler, w http.ResponseWriter, r *http.Request) (*models.Incident, bool) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+
+	id, reqErr := requestutils.GetURLParamUint(r, types.URLParamIncidentRecordID)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return nil, false
+	}
+
+	incident, err := c.Repo().Incident().ReadIncident(proj.ID, cluster.ID, id)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("incident %d not found", id),
+			http.StatusNotFound,
+		))
+
+		return nil, false
+	} else if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return nil, false
+	}
+
+	return incident, true
+}
+
+// readAssignee reads the user an incident is assigned to, who must be a member of the
+// project. It writes an error and returns false if the user cannot be read.
+func readAssignee(c handlers.PorterHandler, w http.ResponseWriter, r *http.Request, assigneeID uint) (*models.User, bool) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	if _, err := c.Repo().Project().ReadProjectRole(proj.ID, assigneeID); errors.Is(err, gorm.ErrRecordNotFound) {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("user %d is not a member of the project", assigneeID),
+			http.StatusBadRequest,
+		))
+
+		return nil, false
+	} else if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return nil, false
+	}
+
+	assignee, err := c.Repo().User().ReadUser(assigneeID)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return nil, false
+	}
+
+	return assignee, true
+}
+
+// getDeployEvents returns the deploys linked to an incident. The release of an incident may
+// have been deleted since, in which case no deploys are linked to it.
+func getDeployEvents(
+	agentGetter authz.KubernetesAgentGetter,
+	r *http.Request,
+	cluster *models.Cluster,
+	incident *models.Incident,
+) ([]*types.IncidentDeployEvent, error) {
+	helmAgent, err := agentGetter.GetHelmAgent(r, cluster, incident.Namespace)
+
+	if err != nil {
+		return nil, err
+	}
+
+	deployEvents, err := incidents.GetDeployEvents(helmAgent, incident)
+
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		return make([]*types.IncidentDeployEvent, 0), nil
+	}
+
+	return deployEvents, err
+}
+
+// handleIncidentError writes the error of an incident status change
+func handleIncidentError(c handlers.PorterHandler, w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, incidents.ErrIncidentResolved) {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+}
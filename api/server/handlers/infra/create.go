@@ -201,6 +201,10 @@ func getSourceLinkAndVersion(kind types.InfraKind) (string, string) {
 		return "porter/aws/eks", "v0.1.0"
 	case types.InfraRDS:
 		return "porter/aws/rds", "v0.1.0"
+	case types.InfraCloudSQL:
+		return "porter/gcp/cloudsql", "v0.1.0"
+	case types.InfraDOPG:
+		return "porter/do/dopg", "v0.1.0"
 	case types.InfraGCR:
 		return "porter/gcp/gcr", "v0.1.0"
 	case types.InfraGKE:
@@ -0,0 +1,72 @@
+package infra
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type InfraCreateNodePoolHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewInfraCreateNodePoolHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *InfraCreateNodePoolHandler {
+	return &InfraCreateNodePoolHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *InfraCreateNodePoolHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+	infra, _ := r.Context().Value(types.InfraScope).(*models.Infra)
+
+	req := &types.CreateNodePoolRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, req); !ok {
+		return
+	}
+
+	values, pools, reqErr := getNodePoolValues(c.Repo().Infra(), infra)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	if _, pool := findNodePool(pools, req.Name); pool != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("node pool %s already exists", req.Name),
+			http.StatusConflict,
+		))
+
+		return
+	}
+
+	pools = append(pools, &types.NodePool{
+		Name:         req.Name,
+		InstanceType: req.InstanceType,
+		MinSize:      req.MinSize,
+		MaxSize:      req.MaxSize,
+		Labels:       req.Labels,
+		Taints:       req.Taints,
+	})
+
+	op, reqErr := applyNodePools(c.Config(), proj, infra, req.InfraCredentials, values, pools)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	c.WriteResult(w, r, op)
+}
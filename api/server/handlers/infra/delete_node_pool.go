@@ -0,0 +1,229 @@
+package infra
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/nodepool"
+	"github.com/porter-dev/porter/internal/kubernetes/nodes"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+	"k8s.io/client-go/kubernetes"
+)
+
+type InfraDeleteNodePoolHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+func NewInfraDeleteNodePoolHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *InfraDeleteNodePoolHandler {
+	return &InfraDeleteNodePoolHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+func (c *InfraDeleteNodePoolHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+	infra, _ := r.Context().Value(types.InfraScope).(*models.Infra)
+
+	name, reqErr := requestutils.GetURLParamString(r, types.URLParamNodePoolName)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	req := &types.DeleteNodePoolRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, req); !ok {
+		return
+	}
+
+	// the credentials are verified before the nodes of the pool are touched
+	if err := checkInfraCredentials(c.Config(), proj, infra, req.InfraCredentials); err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrForbidden(err))
+		return
+	}
+
+	values, pools, reqErr := getNodePoolValues(c.Repo().Infra(), infra)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	i, pool := findNodePool(pools, name)
+
+	if pool == nil {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("node pool %s not found", name),
+			http.StatusNotFound,
+		))
+
+		return
+	}
+
+	if len(pools) == 1 {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("cannot remove the only node pool in the cluster"),
+			http.StatusBadRequest,
+		))
+
+		return
+	}
+
+	pools = append(pools[:i], pools[i+1:]...)
+
+	var clientset kubernetes.Interface
+	nodeNames := make([]string, 0)
+
+	if !req.SkipDrain {
+		clientset, nodeNames, reqErr = c.getNodePoolNodes(r, proj, infra, name)
+
+		if reqErr != nil {
+			c.HandleAPIError(w, r, reqErr)
+			return
+		}
+	}
+
+	if len(nodeNames) == 0 {
+		op, reqErr := applyNodePools(c.Config(), proj, infra, req.InfraCredentials, values, pools)
+
+		if reqErr != nil {
+			c.HandleAPIError(w, r, reqErr)
+			return
+		}
+
+		c.WriteResult(w, r, op)
+		return
+	}
+
+	// draining can take as long as the pods of the pool take to terminate, so the nodes are
+	// drained in the background, and the progress is reported through a drain operation
+	// which also blocks other changes to the node pools until it finishes
+	op, err := createDrainOperation(c.Repo().Infra(), infra, values)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res, err := op.ToOperationType()
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	go c.drainAndRemoveNodePool(op, proj, infra, req.InfraCredentials, values, pools, clientset, name, nodeNames)
+
+	w.WriteHeader(http.StatusAccepted)
+	c.WriteResult(w, r, res)
+}
+
+// getNodePoolNodes returns the names of the nodes in a node pool, along with a client for
+// the cluster of the infra. No nodes are returned if the cluster has not been created.
+func (c *InfraDeleteNodePoolHandler) getNodePoolNodes(
+	r *http.Request,
+	proj *models.Project,
+	infra *models.Infra,
+	name string,
+) (kubernetes.Interface, []string, apierrors.RequestError) {
+	nodeNames := make([]string, 0)
+
+	cluster, err := c.Repo().Cluster().ReadClusterByInfraID(proj.ID, infra.ID)
+
+	if err == gorm.ErrRecordNotFound {
+		return nil, nodeNames, nil
+	} else if err != nil {
+		return nil, nil, apierrors.NewErrInternal(err)
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+
+	if err != nil {
+		return nil, nil, apierrors.NewErrInternal(err)
+	}
+
+	for _, node := range nodes.GetNodesUsage(agent.Clientset) {
+		if nodepool.PoolName(infra.Kind, node.Labels) == name {
+			nodeNames = append(nodeNames, node.Name)
+		}
+	}
+
+	return agent.Clientset, nodeNames, nil
+}
+
+// createDrainOperation records a drain operation for an infra. The last-applied values of
+// the operation are the current values, since the node pools do not change until the drain
+// completes.
+func createDrainOperation(
+	repo repository.InfraRepository,
+	infra *models.Infra,
+	values map[string]interface{},
+) (*models.Operation, error) {
+	uid, err := models.GetOperationID()
+
+	if err != nil {
+		return nil, err
+	}
+
+	valuesJSON, err := json.Marshal(values)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.AddOperation(infra, &models.Operation{
+		UID:         uid,
+		InfraID:     infra.ID,
+		Type:        "drain",
+		Status:      "starting",
+		LastApplied: valuesJSON,
+	})
+}
+
+// drainAndRemoveNodePool cordons and drains the nodes in a node pool, so that workloads are
+// moved to the remaining pools, and then removes the pool. The result is recorded in the
+// drain operation.
+func (c *InfraDeleteNodePoolHandler) drainAndRemoveNodePool(
+	op *models.Operation,
+	proj *models.Project,
+	infra *models.Infra,
+	creds *types.InfraCredentials,
+	values map[string]interface{},
+	pools []*types.NodePool,
+	clientset kubernetes.Interface,
+	name string,
+	nodeNames []string,
+) {
+	op.Status = "completed"
+
+	if err := nodepool.CordonAndDrain(clientset, nodeNames); err != nil {
+		op.Status = "errored"
+		op.Errored = true
+		op.Error = fmt.Sprintf("could not drain node pool %s, please try again: %s", name, err.Error())
+	} else if _, reqErr := applyNodePools(c.Config(), proj, infra, creds, values, pools); reqErr != nil {
+		op.Status = "errored"
+		op.Errored = true
+		op.Error = fmt.Sprintf("node pool %s was drained, but could not be removed: %s", name, reqErr.ExternalError())
+	}
+
+	if _, err := c.Repo().Infra().UpdateOperation(op); err != nil {
+		c.Config().Logger.Error().Err(err).Msgf("could not update drain operation %s", op.UID)
+	}
+}
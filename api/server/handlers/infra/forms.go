@@ -324,6 +324,196 @@ tabs:
       settings:
         default: 0`
 
+const cloudsqlForm = `name: Cloud SQL
+hasSource: false
+includeHiddenFields: true
+isClusterScoped: true
+tabs:
+- name: main
+  label: Main
+  sections:
+  - name: heading
+    contents: 
+    - type: heading
+      label: Database Settings
+  - name: user
+    contents:
+    - type: string-input
+      label: Database Master User
+      required: true
+      placeholder: "admin"
+      variable: db_user
+  - name: password
+    contents:
+    - type: string-input
+      required: true
+      label: Database Master Password
+      variable: db_passwd
+  - name: name
+    contents:
+    - type: string-input
+      label: Database Name
+      required: true
+      placeholder: "cloudsql-staging"
+      variable: db_name
+  - name: region
+    contents:
+    - type: select
+      label: 📍 GCP Region
+      variable: gcp_region
+      settings:
+        default: us-central1
+        options:
+        - label: asia-east1
+          value: asia-east1
+        - label: europe-west1
+          value: europe-west1
+        - label: us-central1
+          value: us-central1
+        - label: us-east1
+          value: us-east1
+        - label: us-west1
+          value: us-west1
+  - name: machine-type
+    contents:
+    - type: select
+      label: ⚙️ Database Machine Type
+      variable: machine_type
+      settings:
+        default: db-custom-1-3840
+        options:
+        - label: db-f1-micro
+          value: db-f1-micro
+        - label: db-g1-small
+          value: db-g1-small
+        - label: db-custom-1-3840
+          value: db-custom-1-3840
+        - label: db-custom-2-7680
+          value: db-custom-2-7680
+        - label: db-custom-4-15360
+          value: db-custom-4-15360
+  - name: versions
+    contents:
+    - type: select
+      label: Database Version
+      variable: db_engine_version
+      settings:
+        default: POSTGRES_13
+        options:
+        - label: "Postgres 11"
+          value: POSTGRES_11
+        - label: "Postgres 12"
+          value: POSTGRES_12
+        - label: "Postgres 13"
+          value: POSTGRES_13
+        - label: "Postgres 14"
+          value: POSTGRES_14
+  - name: storage
+    contents:
+    - type: number-input
+      label: Specify the storage for this instance in gigabytes.
+      variable: db_allocated_storage
+      placeholder: "ex: 10"
+      settings:
+        default: 10
+`
+
+const dopgForm = `name: DigitalOcean Managed Postgres
+hasSource: false
+includeHiddenFields: true
+isClusterScoped: true
+tabs:
+- name: main
+  label: Main
+  sections:
+  - name: heading
+    contents: 
+    - type: heading
+      label: Database Settings
+  - name: user
+    contents:
+    - type: string-input
+      label: Database User
+      required: true
+      placeholder: "porter"
+      variable: db_user
+  - name: name
+    contents:
+    - type: string-input
+      label: Database Name
+      required: true
+      placeholder: "pg-staging"
+      variable: db_name
+  - name: region
+    contents:
+    - type: select
+      label: 📍 DO Region
+      variable: do_region
+      settings:
+        default: nyc1
+        options:
+        - label: Amsterdam 3
+          value: ams3
+        - label: Bangalore 1
+          value: blr1
+        - label: Frankfurt 1
+          value: fra1
+        - label: London 1
+          value: lon1
+        - label: New York 1
+          value: nyc1
+        - label: New York 3
+          value: nyc3
+        - label: San Francisco 2
+          value: sfo2
+        - label: San Francisco 3
+          value: sfo3
+        - label: Singapore 1
+          value: sgp1
+        - label: Toronto 1
+          value: tor1
+  - name: machine-type
+    contents:
+    - type: select
+      label: ⚙️ Database Node Size
+      variable: machine_type
+      settings:
+        default: db-s-1vcpu-2gb
+        options:
+        - label: db-s-1vcpu-1gb
+          value: db-s-1vcpu-1gb
+        - label: db-s-1vcpu-2gb
+          value: db-s-1vcpu-2gb
+        - label: db-s-2vcpu-4gb
+          value: db-s-2vcpu-4gb
+        - label: db-s-4vcpu-8gb
+          value: db-s-4vcpu-8gb
+  - name: versions
+    contents:
+    - type: select
+      label: Database Version
+      variable: db_engine_version
+      settings:
+        default: "13"
+        options:
+        - label: "Postgres 11"
+          value: "11"
+        - label: "Postgres 12"
+          value: "12"
+        - label: "Postgres 13"
+          value: "13"
+        - label: "Postgres 14"
+          value: "14"
+  - name: nodes
+    contents:
+    - type: number-input
+      label: Number of nodes in the database cluster.
+      variable: db_node_count
+      placeholder: "ex: 1"
+      settings:
+        default: 1
+`
+
 const ecrForm = `name: ECR
 hasSource: false
 includeHiddenFields: true
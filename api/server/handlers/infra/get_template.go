@@ -67,6 +67,10 @@ func getFormBytesFromKind(kind string) []byte {
 		formBytes = []byte(ecrForm)
 	case "rds":
 		formBytes = []byte(rdsForm)
+	case "cloudsql":
+		formBytes = []byte(cloudsqlForm)
+	case "dopg":
+		formBytes = []byte(dopgForm)
 	case "eks":
 		formBytes = []byte(eksForm)
 	case "gcr":
@@ -0,0 +1,87 @@
+package infra
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/nodepool"
+	"github.com/porter-dev/porter/internal/kubernetes/nodes"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+)
+
+type InfraListNodePoolsHandler struct {
+	handlers.PorterHandlerWriter
+	authz.KubernetesAgentGetter
+}
+
+func NewInfraListNodePoolsHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *InfraListNodePoolsHandler {
+	return &InfraListNodePoolsHandler{
+		PorterHandlerWriter:   handlers.NewDefaultPorterHandler(config, nil, writer),
+		KubernetesAgentGetter: authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+func (c *InfraListNodePoolsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+	infra, _ := r.Context().Value(types.InfraScope).(*models.Infra)
+
+	if !nodepool.SupportsNodePools(infra.Kind) {
+		c.WriteResult(w, r, types.ListNodePoolsResponse{})
+		return
+	}
+
+	operation, err := c.Repo().Infra().GetLatestOperation(infra)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	op, err := operation.ToOperationType()
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	pools, err := nodepool.GetNodePools(infra.Kind, op.LastApplied)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	// attach the current nodes if the cluster has been created
+	cluster, err := c.Repo().Cluster().ReadClusterByInfraID(proj.ID, infra.ID)
+
+	if err != nil && err != gorm.ErrRecordNotFound {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	nodeList := make([]*nodes.NodeWithUsageData, 0)
+
+	if cluster != nil {
+		agent, err := c.GetAgent(r, cluster, "")
+
+		if err != nil {
+			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+
+		nodeList = nodes.GetNodesUsage(agent.Clientset)
+	}
+
+	res := types.ListNodePoolsResponse(nodepool.AttachNodes(infra.Kind, pools, nodeList))
+
+	c.WriteResult(w, r, res)
+}
@@ -57,6 +57,22 @@ var templateMap = map[string]*types.InfraTemplateMeta{
 		Kind:               "rds",
 		RequiredCredential: "aws_integration_id",
 	},
+	"cloudsql": {
+		Icon:               "",
+		Description:        "Create a Cloud SQL for PostgreSQL instance.",
+		Name:               "Cloud SQL",
+		Version:            "v0.1.0",
+		Kind:               "cloudsql",
+		RequiredCredential: "gcp_integration_id",
+	},
+	"dopg": {
+		Icon:               "",
+		Description:        "Create a Digital Ocean Managed PostgreSQL cluster.",
+		Name:               "DO Managed Postgres",
+		Version:            "v0.1.0",
+		Kind:               "dopg",
+		RequiredCredential: "do_integration_id",
+	},
 	"eks": {
 		Icon:               "https://img.stackshare.io/service/7991/amazon-eks.png",
 		Description:        "Create an Elastic Kubernetes Service cluster.",
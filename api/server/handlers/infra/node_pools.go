@@ -0,0 +1,98 @@
+package infra
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/nodepool"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	ptypes "github.com/porter-dev/porter/provisioner/types"
+)
+
+// getNodePoolValues returns the last-applied values for an infra and the node pools
+// configured in those values. It returns an error if node pools cannot be changed.
+func getNodePoolValues(
+	repo repository.InfraRepository,
+	infra *models.Infra,
+) (map[string]interface{}, []*types.NodePool, apierrors.RequestError) {
+	if !nodepool.SupportsNodePools(infra.Kind) {
+		return nil, nil, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("node pools are not supported for infra of kind %s", infra.Kind),
+			http.StatusBadRequest,
+		)
+	}
+
+	lastOperation, err := repo.GetLatestOperation(infra)
+
+	if err != nil {
+		return nil, nil, apierrors.NewErrInternal(err)
+	}
+
+	// if the last operation is in a "starting" state, block changes
+	if lastOperation.Status == "starting" {
+		return nil, nil, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("Operation currently in progress. Please try again when latest operation has completed."),
+			http.StatusBadRequest,
+		)
+	}
+
+	values := make(map[string]interface{})
+
+	if err := json.Unmarshal(lastOperation.LastApplied, &values); err != nil {
+		return nil, nil, apierrors.NewErrInternal(err)
+	}
+
+	pools, err := nodepool.GetNodePools(infra.Kind, values)
+
+	if err != nil {
+		return nil, nil, apierrors.NewErrInternal(err)
+	}
+
+	return values, pools, nil
+}
+
+// applyNodePools writes the node pools to the infra values and triggers an update operation
+// on the provisioner
+func applyNodePools(
+	config *config.Config,
+	proj *models.Project,
+	infra *models.Infra,
+	creds *types.InfraCredentials,
+	values map[string]interface{},
+	pools []*types.NodePool,
+) (*types.Operation, apierrors.RequestError) {
+	// verify the credentials
+	if err := checkInfraCredentials(config, proj, infra, creds); err != nil {
+		return nil, apierrors.NewErrForbidden(err)
+	}
+
+	nodepool.SetNodePools(infra.Kind, values, pools)
+
+	resp, err := config.ProvisionerClient.Apply(context.Background(), proj.ID, infra.ID, &ptypes.ApplyBaseRequest{
+		Kind:          string(infra.Kind),
+		Values:        values,
+		OperationKind: "update",
+	})
+
+	if err != nil {
+		return nil, apierrors.NewErrInternal(err)
+	}
+
+	return resp, nil
+}
+
+func findNodePool(pools []*types.NodePool, name string) (int, *types.NodePool) {
+	for i, pool := range pools {
+		if pool.Name == name {
+			return i, pool
+		}
+	}
+
+	return -1, nil
+}
@@ -0,0 +1,94 @@
+package infra
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type InfraUpdateNodePoolHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewInfraUpdateNodePoolHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *InfraUpdateNodePoolHandler {
+	return &InfraUpdateNodePoolHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *InfraUpdateNodePoolHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+	infra, _ := r.Context().Value(types.InfraScope).(*models.Infra)
+
+	name, reqErr := requestutils.GetURLParamString(r, types.URLParamNodePoolName)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	req := &types.UpdateNodePoolRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, req); !ok {
+		return
+	}
+
+	values, pools, reqErr := getNodePoolValues(c.Repo().Infra(), infra)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	_, pool := findNodePool(pools, name)
+
+	if pool == nil {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("node pool %s not found", name),
+			http.StatusNotFound,
+		))
+
+		return
+	}
+
+	if req.InstanceType != "" {
+		pool.InstanceType = req.InstanceType
+	}
+
+	if req.MinSize != nil {
+		pool.MinSize = *req.MinSize
+	}
+
+	if req.MaxSize != nil {
+		pool.MaxSize = *req.MaxSize
+	}
+
+	if pool.MinSize > pool.MaxSize {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("min size %d cannot be greater than max size %d", pool.MinSize, pool.MaxSize),
+			http.StatusBadRequest,
+		))
+
+		return
+	}
+
+	op, reqErr := applyNodePools(c.Config(), proj, infra, req.InfraCredentials, values, pools)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	c.WriteResult(w, r, op)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/helm"
+	"github.com/porter-dev/porter/internal/imagepolicy"
 	"github.com/porter-dev/porter/internal/kubernetes/envgroup"
 	"github.com/porter-dev/porter/internal/models"
 )
@@ -131,6 +132,12 @@ func rolloutApplications(
 		return []error{err}
 	}
 
+	imagePolicy, _, err := imagepolicy.GetPolicy(config.Repo, cluster.ProjectID, cluster.ID)
+
+	if err != nil {
+		return []error{err}
+	}
+
 	// construct the synced env section that should be written
 	newSection := &SyncedEnvSection{
 		Name:    envGroup.Name,
@@ -176,11 +183,12 @@ func rolloutApplications(
 			}
 
 			conf := &helm.UpgradeReleaseConfig{
-				Name:       releases[index].Name,
-				Cluster:    cluster,
-				Repo:       config.Repo,
-				Registries: registries,
-				Values:     newConfig,
+				Name:        releases[index].Name,
+				Cluster:     cluster,
+				Repo:        config.Repo,
+				Registries:  registries,
+				Values:      newConfig,
+				ImagePolicy: imagePolicy,
 			}
 
 			_, err = helmAgent.UpgradeReleaseByValues(conf, config.DOConf)
@@ -47,6 +47,14 @@ func (p *RoleDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	if err != nil {
 		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	// remove the user from the teams of the project, so that they do not regain the
+	// permissions of their teams if they are invited to the project again
+	if err := p.Repo().Team().RemoveUserFromTeams(proj.ID, request.UserID); err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
 	}
 
 	res := &types.DeleteRoleResponse{
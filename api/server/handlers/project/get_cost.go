@@ -0,0 +1,125 @@
+package project
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/cost"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type ProjectGetCostHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+func NewProjectGetCostHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *ProjectGetCostHandler {
+	return &ProjectGetCostHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+// ServeHTTP computes the cost of every cluster in the project, and reports the allocated
+// cost of each cluster
+func (p *ProjectGetCostHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	request := &types.GetProjectCostRequest{}
+
+	if ok := p.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	window, err := cost.ParseWindow(request.Since)
+
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	if request.Method == "" {
+		request.Method = types.CostAllocationMethodRequests
+	}
+
+	clusters, err := p.Repo().Cluster().ListClustersByProjectID(proj.ID)
+
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	now := time.Now()
+
+	res := &types.GetCostAllocationResponse{
+		By:            types.CostAllocationGroupCluster,
+		Method:        request.Method,
+		Start:         now.Add(-1 * window),
+		End:           now,
+		UnpricedNodes: make([]string, 0),
+		Allocations:   make([]*types.CostAllocation, 0),
+	}
+
+	for _, cluster := range clusters {
+		conf, err := cost.GetClusterCostConfig(p.Repo(), proj.ID, cluster.ID)
+
+		if err != nil {
+			p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+
+		agent, err := p.GetAgent(r, cluster, "")
+
+		if err != nil {
+			p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+
+		report, err := cost.GetCostReport(agent.Clientset, cost.NewPricing(conf), &cost.ReportOpts{
+			By:     types.CostAllocationGroupNamespace,
+			Method: request.Method,
+			Window: window,
+		})
+
+		if err != nil {
+			p.HandleAPIError(w, r, apierrors.NewErrInternal(fmt.Errorf("cluster %s: %w", cluster.Name, err)))
+			return
+		}
+
+		alloc := &types.CostAllocation{
+			Name: cluster.Name,
+		}
+
+		for _, nsAlloc := range report.Allocations {
+			alloc.CPUCoreHours += nsAlloc.CPUCoreHours
+			alloc.MemoryGBHours += nsAlloc.MemoryGBHours
+			alloc.CPUCost += nsAlloc.CPUCost
+			alloc.MemoryCost += nsAlloc.MemoryCost
+			alloc.TotalCost += nsAlloc.TotalCost
+		}
+
+		res.Allocations = append(res.Allocations, alloc)
+		res.TotalCost += report.TotalCost
+		res.AllocatedCost += report.AllocatedCost
+		res.IdleCost += report.IdleCost
+		res.IdleCPUCoreHours += report.IdleCPUCoreHours
+		res.IdleMemoryGBHours += report.IdleMemoryGBHours
+
+		for _, node := range report.UnpricedNodes {
+			res.UnpricedNodes = append(res.UnpricedNodes, cluster.Name+"/"+node)
+		}
+	}
+
+	p.WriteResult(w, r, res)
+}
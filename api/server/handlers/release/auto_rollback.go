@@ -0,0 +1,44 @@
+package release
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/internal/autorollback"
+	"github.com/porter-dev/porter/internal/helm"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// getAutoRollbackWatcher returns a watcher which rolls back the release if the rollout of an
+// upgrade fails its health checks, or nil if auto-rollback is not enabled for the release
+func getAutoRollbackWatcher(
+	config *config.Config,
+	agentGetter authz.KubernetesAgentGetter,
+	r *http.Request,
+	cluster *models.Cluster,
+	helmAgent *helm.Agent,
+	rel *models.Release,
+) (*autorollback.Watcher, error) {
+	if rel == nil || !rel.AutoRollbackEnabled {
+		return nil, nil
+	}
+
+	agent, err := agentGetter.GetAgent(r, cluster, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the watcher runs in the background and records its steps on the release, so it gets
+	// its own copy of the release
+	watchedRel := *rel
+
+	return &autorollback.Watcher{
+		Repo:      config.Repo,
+		HelmAgent: helmAgent,
+		K8sAgent:  agent,
+		Cluster:   cluster,
+		Release:   &watchedRel,
+	}, nil
+}
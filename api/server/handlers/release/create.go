@@ -17,6 +17,7 @@ import (
 	"github.com/porter-dev/porter/internal/encryption"
 	"github.com/porter-dev/porter/internal/helm"
 	"github.com/porter-dev/porter/internal/helm/loader"
+	"github.com/porter-dev/porter/internal/imagepolicy"
 	"github.com/porter-dev/porter/internal/integrations/ci/actions"
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/oauth"
@@ -90,23 +91,28 @@ func (c *CreateReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	imagePolicy, _, err := imagepolicy.GetPolicy(c.Repo(), cluster.ProjectID, cluster.ID)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
 	conf := &helm.InstallChartConfig{
-		Chart:      chart,
-		Name:       request.Name,
-		Namespace:  namespace,
-		Values:     request.Values,
-		Cluster:    cluster,
-		Repo:       c.Repo(),
-		Registries: registries,
+		Chart:       chart,
+		Name:        request.Name,
+		Namespace:   namespace,
+		Values:      request.Values,
+		Cluster:     cluster,
+		Repo:        c.Repo(),
+		Registries:  registries,
+		ImagePolicy: imagePolicy,
 	}
 
 	helmRelease, err := helmAgent.InstallChart(conf, c.Config().DOConf)
 
 	if err != nil {
-		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
-			fmt.Errorf("error installing a new chart: %s", err.Error()),
-			http.StatusBadRequest,
-		))
+		c.HandleAPIError(w, r, newDeployError(fmt.Errorf("error installing a new chart: %w", err)))
 
 		return
 	}
@@ -0,0 +1,63 @@
+package release
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type CreateBuildAttestationHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewCreateBuildAttestationHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateBuildAttestationHandler {
+	return &CreateBuildAttestationHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *CreateBuildAttestationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	name, _ := requestutils.GetURLParamString(r, types.URLParamReleaseName)
+	namespace := r.Context().Value(types.NamespaceScope).(string)
+
+	request := &types.CreateBuildAttestationRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	attestation, err := c.Repo().BuildAttestation().CreateBuildAttestation(&models.BuildAttestation{
+		ProjectID:     cluster.ProjectID,
+		ClusterID:     cluster.ID,
+		Name:          name,
+		Namespace:     namespace,
+		ImageRepoURI:  request.ImageRepoURI,
+		Tag:           request.Tag,
+		Digest:        request.Digest,
+		GitSHA:        request.GitSHA,
+		SBOMFormat:    string(request.SBOMFormat),
+		SBOMRef:       request.SBOMRef,
+		ProvenanceRef: request.ProvenanceRef,
+		SignatureRef:  request.SignatureRef,
+	})
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := types.CreateBuildAttestationResponse(*attestation.ToBuildAttestationType())
+
+	c.WriteResult(w, r, &res)
+}
@@ -0,0 +1,105 @@
+package release
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/prometheus"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+type CreateCustomMetricHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+func NewCreateCustomMetricHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateCustomMetricHandler {
+	return &CreateCustomMetricHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+func (c *CreateCustomMetricHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	helmRelease, _ := r.Context().Value(types.ReleaseScope).(*release.Release)
+
+	request := &types.CreateCustomMetricRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	data, reqErr := getCustomQueryData(helmRelease)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	query, err := prometheus.RenderCustomQuery(request.Query, data)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	promSvc, reqErr := getPrometheusService(agent)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	if err := prometheus.ValidateQuery(agent.Clientset, promSvc, query); err != nil {
+		c.HandleAPIError(w, r, handlePrometheusError(err))
+		return
+	}
+
+	metric, err := c.Repo().CustomMetric().ReadCustomMetric(
+		cluster.ProjectID, cluster.ID, helmRelease.Name, helmRelease.Namespace, request.Name,
+	)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		metric, err = c.Repo().CustomMetric().CreateCustomMetric(&models.CustomMetric{
+			ProjectID:   cluster.ProjectID,
+			ClusterID:   cluster.ID,
+			ReleaseName: helmRelease.Name,
+			Namespace:   helmRelease.Namespace,
+			Name:        request.Name,
+			Query:       request.Query,
+			Unit:        request.Unit,
+		})
+	} else if err == nil {
+		metric.Query = request.Query
+		metric.Unit = request.Unit
+
+		metric, err = c.Repo().CustomMetric().UpdateCustomMetric(metric)
+	}
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	c.WriteResult(w, r, metric.ToCustomMetricType())
+}
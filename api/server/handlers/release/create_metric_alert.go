@@ -0,0 +1,77 @@
+package release
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+type CreateMetricAlertRuleHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+func NewCreateMetricAlertRuleHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateMetricAlertRuleHandler {
+	return &CreateMetricAlertRuleHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+func (c *CreateMetricAlertRuleHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	helmRelease, _ := r.Context().Value(types.ReleaseScope).(*release.Release)
+
+	request := &types.CreateMetricAlertRuleRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	rule := &models.MetricAlertRule{
+		ProjectID:        cluster.ProjectID,
+		ClusterID:        cluster.ID,
+		ReleaseName:      helmRelease.Name,
+		Namespace:        helmRelease.Namespace,
+		Name:             request.Name,
+		Metric:           request.Metric,
+		CustomMetricName: request.CustomMetricName,
+		Comparator:       request.Comparator,
+		Threshold:        request.Threshold,
+		ForMinutes:       request.ForMinutes,
+		Enabled:          request.Enabled,
+		State:            types.MetricAlertStateOK,
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if reqErr := validateMetricAlertRule(c.Repo(), agent, helmRelease, rule); reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	rule, err = c.Repo().MetricAlertRule().CreateMetricAlertRule(rule)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	c.WriteResult(w, r, rule.ToMetricAlertRuleType())
+}
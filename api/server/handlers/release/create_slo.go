@@ -0,0 +1,66 @@
+package release
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/slo"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+type CreateSLOHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewCreateSLOHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateSLOHandler {
+	return &CreateSLOHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *CreateSLOHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	helmRelease, _ := r.Context().Value(types.ReleaseScope).(*release.Release)
+
+	request := &types.CreateSLORequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	obj := &models.SLO{
+		ProjectID:          cluster.ProjectID,
+		ClusterID:          cluster.ID,
+		ReleaseName:        helmRelease.Name,
+		Namespace:          helmRelease.Namespace,
+		Name:               request.Name,
+		Kind:               request.Kind,
+		Objective:          request.Objective,
+		LatencyThresholdMS: request.LatencyThresholdMS,
+		WindowDays:         request.WindowDays,
+		BlockDeploys:       request.BlockDeploys,
+	}
+
+	if err := slo.Validate(obj, helmRelease); err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	obj, err := c.Repo().SLO().CreateSLO(obj)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	c.WriteResult(w, r, obj.ToSLOType())
+}
@@ -0,0 +1,65 @@
+package release
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/internal/helm/grapher"
+	"github.com/porter-dev/porter/internal/kubernetes"
+	"github.com/porter-dev/porter/internal/kubernetes/prometheus"
+	"helm.sh/helm/v3/pkg/release"
+	v1 "k8s.io/api/core/v1"
+)
+
+// getCustomQueryData returns the data which the custom metric queries of a release are
+// rendered with
+func getCustomQueryData(helmRelease *release.Release) (*prometheus.CustomQueryData, apierrors.RequestError) {
+	yamlArr := grapher.ImportMultiDocYAML([]byte(helmRelease.Manifest))
+	controllers := make([]prometheus.Controller, 0)
+
+	for _, controller := range grapher.ParseControllers(yamlArr) {
+		controllers = append(controllers, prometheus.Controller{
+			Kind: controller.Kind,
+			Name: controller.Name,
+		})
+	}
+
+	data, err := prometheus.GetCustomQueryData(helmRelease.Namespace, helmRelease.Name, controllers)
+
+	if err != nil {
+		return nil, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest)
+	}
+
+	return data, nil
+}
+
+// getPrometheusService returns the Prometheus service which custom metric queries are sent to
+func getPrometheusService(agent *kubernetes.Agent) (*v1.Service, apierrors.RequestError) {
+	promSvc, found, err := prometheus.GetPrometheusService(agent.Clientset)
+
+	if err != nil {
+		return nil, apierrors.NewErrInternal(err)
+	}
+
+	if !found {
+		return nil, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("prometheus is not installed in this cluster"),
+			http.StatusPreconditionFailed,
+		)
+	}
+
+	return promSvc, nil
+}
+
+// handlePrometheusError returns rejected queries to the client
+func handlePrometheusError(err error) apierrors.RequestError {
+	var queryErr *prometheus.QueryError
+
+	if errors.As(err, &queryErr) {
+		return apierrors.NewErrPassThroughToClient(queryErr, http.StatusBadRequest)
+	}
+
+	return apierrors.NewErrInternal(err)
+}
@@ -0,0 +1,41 @@
+package release
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+type DeleteMetricAlertRuleHandler struct {
+	handlers.PorterHandler
+}
+
+func NewDeleteMetricAlertRuleHandler(
+	config *config.Config,
+) *DeleteMetricAlertRuleHandler {
+	return &DeleteMetricAlertRuleHandler{
+		PorterHandler: handlers.NewDefaultPorterHandler(config, nil, nil),
+	}
+}
+
+func (c *DeleteMetricAlertRuleHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	helmRelease, _ := r.Context().Value(types.ReleaseScope).(*release.Release)
+
+	rule, reqErr := readMetricAlertRule(r, c.Repo(), cluster, helmRelease)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	if err := c.Repo().MetricAlertRule().DeleteMetricAlertRule(rule); err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+}
@@ -0,0 +1,51 @@
+package release
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+)
+
+type GetDriftDetectionHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewGetDriftDetectionHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *GetDriftDetectionHandler {
+	return &GetDriftDetectionHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *GetDriftDetectionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	name, _ := requestutils.GetURLParamString(r, types.URLParamReleaseName)
+	namespace := r.Context().Value(types.NamespaceScope).(string)
+
+	conf, err := c.Repo().DriftDetectionConfig().ReadDriftDetectionConfig(cluster.ProjectID, cluster.ID, name, namespace)
+
+	if err != nil {
+		if err != gorm.ErrRecordNotFound {
+			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+
+		// drift detection has not been configured for this release, so return the defaults
+		conf = &models.DriftDetectionConfig{
+			IntervalMinutes: defaultDriftIntervalMinutes,
+		}
+	}
+
+	res := types.GetDriftDetectionConfigResponse(*conf.ToDriftDetectionConfigType())
+
+	c.WriteResult(w, r, &res)
+}
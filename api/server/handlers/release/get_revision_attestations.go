@@ -0,0 +1,78 @@
+package release
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+// GetRevisionAttestationsHandler returns the build attestations of the image deployed by
+// a release revision
+type GetRevisionAttestationsHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewGetRevisionAttestationsHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *GetRevisionAttestationsHandler {
+	return &GetRevisionAttestationsHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *GetRevisionAttestationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	helmRelease, _ := r.Context().Value(types.ReleaseScope).(*release.Release)
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+
+	res := make(types.ListBuildAttestationsResponse, 0)
+
+	imageRepoURI, tag := getReleaseImage(helmRelease.Config)
+
+	if imageRepoURI == "" || tag == "" {
+		c.WriteResult(w, r, res)
+		return
+	}
+
+	attestations, err := c.Repo().BuildAttestation().ListBuildAttestationsForImage(
+		cluster.ProjectID,
+		cluster.ID,
+		helmRelease.Name,
+		helmRelease.Namespace,
+		imageRepoURI,
+		tag,
+	)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	for _, attestation := range attestations {
+		attestationType := attestation.ToBuildAttestationType()
+		attestationType.Revision = helmRelease.Version
+
+		res = append(res, attestationType)
+	}
+
+	c.WriteResult(w, r, res)
+}
+
+func getReleaseImage(values map[string]interface{}) (string, string) {
+	image, ok := values["image"].(map[string]interface{})
+
+	if !ok {
+		return "", ""
+	}
+
+	repository, _ := image["repository"].(string)
+	tag, _ := image["tag"].(string)
+
+	return repository, tag
+}
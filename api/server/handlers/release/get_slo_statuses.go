@@ -0,0 +1,79 @@
+package release
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/slo"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+type GetSLOStatusesHandler struct {
+	handlers.PorterHandlerWriter
+	authz.KubernetesAgentGetter
+}
+
+func NewGetSLOStatusesHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *GetSLOStatusesHandler {
+	return &GetSLOStatusesHandler{
+		PorterHandlerWriter:   handlers.NewDefaultPorterHandler(config, nil, writer),
+		KubernetesAgentGetter: authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+func (c *GetSLOStatusesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	helmRelease, _ := r.Context().Value(types.ReleaseScope).(*release.Release)
+
+	slos, err := c.Repo().SLO().ListSLOs(cluster.ProjectID, cluster.ID, helmRelease.Name, helmRelease.Namespace)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := make(types.GetSLOStatusesResponse, 0)
+
+	if len(slos) == 0 {
+		c.WriteResult(w, r, res)
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	promSvc, reqErr := getPrometheusService(agent)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	now := time.Now().UTC()
+
+	for _, obj := range slos {
+		status, err := slo.GetStatus(agent.Clientset, promSvc, obj, helmRelease, now)
+
+		if err != nil {
+			c.HandleAPIError(w, r, handlePrometheusError(err))
+			return
+		}
+
+		res = append(res, status)
+	}
+
+	c.WriteResult(w, r, res)
+}
@@ -0,0 +1,32 @@
+package release
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/releasehooks"
+)
+
+// getHooksRunner returns a runner for the pre-deploy and post-deploy hooks of a release, or
+// nil if the release does not have hooks
+func getHooksRunner(
+	config *config.Config,
+	agentGetter authz.KubernetesAgentGetter,
+	r *http.Request,
+	cluster *models.Cluster,
+	rel *models.Release,
+) (*releasehooks.Runner, error) {
+	if rel == nil || rel.GetHooks() == nil {
+		return nil, nil
+	}
+
+	agent, err := agentGetter.GetAgent(r, cluster, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return releasehooks.NewRunner(config.Repo, agent, rel), nil
+}
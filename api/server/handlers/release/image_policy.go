@@ -0,0 +1,27 @@
+package release
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/imagepolicy"
+)
+
+// newDeployError returns the error for a failed install or upgrade. If the release was
+// rejected by the image policy, the violations are returned to the client as error details.
+func newDeployError(err error) apierrors.RequestError {
+	var violationErr *imagepolicy.ViolationError
+
+	if errors.As(err, &violationErr) {
+		return apierrors.NewErrWithDetails(
+			err,
+			http.StatusBadRequest,
+			types.ErrCodeImagePolicyViolation,
+			violationErr.Violations,
+		)
+	}
+
+	return apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest)
+}
@@ -0,0 +1,47 @@
+package release
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type ListBuildAttestationsHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewListBuildAttestationsHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListBuildAttestationsHandler {
+	return &ListBuildAttestationsHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *ListBuildAttestationsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	name, _ := requestutils.GetURLParamString(r, types.URLParamReleaseName)
+	namespace := r.Context().Value(types.NamespaceScope).(string)
+
+	attestations, err := c.Repo().BuildAttestation().ListBuildAttestationsForRelease(cluster.ProjectID, cluster.ID, name, namespace)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := make(types.ListBuildAttestationsResponse, 0, len(attestations))
+
+	for _, attestation := range attestations {
+		res = append(res, attestation.ToBuildAttestationType())
+	}
+
+	c.WriteResult(w, r, res)
+}
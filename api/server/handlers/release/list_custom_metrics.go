@@ -0,0 +1,48 @@
+package release
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+type ListCustomMetricsHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewListCustomMetricsHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListCustomMetricsHandler {
+	return &ListCustomMetricsHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *ListCustomMetricsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	helmRelease, _ := r.Context().Value(types.ReleaseScope).(*release.Release)
+
+	metrics, err := c.Repo().CustomMetric().ListCustomMetrics(
+		cluster.ProjectID, cluster.ID, helmRelease.Name, helmRelease.Namespace,
+	)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := make(types.ListCustomMetricsResponse, 0)
+
+	for _, metric := range metrics {
+		res = append(res, metric.ToCustomMetricType())
+	}
+
+	c.WriteResult(w, r, res)
+}
@@ -0,0 +1,42 @@
+package release
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+type ListMetricAlertRulesHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewListMetricAlertRulesHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListMetricAlertRulesHandler {
+	return &ListMetricAlertRulesHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *ListMetricAlertRulesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	helmRelease, _ := r.Context().Value(types.ReleaseScope).(*release.Release)
+
+	rules, err := c.Repo().MetricAlertRule().ListMetricAlertRules(
+		cluster.ProjectID, cluster.ID, helmRelease.Name, helmRelease.Namespace,
+	)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	c.WriteResult(w, r, getMetricAlertRulesResponse(rules))
+}
@@ -0,0 +1,86 @@
+package release
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/prometheus"
+	"github.com/porter-dev/porter/internal/models"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+// defaultMetricNamesRange is how far back metric names are listed if no range is requested
+const defaultMetricNamesRange = time.Hour
+
+type ListReleaseMetricNamesHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+func NewListReleaseMetricNamesHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *ListReleaseMetricNamesHandler {
+	return &ListReleaseMetricNamesHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+func (c *ListReleaseMetricNamesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	helmRelease, _ := r.Context().Value(types.ReleaseScope).(*release.Release)
+
+	request := &types.ListReleaseMetricNamesRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	if request.EndRange == 0 {
+		request.EndRange = uint(time.Now().Unix())
+	}
+
+	if request.StartRange == 0 {
+		request.StartRange = request.EndRange - uint(defaultMetricNamesRange.Seconds())
+	}
+
+	data, reqErr := getCustomQueryData(helmRelease)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	promSvc, reqErr := getPrometheusService(agent)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	names, err := prometheus.ListMetricNames(
+		agent.Clientset, promSvc, data.PodSelector, request.StartRange, request.EndRange,
+	)
+
+	if err != nil {
+		c.HandleAPIError(w, r, handlePrometheusError(err))
+		return
+	}
+
+	c.WriteResult(w, r, types.ListReleaseMetricNamesResponse(names))
+}
@@ -0,0 +1,46 @@
+package release
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+type ListSLOsHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewListSLOsHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *ListSLOsHandler {
+	return &ListSLOsHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (c *ListSLOsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	helmRelease, _ := r.Context().Value(types.ReleaseScope).(*release.Release)
+
+	slos, err := c.Repo().SLO().ListSLOs(cluster.ProjectID, cluster.ID, helmRelease.Name, helmRelease.Namespace)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := make(types.ListSLOsResponse, 0)
+
+	for _, slo := range slos {
+		res = append(res, slo.ToSLOType())
+	}
+
+	c.WriteResult(w, r, res)
+}
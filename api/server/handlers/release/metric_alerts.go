@@ -0,0 +1,119 @@
+package release
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/alerts"
+	"github.com/porter-dev/porter/internal/kubernetes"
+	"github.com/porter-dev/porter/internal/kubernetes/prometheus"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+// readMetricAlertRule reads the alert rule in the URL, which must belong to the release
+func readMetricAlertRule(
+	r *http.Request,
+	repo repository.Repository,
+	cluster *models.Cluster,
+	helmRelease *release.Release,
+) (*models.MetricAlertRule, apierrors.RequestError) {
+	ruleID, reqErr := requestutils.GetURLParamUint(r, types.URLParamMetricAlertRuleID)
+
+	if reqErr != nil {
+		return nil, reqErr
+	}
+
+	rule, err := repo.MetricAlertRule().ReadMetricAlertRule(cluster.ProjectID, cluster.ID, ruleID)
+
+	if err == nil && (rule.ReleaseName != helmRelease.Name || rule.Namespace != helmRelease.Namespace) {
+		err = gorm.ErrRecordNotFound
+	}
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("metric alert rule %d not found", ruleID),
+				http.StatusNotFound,
+			)
+		}
+
+		return nil, apierrors.NewErrInternal(err)
+	}
+
+	return rule, nil
+}
+
+// validateMetricAlertRule checks that the query of an alert rule can be built for the release
+// and evaluated by the cluster's Prometheus
+func validateMetricAlertRule(
+	repo repository.Repository,
+	agent *kubernetes.Agent,
+	helmRelease *release.Release,
+	rule *models.MetricAlertRule,
+) apierrors.RequestError {
+	var customMetric *models.CustomMetric
+
+	if rule.Metric == types.MetricAlertMetricCustom {
+		if rule.CustomMetricName == "" {
+			return apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("custom_metric_name is required for custom metric alerts"),
+				http.StatusBadRequest,
+			)
+		}
+
+		var err error
+
+		customMetric, err = repo.CustomMetric().ReadCustomMetric(
+			rule.ProjectID, rule.ClusterID, rule.ReleaseName, rule.Namespace, rule.CustomMetricName,
+		)
+
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return apierrors.NewErrPassThroughToClient(
+					fmt.Errorf("custom metric %s not found", rule.CustomMetricName),
+					http.StatusBadRequest,
+				)
+			}
+
+			return apierrors.NewErrInternal(err)
+		}
+	} else {
+		rule.CustomMetricName = ""
+	}
+
+	promSvc, reqErr := getPrometheusService(agent)
+
+	if reqErr != nil {
+		return reqErr
+	}
+
+	query, err := alerts.GetQuery(agent.Clientset, promSvc, rule, helmRelease, customMetric, time.Now())
+
+	if err != nil {
+		return apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest)
+	}
+
+	if err := prometheus.ValidateQuery(agent.Clientset, promSvc, query); err != nil {
+		return handlePrometheusError(err)
+	}
+
+	return nil
+}
+
+func getMetricAlertRulesResponse(rules []*models.MetricAlertRule) types.ListMetricAlertRulesResponse {
+	res := make(types.ListMetricAlertRulesResponse, 0)
+
+	for _, rule := range rules {
+		res = append(res, rule.ToMetricAlertRuleType())
+	}
+
+	return res
+}
@@ -0,0 +1,48 @@
+package release
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+// readSLO reads the SLO in the URL, which must belong to the release
+func readSLO(
+	r *http.Request,
+	repo repository.Repository,
+	cluster *models.Cluster,
+	helmRelease *release.Release,
+) (*models.SLO, apierrors.RequestError) {
+	sloID, reqErr := requestutils.GetURLParamUint(r, types.URLParamSLOID)
+
+	if reqErr != nil {
+		return nil, reqErr
+	}
+
+	slo, err := repo.SLO().ReadSLO(cluster.ProjectID, cluster.ID, sloID)
+
+	if err == nil && (slo.ReleaseName != helmRelease.Name || slo.Namespace != helmRelease.Namespace) {
+		err = gorm.ErrRecordNotFound
+	}
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("slo %d not found", sloID),
+				http.StatusNotFound,
+			)
+		}
+
+		return nil, apierrors.NewErrInternal(err)
+	}
+
+	return slo, nil
+}
@@ -0,0 +1,118 @@
+package release
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/websocket"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/logstream"
+	"github.com/porter-dev/porter/internal/models"
+	"helm.sh/helm/v3/pkg/release"
+	v1 "k8s.io/api/core/v1"
+)
+
+type StreamLogsHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+func NewStreamLogsHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *StreamLogsHandler {
+	return &StreamLogsHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+func (c *StreamLogsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	request := &types.StreamReleaseLogsRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	safeRW := r.Context().Value(types.RequestCtxWebsocketKey).(*websocket.WebsocketSafeReadWriter)
+	helmRelease, _ := r.Context().Value(types.ReleaseScope).(*release.Release)
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+
+	opts := &logstream.Options{
+		Container: request.Container,
+		Since:     time.Duration(request.SinceSeconds) * time.Second,
+		TailLines: request.TailLines,
+		Follow:    request.Follow,
+	}
+
+	if request.Grep != "" {
+		grep, err := regexp.Compile(request.Grep)
+
+		if err != nil {
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("invalid grep expression: %w", err),
+				http.StatusBadRequest,
+			))
+
+			return
+		}
+
+		opts.Grep = grep
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	helmAgent, err := c.GetHelmAgent(r, cluster, "")
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	// the latest revision of the release is read on every poll, so that the pods of jobs
+	// run by a new revision are picked up during a rollout
+	listPods := func() ([]v1.Pod, error) {
+		if latest, err := helmAgent.GetRelease(helmRelease.Name, 0, false); err == nil {
+			helmRelease = latest
+		}
+
+		return getReleasePods(agent, helmRelease)
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// stop streaming when the client closes the websocket
+	go func() {
+		defer cancel()
+
+		for {
+			if _, _, err := safeRW.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	err = logstream.Stream(ctx, agent.Clientset, helmRelease.Namespace, listPods, opts, func(line *types.ReleaseLogLine) error {
+		return safeRW.WriteJSON(line)
+	})
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+}
@@ -13,9 +13,12 @@ import (
 	"github.com/porter-dev/porter/api/server/shared/apierrors"
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/autorollback"
 	"github.com/porter-dev/porter/internal/helm"
+	"github.com/porter-dev/porter/internal/imagepolicy"
 	"github.com/porter-dev/porter/internal/integrations/slack"
 	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/releasehooks"
 	"helm.sh/helm/v3/pkg/release"
 )
 
@@ -64,11 +67,43 @@ func (c *UpgradeReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	imagePolicy, _, err := imagepolicy.GetPolicy(c.Repo(), cluster.ProjectID, cluster.ID)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
 	conf := &helm.UpgradeReleaseConfig{
-		Name:       helmRelease.Name,
-		Cluster:    cluster,
-		Repo:       c.Repo(),
-		Registries: registries,
+		Name:        helmRelease.Name,
+		Cluster:     cluster,
+		Repo:        c.Repo(),
+		Registries:  registries,
+		ImagePolicy: imagePolicy,
+	}
+
+	rel, err := c.Repo().Release().ReadRelease(cluster.ID, helmRelease.Name, helmRelease.Namespace)
+
+	if err != nil {
+		rel = nil
+	}
+
+	hooksRunner, err := getHooksRunner(c.Config(), c.KubernetesAgentGetter, r, cluster, rel)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if hooksRunner != nil {
+		conf.PreUpgrade = hooksRunner.PreDeploy
+	}
+
+	watcher, err := getAutoRollbackWatcher(c.Config(), c.KubernetesAgentGetter, r, cluster, helmAgent, rel)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
 	}
 
 	// if the chart version is set, load a chart from the repo
@@ -117,7 +152,44 @@ func (c *UpgradeReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		conf.Chart = chart
 	}
 
-	newHelmRelease, upgradeErr := helmAgent.UpgradeRelease(conf, request.Values, c.Config().DOConf)
+	if hooksRunner.HasPreDeploy() {
+		// the image policy and the resource quota are checked before the request returns, so
+		// that a rejected release is reported to the client instead of only in the release steps
+		if err := helmAgent.ValidateUpgradeRelease(conf, request.Values, c.Config().DOConf); err != nil {
+			c.HandleAPIError(w, r, newDeployError(err))
+			return
+		}
+
+		// the pre-deploy hook can run for longer than the request timeout, so the release is
+		// upgraded in the background and the result is recorded in the release steps
+		go func() {
+			hooksRunner.RecordUpgrade(c.upgrade(helmAgent, conf, request.Values, user, cluster, helmRelease, rel, hooksRunner, watcher))
+		}()
+
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	if err := c.upgrade(helmAgent, conf, request.Values, user, cluster, helmRelease, rel, hooksRunner, watcher); err != nil {
+		c.HandleAPIError(w, r, err)
+		return
+	}
+}
+
+// upgrade upgrades the release, sends the deploy notifications and updates the GitHub
+// actions env of the release
+func (c *UpgradeReleaseHandler) upgrade(
+	helmAgent *helm.Agent,
+	conf *helm.UpgradeReleaseConfig,
+	values string,
+	user *models.User,
+	cluster *models.Cluster,
+	helmRelease *release.Release,
+	rel *models.Release,
+	hooksRunner *releasehooks.Runner,
+	watcher *autorollback.Watcher,
+) apierrors.RequestError {
+	newHelmRelease, upgradeErr := helmAgent.UpgradeRelease(conf, values, c.Config().DOConf)
 
 	if upgradeErr == nil && newHelmRelease != nil {
 		helmRelease = newHelmRelease
@@ -125,16 +197,13 @@ func (c *UpgradeReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 
 	slackInts, _ := c.Repo().SlackIntegration().ListSlackIntegrationsByProjectID(cluster.ProjectID)
 
-	rel, releaseErr := c.Repo().Release().ReadRelease(cluster.ID, helmRelease.Name, helmRelease.Namespace)
-
 	var notifConf *types.NotificationConfig
 	notifConf = nil
 	if rel != nil && rel.NotificationConfig != 0 {
 		conf, err := c.Repo().NotificationConfig().ReadNotificationConfig(rel.NotificationConfig)
 
 		if err != nil {
-			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
-			return
+			return apierrors.NewErrInternal(err)
 		}
 
 		notifConf = conf.ToNotificationConfigType()
@@ -166,12 +235,7 @@ func (c *UpgradeReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 			notifier.Notify(notifyOpts)
 		}
 
-		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
-			upgradeErr,
-			http.StatusBadRequest,
-		))
-
-		return
+		return newDeployError(upgradeErr)
 	}
 
 	if helmRelease.Chart != nil && helmRelease.Chart.Metadata.Name != "job" {
@@ -183,14 +247,30 @@ func (c *UpgradeReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		}
 	}
 
+	if watcher != nil {
+		watcher.Notifier = notifier
+		watcher.NotifyOpts = notifyOpts
+
+		go func() {
+			if _, err := watcher.Watch(helmRelease); err != nil {
+				c.Config().Logger.Error().Err(err).Msgf("auto-rollback of release %s failed", helmRelease.Name)
+			}
+		}()
+	}
+
+	if hooksRunner != nil {
+		// the post-deploy hook waits for the rollout, so it is run in the background and its
+		// status is recorded in the release steps
+		go hooksRunner.PostDeploy(helmRelease.Manifest)
+	}
+
 	// update the github actions env if the release exists and is built from source
 	if cName := helmRelease.Chart.Metadata.Name; cName == "job" || cName == "web" || cName == "worker" {
-		if releaseErr == nil && rel != nil {
-			err = updateReleaseRepo(c.Config(), rel, helmRelease)
+		if rel != nil {
+			err := updateReleaseRepo(c.Config(), rel, helmRelease)
 
 			if err != nil {
-				c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
-				return
+				return apierrors.NewErrInternal(err)
 			}
 
 			gitAction := rel.GitActionConfig
@@ -209,24 +289,23 @@ func (c *UpgradeReleaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 				)
 
 				if err != nil {
-					c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
-					return
+					return apierrors.NewErrInternal(err)
 				}
 
 				actionVersion, err := semver.NewVersion(gaRunner.Version)
 
 				if err != nil {
-					c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
-					return
+					return apierrors.NewErrInternal(err)
 				}
 
 				if createEnvSecretConstraint.Check(actionVersion) {
 					if err := gaRunner.CreateEnvSecret(); err != nil {
-						c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
-						return
+						return apierrors.NewErrInternal(err)
 					}
 				}
 			}
 		}
 	}
+
+	return nil
 }
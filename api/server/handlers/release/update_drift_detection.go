@@ -0,0 +1,79 @@
+package release
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+)
+
+const defaultDriftIntervalMinutes = 60
+
+type UpdateDriftDetectionHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewUpdateDriftDetectionHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *UpdateDriftDetectionHandler {
+	return &UpdateDriftDetectionHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *UpdateDriftDetectionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	name, _ := requestutils.GetURLParamString(r, types.URLParamReleaseName)
+	namespace := r.Context().Value(types.NamespaceScope).(string)
+
+	request := &types.UpdateDriftDetectionConfigRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	if request.IntervalMinutes == 0 {
+		request.IntervalMinutes = defaultDriftIntervalMinutes
+	}
+
+	// either create a new drift detection config or update the current one
+	conf, err := c.Repo().DriftDetectionConfig().ReadDriftDetectionConfig(cluster.ProjectID, cluster.ID, name, namespace)
+
+	if err != nil && err != gorm.ErrRecordNotFound {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if err == gorm.ErrRecordNotFound {
+		conf, err = c.Repo().DriftDetectionConfig().CreateDriftDetectionConfig(&models.DriftDetectionConfig{
+			ProjectID:       cluster.ProjectID,
+			ClusterID:       cluster.ID,
+			Name:            name,
+			Namespace:       namespace,
+			Enabled:         request.Enabled,
+			IntervalMinutes: request.IntervalMinutes,
+		})
+	} else {
+		conf.Enabled = request.Enabled
+		conf.IntervalMinutes = request.IntervalMinutes
+
+		conf, err = c.Repo().DriftDetectionConfig().UpdateDriftDetectionConfig(conf)
+	}
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := types.GetDriftDetectionConfigResponse(*conf.ToDriftDetectionConfigType())
+
+	c.WriteResult(w, r, &res)
+}
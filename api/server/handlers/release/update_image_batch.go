@@ -13,6 +13,7 @@ import (
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/helm"
+	"github.com/porter-dev/porter/internal/imagepolicy"
 	"github.com/porter-dev/porter/internal/models"
 )
 
@@ -66,6 +67,13 @@ func (c *UpdateImageBatchHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	imagePolicy, _, err := imagepolicy.GetPolicy(c.Repo(), cluster.ProjectID, cluster.ID)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
 	// asynchronously update releases with that image repo uri
 	var wg sync.WaitGroup
 	mu := &sync.Mutex{}
@@ -94,11 +102,12 @@ func (c *UpdateImageBatchHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 				rel.Config["paused"] = true
 
 				conf := &helm.UpgradeReleaseConfig{
-					Name:       releases[index].Name,
-					Cluster:    cluster,
-					Repo:       c.Repo(),
-					Registries: registries,
-					Values:     rel.Config,
+					Name:        releases[index].Name,
+					Cluster:     cluster,
+					Repo:        c.Repo(),
+					Registries:  registries,
+					Values:      rel.Config,
+					ImagePolicy: imagePolicy,
 				}
 
 				_, err = helmAgent.UpgradeReleaseByValues(conf, c.Config().DOConf)
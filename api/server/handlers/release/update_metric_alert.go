@@ -0,0 +1,90 @@
+package release
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/alerts"
+	"github.com/porter-dev/porter/internal/models"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+type UpdateMetricAlertRuleHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+func NewUpdateMetricAlertRuleHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *UpdateMetricAlertRuleHandler {
+	return &UpdateMetricAlertRuleHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+func (c *UpdateMetricAlertRuleHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	helmRelease, _ := r.Context().Value(types.ReleaseScope).(*release.Release)
+
+	rule, reqErr := readMetricAlertRule(r, c.Repo(), cluster, helmRelease)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	request := &types.UpdateMetricAlertRuleRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	// the evaluation state is only meaningful for the definition it was evaluated with
+	definitionChanged := rule.Metric != request.Metric ||
+		rule.CustomMetricName != request.CustomMetricName ||
+		rule.Comparator != request.Comparator ||
+		rule.Threshold != request.Threshold ||
+		rule.ForMinutes != request.ForMinutes ||
+		rule.Enabled != request.Enabled
+
+	rule.Name = request.Name
+	rule.Metric = request.Metric
+	rule.CustomMetricName = request.CustomMetricName
+	rule.Comparator = request.Comparator
+	rule.Threshold = request.Threshold
+	rule.ForMinutes = request.ForMinutes
+	rule.Enabled = request.Enabled
+
+	agent, err := c.GetAgent(r, cluster, "")
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if reqErr := validateMetricAlertRule(c.Repo(), agent, helmRelease, rule); reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	if definitionChanged {
+		alerts.Reset(rule)
+	}
+
+	rule, err = c.Repo().MetricAlertRule().UpdateMetricAlertRule(rule)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	c.WriteResult(w, r, rule.ToMetricAlertRuleType())
+}
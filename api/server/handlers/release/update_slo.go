@@ -0,0 +1,67 @@
+package release
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/slo"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+type UpdateSLOHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewUpdateSLOHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *UpdateSLOHandler {
+	return &UpdateSLOHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (c *UpdateSLOHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	helmRelease, _ := r.Context().Value(types.ReleaseScope).(*release.Release)
+
+	obj, reqErr := readSLO(r, c.Repo(), cluster, helmRelease)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	request := &types.UpdateSLORequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	obj.Name = request.Name
+	obj.Kind = request.Kind
+	obj.Objective = request.Objective
+	obj.LatencyThresholdMS = request.LatencyThresholdMS
+	obj.WindowDays = request.WindowDays
+	obj.BlockDeploys = request.BlockDeploys
+
+	if err := slo.Validate(obj, helmRelease); err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	obj, err := c.Repo().SLO().UpdateSLO(obj)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	c.WriteResult(w, r, obj.ToSLOType())
+}
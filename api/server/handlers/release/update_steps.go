@@ -11,6 +11,7 @@ import (
 	"github.com/porter-dev/porter/api/server/shared/requestutils"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/releasesteps"
 	"gorm.io/gorm"
 )
 
@@ -53,39 +54,12 @@ func (c *UpdateReleaseStepsHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	if release.EventContainer == 0 {
-		// create new event container
-		container, err := c.Repo().BuildEvent().CreateEventContainer(&models.EventContainer{ReleaseID: release.ID})
-		if err != nil {
-			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
-			return
-		}
-
-		release.EventContainer = container.ID
-
-		release, err = c.Repo().Release().UpdateRelease(release)
-
-		if err != nil {
-			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
-			return
-		}
-
-	}
-
-	container, err := c.Repo().BuildEvent().ReadEventContainer(release.EventContainer)
-
-	if err != nil {
-		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
-		return
-	}
-
-	if err := c.Repo().BuildEvent().AppendEvent(container, &models.SubEvent{
-		EventContainerID: container.ID,
-		EventID:          request.Event.EventID,
-		Name:             request.Event.Name,
-		Index:            request.Event.Index,
-		Status:           request.Event.Status,
-		Info:             request.Event.Info,
+	if err := releasesteps.AppendEvent(c.Repo(), release, &models.SubEvent{
+		EventID: request.Event.EventID,
+		Name:    request.Event.Name,
+		Index:   request.Event.Index,
+		Status:  request.Event.Status,
+		Info:    request.Event.Info,
 	}); err != nil {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
@@ -14,6 +14,7 @@ import (
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/analytics"
 	"github.com/porter-dev/porter/internal/helm"
+	"github.com/porter-dev/porter/internal/imagepolicy"
 	"github.com/porter-dev/porter/internal/integrations/slack"
 	"gorm.io/gorm"
 )
@@ -125,12 +126,38 @@ func (c *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	imagePolicy, _, err := imagepolicy.GetPolicy(c.Repo(), release.ProjectID, cluster.ID)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
 	conf := &helm.UpgradeReleaseConfig{
-		Name:       release.Name,
-		Cluster:    cluster,
-		Repo:       c.Repo(),
-		Registries: registries,
-		Values:     rel.Config,
+		Name:        release.Name,
+		Cluster:     cluster,
+		Repo:        c.Repo(),
+		Registries:  registries,
+		Values:      rel.Config,
+		ImagePolicy: imagePolicy,
+	}
+
+	hooksRunner, err := getHooksRunner(c.Config(), c.KubernetesAgentGetter, r, cluster, release)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if hooksRunner != nil {
+		conf.PreUpgrade = hooksRunner.PreDeploy
+	}
+
+	watcher, err := getAutoRollbackWatcher(c.Config(), c.KubernetesAgentGetter, r, cluster, helmAgent, release)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
 	}
 
 	slackInts, _ := c.Repo().SlackIntegration().ListSlackIntegrationsByProjectID(release.ProjectID)
@@ -166,31 +193,67 @@ func (c *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		),
 	}
 
-	rel, err = helmAgent.UpgradeReleaseByValues(conf, c.Config().DOConf)
+	chartName := rel.Chart.Metadata.Name
 
-	if err != nil {
-		notifyOpts.Status = slack.StatusHelmFailed
-		notifyOpts.Info = err.Error()
+	upgrade := func() error {
+		newRel, err := helmAgent.UpgradeReleaseByValues(conf, c.Config().DOConf)
+
+		if err != nil {
+			notifyOpts.Status = slack.StatusHelmFailed
+			notifyOpts.Info = err.Error()
 
-		if !cluster.NotificationsDisabled {
-			notifier.Notify(notifyOpts)
+			if !cluster.NotificationsDisabled {
+				notifier.Notify(notifyOpts)
+			}
+
+			return err
 		}
 
-		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
-			err,
-			http.StatusBadRequest,
-		))
+		if newRel.Chart != nil && newRel.Chart.Metadata.Name != "job" {
+			notifyOpts.Status = slack.StatusHelmDeployed
+			notifyOpts.Version = newRel.Version
 
-		return
-	}
+			if !cluster.NotificationsDisabled {
+				notifier.Notify(notifyOpts)
+			}
+		}
 
-	if rel.Chart != nil && rel.Chart.Metadata.Name != "job" {
-		notifyOpts.Status = slack.StatusHelmDeployed
-		notifyOpts.Version = rel.Version
+		if watcher != nil {
+			watcher.Notifier = notifier
+			watcher.NotifyOpts = notifyOpts
 
-		if !cluster.NotificationsDisabled {
-			notifier.Notify(notifyOpts)
+			go func() {
+				if _, err := watcher.Watch(newRel); err != nil {
+					c.Config().Logger.Error().Err(err).Msgf("auto-rollback of release %s failed", newRel.Name)
+				}
+			}()
 		}
+
+		if hooksRunner != nil {
+			go hooksRunner.PostDeploy(newRel.Manifest)
+		}
+
+		return nil
+	}
+
+	if hooksRunner.HasPreDeploy() {
+		// the image policy and the resource quota are checked before the request returns, so
+		// that a rejected release is reported to the client instead of only in the release steps
+		if err := helmAgent.ValidateUpgradeReleaseByValues(conf, c.Config().DOConf); err != nil {
+			c.HandleAPIError(w, r, newDeployError(err))
+			return
+		}
+
+		// the pre-deploy hook can run for longer than the request timeout, so the release is
+		// upgraded in the background and the result is recorded in the release steps
+		go func() {
+			hooksRunner.RecordUpgrade(upgrade())
+		}()
+
+		w.WriteHeader(http.StatusAccepted)
+	} else if err := upgrade(); err != nil {
+		c.HandleAPIError(w, r, newDeployError(err))
+		return
 	}
 
 	c.Config().AnalyticsClient.Track(analytics.ApplicationDeploymentWebhookTrack(&analytics.ApplicationDeploymentWebhookTrackOpts{
@@ -201,7 +264,7 @@ func (c *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			release.ClusterID,
 			release.Name,
 			release.Namespace,
-			rel.Chart.Metadata.Name,
+			chartName,
 		),
 	}))
 }
@@ -0,0 +1,115 @@
+package remote_build
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/remotebuild"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/registry"
+)
+
+type CreateRemoteBuildHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+func NewCreateRemoteBuildHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *CreateRemoteBuildHandler {
+	return &CreateRemoteBuildHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+func (c *CreateRemoteBuildHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	namespace, _ := r.Context().Value(types.NamespaceScope).(string)
+
+	request := &types.CreateRemoteBuildRequest{}
+
+	if ok := c.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	regs, err := c.Repo().Registry().ListRegistriesByProjectID(cluster.ProjectID)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	reg := registry.FindRegistryForImage(regs, request.ImageRepoURI)
+
+	if reg == nil {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("image repository %s does not belong to a registry connected to this project", request.ImageRepoURI),
+			http.StatusBadRequest,
+		))
+
+		return
+	}
+
+	// the builder is only authenticated with the registry of the image, so cache refs
+	// must belong to the same registry
+	for _, ref := range append(request.CacheFrom, request.CacheTo) {
+		if ref == "" {
+			continue
+		}
+
+		if cacheReg := registry.FindRegistryForImage(regs, ref); cacheReg == nil || cacheReg.ID != reg.ID {
+			c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("cache image %s must belong to the same registry as %s", ref, request.ImageRepoURI),
+				http.StatusBadRequest,
+			))
+
+			return
+		}
+	}
+
+	_reg := registry.Registry(*reg)
+
+	dockerConfigJSON, err := _reg.GetDockerConfigJSON(c.Repo(), c.Config().DOConf)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	build, err := remotebuild.Create(agent.Clientset, &remotebuild.Opts{
+		Namespace:        namespace,
+		Builder:          request.Builder,
+		ImageRepoURI:     request.ImageRepoURI,
+		Tag:              request.Tag,
+		Dockerfile:       request.Dockerfile,
+		BuildArgs:        request.BuildArgs,
+		CacheFrom:        request.CacheFrom,
+		CacheTo:          request.CacheTo,
+		DockerConfigJSON: dockerConfigJSON,
+	})
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := types.CreateRemoteBuildResponse(*build)
+
+	c.WriteResult(w, r, &res)
+}
@@ -0,0 +1,87 @@
+package remote_build
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/server/shared/websocket"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/remotebuild"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type StreamRemoteBuildLogsHandler struct {
+	handlers.PorterHandlerWriter
+	authz.KubernetesAgentGetter
+}
+
+func NewStreamRemoteBuildLogsHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *StreamRemoteBuildLogsHandler {
+	return &StreamRemoteBuildLogsHandler{
+		PorterHandlerWriter:   handlers.NewDefaultPorterHandler(config, nil, writer),
+		KubernetesAgentGetter: authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+func (c *StreamRemoteBuildLogsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	safeRW := r.Context().Value(types.RequestCtxWebsocketKey).(*websocket.WebsocketSafeReadWriter)
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	namespace, _ := r.Context().Value(types.NamespaceScope).(string)
+
+	buildID, reqErr := requestutils.GetURLParamString(r, types.URLParamRemoteBuildID)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if _, err := remotebuild.Get(agent.Clientset, namespace, buildID); errors.Is(err, remotebuild.ErrBuildNotFound) {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("build %s not found in namespace %s", buildID, namespace),
+			http.StatusNotFound,
+		))
+
+		return
+	} else if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// stop streaming when the client closes the websocket
+	go func() {
+		defer cancel()
+
+		for {
+			if _, _, err := safeRW.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	err = remotebuild.StreamLogs(ctx, agent.Clientset, namespace, buildID, safeRW)
+
+	if err != nil && !errors.Is(err, context.Canceled) {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+}
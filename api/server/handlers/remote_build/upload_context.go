@@ -0,0 +1,99 @@
+package remote_build
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/remotebuild"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// maxContextSize is the largest build context which can be uploaded
+const maxContextSize = 1 << 30
+
+// UploadRemoteBuildContextHandler streams the gzipped tarball of a build context in the
+// request body into the build, which starts the build
+type UploadRemoteBuildContextHandler struct {
+	handlers.PorterHandlerWriter
+	authz.KubernetesAgentGetter
+}
+
+func NewUploadRemoteBuildContextHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *UploadRemoteBuildContextHandler {
+	return &UploadRemoteBuildContextHandler{
+		PorterHandlerWriter:   handlers.NewDefaultPorterHandler(config, nil, writer),
+		KubernetesAgentGetter: authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+func (c *UploadRemoteBuildContextHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster, _ := r.Context().Value(types.ClusterScope).(*models.Cluster)
+	namespace, _ := r.Context().Value(types.NamespaceScope).(string)
+
+	buildID, reqErr := requestutils.GetURLParamString(r, types.URLParamRemoteBuildID)
+
+	if reqErr != nil {
+		c.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if _, err := remotebuild.Get(agent.Clientset, namespace, buildID); errors.Is(err, remotebuild.ErrBuildNotFound) {
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("build %s not found in namespace %s", buildID, namespace),
+			http.StatusNotFound,
+		))
+
+		return
+	} else if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	restConf, err := agent.RESTClientGetter.ToRESTConfig()
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	body := http.MaxBytesReader(w, r.Body, maxContextSize)
+	defer body.Close()
+
+	err = remotebuild.UploadContext(agent.Clientset, restConf, namespace, buildID, body)
+
+	if err != nil {
+		// the build can't start without its context, so it is cleaned up immediately
+		remotebuild.Delete(agent.Clientset, namespace, buildID)
+
+		c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(err, http.StatusBadRequest))
+		return
+	}
+
+	build, err := remotebuild.Get(agent.Clientset, namespace, buildID)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := types.GetRemoteBuildResponse(*build)
+
+	c.WriteResult(w, r, &res)
+}
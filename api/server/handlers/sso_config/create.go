@@ -0,0 +1,57 @@
+package sso_config
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/oauth"
+)
+
+type SSOConfigCreateHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewSSOConfigCreateHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *SSOConfigCreateHandler {
+	return &SSOConfigCreateHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (p *SSOConfigCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	request := &types.CreateSSOConfigRequest{}
+
+	if ok := p.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	ssoConfig := &models.SSOConfig{
+		ProjectID:         proj.ID,
+		Kind:              request.Kind,
+		VerificationToken: oauth.CreateRandomState(),
+	}
+
+	if reqErr := setSSOConfigFields(r.Context(), p.Config(), proj, ssoConfig, &request.UpdateSSOConfigRequest); reqErr != nil {
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	ssoConfig, err := p.Repo().SSOConfig().CreateSSOConfig(ssoConfig)
+
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	p.WriteResult(w, r, ssoConfig.ToSSOConfigType(p.Config().ServerConf.ServerURL))
+}
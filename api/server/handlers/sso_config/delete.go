@@ -0,0 +1,39 @@
+package sso_config
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type SSOConfigDeleteHandler struct {
+	handlers.PorterHandler
+}
+
+func NewSSOConfigDeleteHandler(
+	config *config.Config,
+) *SSOConfigDeleteHandler {
+	return &SSOConfigDeleteHandler{
+		PorterHandler: handlers.NewDefaultPorterHandler(config, nil, nil),
+	}
+}
+
+func (p *SSOConfigDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	ssoConfig, reqErr := readSSOConfig(r, p.Repo(), proj)
+
+	if reqErr != nil {
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	if err := p.Repo().SSOConfig().DeleteSSOConfig(ssoConfig); err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+}
@@ -0,0 +1,44 @@
+package sso_config
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type SSOConfigListHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewSSOConfigListHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *SSOConfigListHandler {
+	return &SSOConfigListHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (p *SSOConfigListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	ssoConfigs, err := p.Repo().SSOConfig().ListSSOConfigs(proj.ID)
+
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	res := make(types.ListSSOConfigsResponse, 0)
+
+	for _, ssoConfig := range ssoConfigs {
+		res = append(res, ssoConfig.ToSSOConfigType(p.Config().ServerConf.ServerURL))
+	}
+
+	p.WriteResult(w, r, res)
+}
@@ -0,0 +1,164 @@
+package sso_config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/auth/sso"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// readSSOConfig reads the SSO config in the URL, which must belong to the project
+func readSSOConfig(
+	r *http.Request,
+	repo repository.Repository,
+	proj *models.Project,
+) (*models.SSOConfig, apierrors.RequestError) {
+	configID, reqErr := requestutils.GetURLParamUint(r, types.URLParamSSOConfigID)
+
+	if reqErr != nil {
+		return nil, reqErr
+	}
+
+	ssoConfig, err := repo.SSOConfig().ReadSSOConfig(proj.ID, configID)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("SSO configuration %d not found", configID),
+				http.StatusNotFound,
+			)
+		}
+
+		return nil, apierrors.NewErrInternal(err)
+	}
+
+	return ssoConfig, nil
+}
+
+// setSSOConfigFields validates a request for an SSO config of a project, and sets the fields
+// of the config from the request. The domains of the config must be verified again if they
+// change, and the discovery document of an OIDC issuer is read again on every save.
+func setSSOConfigFields(
+	ctx context.Context,
+	config *config.Config,
+	proj *models.Project,
+	ssoConfig *models.SSOConfig,
+	request *types.UpdateSSOConfigRequest,
+) apierrors.RequestError {
+	badRequest := func(format string, args ...interface{}) apierrors.RequestError {
+		return apierrors.NewErrPassThroughToClient(fmt.Errorf(format, args...), http.StatusBadRequest)
+	}
+
+	switch ssoConfig.Kind {
+	case types.SSOKindOIDC:
+		if request.OIDCIssuerURL == "" || request.OIDCClientID == "" {
+			return badRequest("oidc_issuer_url and oidc_client_id are required for OIDC")
+		}
+
+		if request.OIDCClientSecret == "" && len(ssoConfig.OIDCClientSecret) == 0 {
+			return badRequest("oidc_client_secret is required for OIDC")
+		}
+	case types.SSOKindSAML:
+		if request.SAMLIdPSSOURL == "" || request.SAMLIdPCertificate == "" {
+			return badRequest("saml_idp_sso_url and saml_idp_certificate are required for SAML")
+		}
+
+		if _, err := sso.ParseCertificate(request.SAMLIdPCertificate); err != nil {
+			return badRequest("%s", err.Error())
+		}
+	}
+
+	domains := make([]models.SSODomain, 0)
+	domainsChanged := len(request.Domains) != len(ssoConfig.Domains)
+
+	for i, domain := range request.Domains {
+		domain = strings.ToLower(domain)
+
+		// the domains of the instance cannot be claimed by a project
+		if config.InstanceSSOConfig != nil && config.InstanceSSOConfig.HasEmail("@"+domain) {
+			return apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("domain %s uses the single sign-on of the instance", domain),
+				http.StatusConflict,
+			)
+		}
+
+		for _, other := range domains {
+			if other.Domain == domain {
+				return badRequest("domain %s is listed more than once", domain)
+			}
+		}
+
+		domainsChanged = domainsChanged || ssoConfig.Domains[i].Domain != domain
+
+		domains = append(domains, models.SSODomain{
+			Domain: domain,
+		})
+	}
+
+	mappings := make([]models.SSOGroupMapping, 0)
+
+	for _, mapping := range request.GroupMappings {
+		if (mapping.RoleKind == "") == (mapping.TeamID == 0) {
+			return badRequest("group %s must be mapped to either a role kind or a team", mapping.Group)
+		}
+
+		if mapping.TeamID != 0 {
+			if _, err := config.Repo.Team().ReadTeam(proj.ID, mapping.TeamID); errors.Is(err, gorm.ErrRecordNotFound) {
+				return badRequest("team %d not found", mapping.TeamID)
+			} else if err != nil {
+				return apierrors.NewErrInternal(err)
+			}
+		}
+
+		mappings = append(mappings, models.SSOGroupMapping{
+			Group:    mapping.Group,
+			RoleKind: mapping.RoleKind,
+			TeamID:   mapping.TeamID,
+		})
+	}
+
+	if domainsChanged {
+		ssoConfig.DomainsVerified = false
+	}
+
+	ssoConfig.Domains = domains
+	ssoConfig.EnforceSSO = request.EnforceSSO
+	ssoConfig.DefaultRoleKind = request.DefaultRoleKind
+	ssoConfig.GroupsClaim = request.GroupsClaim
+	ssoConfig.GroupMappings = mappings
+
+	switch ssoConfig.Kind {
+	case types.SSOKindOIDC:
+		disc, err := sso.DiscoverOIDC(ctx, request.OIDCIssuerURL)
+
+		if err != nil {
+			return badRequest("%s", err.Error())
+		}
+
+		ssoConfig.OIDCIssuerURL = disc.Issuer
+		ssoConfig.OIDCClientID = request.OIDCClientID
+		ssoConfig.OIDCAuthURL = disc.AuthURL
+		ssoConfig.OIDCTokenURL = disc.TokenURL
+		ssoConfig.OIDCJWKSURI = disc.JWKSURI
+
+		if request.OIDCClientSecret != "" {
+			ssoConfig.OIDCClientSecret = []byte(request.OIDCClientSecret)
+		}
+	case types.SSOKindSAML:
+		ssoConfig.SAMLIdPSSOURL = request.SAMLIdPSSOURL
+		ssoConfig.SAMLIdPEntityID = request.SAMLIdPEntityID
+		ssoConfig.SAMLIdPCertificate = request.SAMLIdPCertificate
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package sso_config
+
+import (
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+type SSOConfigUpdateHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewSSOConfigUpdateHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *SSOConfigUpdateHandler {
+	return &SSOConfigUpdateHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (p *SSOConfigUpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	ssoConfig, reqErr := readSSOConfig(r, p.Repo(), proj)
+
+	if reqErr != nil {
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	request := &types.UpdateSSOConfigRequest{}
+
+	if ok := p.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	if reqErr := setSSOConfigFields(r.Context(), p.Config(), proj, ssoConfig, request); reqErr != nil {
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	ssoConfig, err := p.Repo().SSOConfig().UpdateSSOConfig(ssoConfig)
+
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	p.WriteResult(w, r, ssoConfig.ToSSOConfigType(p.Config().ServerConf.ServerURL))
+}
@@ -0,0 +1,99 @@
+package sso_config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+)
+
+// SSOConfigVerifyHandler verifies that the project owns the domains of an SSO config, by
+// checking that the TXT records at _porter-verification.<domain> contain the verification
+// record of the config. Users can only log in with the identity provider once its domains
+// are verified, since the identity provider can log in any user of the domains.
+type SSOConfigVerifyHandler struct {
+	handlers.PorterHandlerWriter
+}
+
+func NewSSOConfigVerifyHandler(
+	config *config.Config,
+	writer shared.ResultWriter,
+) *SSOConfigVerifyHandler {
+	return &SSOConfigVerifyHandler{
+		PorterHandlerWriter: handlers.NewDefaultPorterHandler(config, nil, writer),
+	}
+}
+
+func (p *SSOConfigVerifyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proj, _ := r.Context().Value(types.ProjectScope).(*models.Project)
+
+	ssoConfig, reqErr := readSSOConfig(r, p.Repo(), proj)
+
+	if reqErr != nil {
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	for _, domain := range ssoConfig.GetDomains() {
+		// a domain can only be verified by a single config
+		other, err := p.Repo().SSOConfig().ReadVerifiedSSOConfigByDomain(domain)
+
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		} else if (err == nil && other.ID != ssoConfig.ID) ||
+			(p.Config().InstanceSSOConfig != nil && p.Config().InstanceSSOConfig.HasEmail("@"+domain)) {
+			p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("domain %s is already verified by another SSO configuration", domain),
+				http.StatusConflict,
+			))
+
+			return
+		}
+
+		records, err := net.DefaultResolver.LookupTXT(r.Context(), "_porter-verification."+domain)
+
+		if !containsRecord(records, ssoConfig.GetVerificationRecord()) {
+			if err == nil {
+				err = fmt.Errorf("record not found")
+			}
+
+			p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("could not verify domain %s: TXT record %s at _porter-verification.%s: %s",
+					domain, ssoConfig.GetVerificationRecord(), domain, err.Error()),
+				http.StatusBadRequest,
+			))
+
+			return
+		}
+	}
+
+	ssoConfig.DomainsVerified = true
+
+	ssoConfig, err := p.Repo().SSOConfig().UpdateSSOConfig(ssoConfig)
+
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	p.WriteResult(w, r, ssoConfig.ToSSOConfigType(p.Config().ServerConf.ServerURL))
+}
+
+func containsRecord(records []string, record string) bool {
+	for _, r := range records {
+		if r == record {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,75 @@
+package team
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// readTeam reads the team in the URL, which must belong to the project
+func readTeam(
+	r *http.Request,
+	repo repository.Repository,
+	proj *models.Project,
+) (*models.Team, apierrors.RequestError) {
+	teamID, reqErr := requestutils.GetURLParamUint(r, types.URLParamTeamID)
+
+	if reqErr != nil {
+		return nil, reqErr
+	}
+
+	team, err := repo.Team().ReadTeam(proj.ID, teamID)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apierrors.NewErrPassThroughToClient(
+				fmt.Errorf("team %d not found", teamID),
+				http.StatusNotFound,
+			)
+		}
+
+		return nil, apierrors.NewErrInternal(err)
+	}
+
+	return team, nil
+}
+
+// getTeamTypes converts teams to external teams, along with the emails of their members
+func getTeamTypes(repo repository.Repository, teams ...*models.Team) ([]*types.Team, error) {
+	userIDs := make([]uint, 0)
+
+	for _, team := range teams {
+		for _, member := range team.Members {
+			userIDs = append(userIDs, member.UserID)
+		}
+	}
+
+	emails := make(map[uint]string)
+
+	if len(userIDs) > 0 {
+		users, err := repo.User().ListUsersByIDs(userIDs)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range users {
+			emails[user.ID] = user.Email
+		}
+	}
+
+	res := make([]*types.Team, 0)
+
+	for _, team := range teams {
+		res = append(res, team.ToTeamType(emails))
+	}
+
+	return res, nil
+}
@@ -58,6 +58,11 @@ func (u *UserCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if reqErr := checkPasswordLogin(u.Config(), request.Email); reqErr != nil {
+		u.HandleAPIError(w, r, reqErr)
+		return
+	}
+
 	// hash the password using bcrypt
 	hashedPw, err := bcrypt.GenerateFromPassword([]byte(user.Password), 8)
 
@@ -42,6 +42,11 @@ func (u *UserLoginHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if reqErr := checkPasswordLogin(u.Config(), request.Email); reqErr != nil {
+		u.HandleAPIError(w, r, reqErr)
+		return
+	}
+
 	// check that passwords match
 	storedUser, err := u.Repo().User().ReadUserByEmail(request.Email)
 
@@ -0,0 +1,337 @@
+package user
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/analytics"
+	"github.com/porter-dev/porter/internal/auth/sso"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// getSSOConfigForEmail returns the SSO config of the domain of an email, or nil if users of
+// the domain do not log in with single sign-on
+func getSSOConfigForEmail(config *config.Config, email string) (*models.SSOConfig, error) {
+	if config.InstanceSSOConfig != nil && config.InstanceSSOConfig.HasEmail(email) {
+		return config.InstanceSSOConfig, nil
+	}
+
+	domain := models.GetEmailDomain(email)
+
+	if domain == "" {
+		return nil, nil
+	}
+
+	ssoConfig, err := config.Repo.SSOConfig().ReadVerifiedSSOConfigByDomain(domain)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return ssoConfig, nil
+}
+
+// getSSOConfig returns an SSO config whose domains are verified by id, where the config of
+// the instance has the id 0
+func getSSOConfig(config *config.Config, configID uint) (*models.SSOConfig, error) {
+	if configID == 0 {
+		if config.InstanceSSOConfig == nil {
+			return nil, gorm.ErrRecordNotFound
+		}
+
+		return config.InstanceSSOConfig, nil
+	}
+
+	ssoConfig, err := config.Repo.SSOConfig().ReadSSOConfigByID(configID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// users cannot log in with an identity provider until its domains are verified
+	if !ssoConfig.DomainsVerified {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return ssoConfig, nil
+}
+
+// checkPasswordLogin returns an error if password login is disabled for the domain of an
+// email, because users of the domain must log in with single sign-on
+func checkPasswordLogin(config *config.Config, email string) apierrors.RequestError {
+	ssoConfig, err := getSSOConfigForEmail(config, email)
+
+	if err != nil {
+		return apierrors.NewErrInternal(err)
+	}
+
+	if ssoConfig != nil && ssoConfig.EnforceSSO {
+		return apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("password login is disabled for %s, log in with single sign-on instead", models.GetEmailDomain(email)),
+			http.StatusForbidden,
+		)
+	}
+
+	return nil
+}
+
+func getOIDCProvider(config *config.Config, ssoConfig *models.SSOConfig) (*sso.OIDCProvider, error) {
+	return sso.NewOIDCProvider(&sso.OIDCConfig{
+		IssuerURL:    ssoConfig.OIDCIssuerURL,
+		ClientID:     ssoConfig.OIDCClientID,
+		ClientSecret: string(ssoConfig.OIDCClientSecret),
+		RedirectURL:  ssoConfig.GetOIDCRedirectURL(config.ServerConf.ServerURL),
+		AuthURL:      ssoConfig.OIDCAuthURL,
+		TokenURL:     ssoConfig.OIDCTokenURL,
+		JWKSURI:      ssoConfig.OIDCJWKSURI,
+		GroupsClaim:  ssoConfig.GroupsClaim,
+	})
+}
+
+func getSAMLServiceProvider(config *config.Config, ssoConfig *models.SSOConfig) (*sso.SAMLServiceProvider, error) {
+	return sso.NewSAMLServiceProvider(&sso.SAMLConfig{
+		EntityID:        ssoConfig.GetSAMLEntityID(config.ServerConf.ServerURL),
+		ACSURL:          ssoConfig.GetSAMLACSURL(config.ServerConf.ServerURL),
+		IdPSSOURL:       ssoConfig.SAMLIdPSSOURL,
+		IdPEntityID:     ssoConfig.SAMLIdPEntityID,
+		IdPCertificate:  ssoConfig.SAMLIdPCertificate,
+		GroupsAttribute: ssoConfig.GroupsClaim,
+		RequestSecret:   []byte(config.ServerConf.TokenGeneratorSecret),
+		UsedIDs:         &ssoUsedIDStore{config.Repo},
+	})
+}
+
+// ssoUsedIDStore records the ids which users logged in with in the database
+type ssoUsedIDStore struct {
+	repo repository.Repository
+}
+
+func (s *ssoUsedIDStore) UseID(id string, expiry time.Time) error {
+	created, err := s.repo.SSOConfig().CreateSSOUsedID(&models.SSOUsedID{
+		ID:        id,
+		ExpiresAt: expiry,
+	})
+
+	if err != nil {
+		return err
+	} else if !created {
+		return sso.ErrIDUsed
+	}
+
+	return nil
+}
+
+// samlRequestCookie is the name of the cookie which binds a SAML login to the browser which
+// started it
+const samlRequestCookie = "porter_saml_request"
+
+// setSAMLRequestCookie stores the id of a SAML authentication request in a cookie which is
+// only sent to the assertion consumer service of the config. The cookie must be sent with
+// the cross-site post of the identity provider, so its SameSite mode is None.
+func setSAMLRequestCookie(w http.ResponseWriter, config *config.Config, ssoConfig *models.SSOConfig, requestID string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     samlRequestCookie,
+		Value:    requestID,
+		Path:     getSAMLACSPath(config, ssoConfig),
+		MaxAge:   int(sso.SAMLRequestValidity.Seconds()),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteNoneMode,
+	})
+}
+
+// popSAMLRequestCookie returns the id of the SAML authentication request started by the
+// browser, and deletes the cookie so that it cannot be used again
+func popSAMLRequestCookie(w http.ResponseWriter, r *http.Request, config *config.Config, ssoConfig *models.SSOConfig) string {
+	cookie, err := r.Cookie(samlRequestCookie)
+
+	if err != nil {
+		return ""
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     samlRequestCookie,
+		Path:     getSAMLACSPath(config, ssoConfig),
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteNoneMode,
+	})
+
+	return cookie.Value
+}
+
+func getSAMLACSPath(config *config.Config, ssoConfig *models.SSOConfig) string {
+	acsURL, err := url.Parse(ssoConfig.GetSAMLACSURL(config.ServerConf.ServerURL))
+
+	if err != nil {
+		return "/"
+	}
+
+	return acsURL.Path
+}
+
+// upsertSSOUser creates the user of an identity asserted by the identity provider of an SSO
+// config if the user does not exist, and syncs the role and the teams of the user in the
+// project of the config
+func upsertSSOUser(config *config.Config, ssoConfig *models.SSOConfig, identity *sso.Identity) (*models.User, error) {
+	// identity providers can only log in the users of their own domains
+	if !ssoConfig.HasEmail(identity.Email) {
+		return nil, fmt.Errorf("%s is not in a domain of the identity provider", identity.Email)
+	}
+
+	if err := checkUserRestrictions(config.ServerConf, identity.Email); err != nil {
+		return nil, err
+	}
+
+	user, err := config.Repo.User().ReadUserByEmail(identity.Email)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		user, err = config.Repo.User().CreateUser(&models.User{
+			Email:         identity.Email,
+			EmailVerified: true,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := addUserToDefaultProject(config, user); err != nil {
+			return nil, err
+		}
+
+		config.AnalyticsClient.Track(analytics.UserCreateTrack(&analytics.UserCreateTrackOpts{
+			UserScopedTrackOpts: analytics.GetUserScopedTrackOpts(user.ID),
+			Email:               user.Email,
+		}))
+	} else if err != nil {
+		return nil, err
+	} else if !user.EmailVerified {
+		// the identity provider of a verified domain vouches for the emails of its users
+		user.EmailVerified = true
+
+		if user, err = config.Repo.User().UpdateUser(user); err != nil {
+			return nil, err
+		}
+	}
+
+	if ssoConfig.ProjectID != 0 {
+		if err := syncSSOProjectMembership(config, ssoConfig, user, identity.Groups); err != nil {
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+// ssoRoleKindRanks ranks the role kinds which groups can be mapped to, so that users in
+// several mapped groups get the most privileged role kind
+var ssoRoleKindRanks = map[types.RoleKind]int{
+	types.RoleMember:    1,
+	types.RoleViewer:    2,
+	types.RoleDeveloper: 3,
+	types.RoleAdmin:     4,
+}
+
+// syncSSOProjectMembership adds a user to the project of an SSO config with the default role
+// kind of the config, unless the groups of the user are mapped to a role kind. The identity
+// provider is the source of truth for mapped groups: the role of the user is updated to the
+// role kind of their mapped groups on every login, and the user is added to or removed from
+// mapped teams.
+func syncSSOProjectMembership(config *config.Config, ssoConfig *models.SSOConfig, user *models.User, groups []string) error {
+	project, err := config.Repo.Project().ReadProject(ssoConfig.ProjectID)
+
+	if err != nil {
+		return err
+	}
+
+	inGroup := make(map[string]bool)
+
+	for _, group := range groups {
+		inGroup[group] = true
+	}
+
+	var mappedKind types.RoleKind
+
+	// teams maps the ids of mapped teams to whether the user should be a member
+	teams := make(map[uint]bool)
+
+	for _, mapping := range ssoConfig.GroupMappings {
+		if mapping.TeamID != 0 {
+			teams[mapping.TeamID] = teams[mapping.TeamID] || inGroup[mapping.Group]
+		}
+
+		if inGroup[mapping.Group] && ssoRoleKindRanks[mapping.RoleKind] > ssoRoleKindRanks[mappedKind] {
+			mappedKind = mapping.RoleKind
+		}
+	}
+
+	role, err := config.Repo.Project().ReadProjectRole(project.ID, user.ID)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		kind := mappedKind
+
+		if kind == "" {
+			kind = ssoConfig.DefaultRoleKind
+		}
+
+		_, err = config.Repo.Project().CreateProjectRole(project, &models.Role{
+			Role: types.Role{
+				UserID:    user.ID,
+				ProjectID: project.ID,
+				Kind:      kind,
+			},
+		})
+
+		if err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	} else if mappedKind != "" && role.Kind != mappedKind {
+		role.Kind = mappedKind
+
+		if _, err := config.Repo.Project().UpdateProjectRole(project.ID, role); err != nil {
+			return err
+		}
+	}
+
+	for teamID, isMember := range teams {
+		team, err := config.Repo.Team().ReadTeam(project.ID, teamID)
+
+		// teams which were deleted after they were mapped are skipped
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		var hasMember bool
+
+		for _, member := range team.Members {
+			hasMember = hasMember || member.UserID == user.ID
+		}
+
+		if isMember && !hasMember {
+			_, err = config.Repo.Team().AddTeamMember(team, user.ID)
+		} else if !isMember && hasMember {
+			_, err = config.Repo.Team().RemoveTeamMember(team, user.ID)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
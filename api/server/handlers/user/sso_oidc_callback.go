@@ -0,0 +1,107 @@
+package user
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/porter-dev/porter/api/server/authn"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/analytics"
+)
+
+type UserSSOOIDCCallbackHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewUserSSOOIDCCallbackHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *UserSSOOIDCCallbackHandler {
+	return &UserSSOOIDCCallbackHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (p *UserSSOOIDCCallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	session, err := p.Config().Store.Get(r, p.Config().ServerConf.CookieName)
+
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if state, ok := session.Values["state"].(string); !ok || state == "" || r.URL.Query().Get("state") != state {
+		p.HandleAPIError(w, r, apierrors.NewErrForbidden(fmt.Errorf("invalid oauth state")))
+		return
+	}
+
+	configID, ok := session.Values["sso_config_id"].(uint)
+	nonce, _ := session.Values["sso_nonce"].(string)
+
+	if !ok || nonce == "" {
+		p.HandleAPIError(w, r, apierrors.NewErrForbidden(fmt.Errorf("no single sign-on login in progress")))
+		return
+	}
+
+	// the nonce can only be used once, and the session is saved along with the user below
+	delete(session.Values, "sso_config_id")
+	delete(session.Values, "sso_nonce")
+
+	if errMsg := r.URL.Query().Get("error"); errMsg != "" {
+		http.Redirect(w, r, "/login?error="+url.QueryEscape(errMsg), 302)
+		return
+	}
+
+	ssoConfig, err := getSSOConfig(p.Config(), configID)
+
+	if err != nil || ssoConfig.Kind != types.SSOKindOIDC {
+		p.HandleAPIError(w, r, apierrors.NewErrForbidden(fmt.Errorf("invalid SSO configuration %d", configID)))
+		return
+	}
+
+	provider, err := getOIDCProvider(p.Config(), ssoConfig)
+
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	identity, err := provider.Exchange(r.Context(), r.URL.Query().Get("code"), nonce)
+
+	if err != nil {
+		p.HandleAPIErrorNoWrite(w, r, apierrors.NewErrForbidden(err))
+		http.Redirect(w, r, "/login?error="+url.QueryEscape("Could not verify the login with the identity provider."), 302)
+		return
+	}
+
+	user, err := upsertSSOUser(p.Config(), ssoConfig, identity)
+
+	if err != nil {
+		p.HandleAPIErrorNoWrite(w, r, apierrors.NewErrForbidden(err))
+		http.Redirect(w, r, "/login?error="+url.QueryEscape(err.Error()), 302)
+		return
+	}
+
+	p.Config().AnalyticsClient.Identify(analytics.CreateSegmentIdentifyUser(user))
+
+	// save the user as authenticated in the session
+	redirect, err := authn.SaveUserAuthenticated(w, r, p.Config(), user)
+
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if redirect != "" {
+		http.Redirect(w, r, redirect, http.StatusFound)
+		return
+	}
+
+	http.Redirect(w, r, "/dashboard", 302)
+}
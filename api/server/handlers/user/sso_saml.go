@@ -0,0 +1,147 @@
+package user
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/authn"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/analytics"
+	"github.com/porter-dev/porter/internal/auth/sso"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+)
+
+// maxSAMLResponseBytes limits the size of the form posted by identity providers
+const maxSAMLResponseBytes = 1 << 20
+
+type UserSSOSAMLACSHandler struct {
+	handlers.PorterHandler
+}
+
+func NewUserSSOSAMLACSHandler(
+	config *config.Config,
+) *UserSSOSAMLACSHandler {
+	return &UserSSOSAMLACSHandler{
+		PorterHandler: handlers.NewDefaultPorterHandler(config, nil, nil),
+	}
+}
+
+func (p *UserSSOSAMLACSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ssoConfig, sp, reqErr := getSAMLConfigFromURL(p.Config(), r)
+
+	if reqErr != nil {
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxSAMLResponseBytes)
+
+	if err := r.ParseForm(); err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(fmt.Errorf("invalid saml response form"), http.StatusBadRequest))
+		return
+	}
+
+	requestID := popSAMLRequestCookie(w, r, p.Config(), ssoConfig)
+
+	identity, err := sp.ParseResponse(r.PostFormValue("SAMLResponse"), requestID, time.Now())
+
+	if err != nil {
+		p.HandleAPIErrorNoWrite(w, r, apierrors.NewErrForbidden(err))
+		http.Redirect(w, r, "/login?error="+url.QueryEscape("Could not verify the login with the identity provider."), 302)
+		return
+	}
+
+	user, err := upsertSSOUser(p.Config(), ssoConfig, identity)
+
+	if err != nil {
+		p.HandleAPIErrorNoWrite(w, r, apierrors.NewErrForbidden(err))
+		http.Redirect(w, r, "/login?error="+url.QueryEscape(err.Error()), 302)
+		return
+	}
+
+	p.Config().AnalyticsClient.Identify(analytics.CreateSegmentIdentifyUser(user))
+
+	// the session cookie is not sent with the post of the identity provider, so the user is
+	// saved in a new session which replaces the existing one
+	redirect, err := authn.SaveUserAuthenticated(w, r, p.Config(), user)
+
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if redirect != "" {
+		http.Redirect(w, r, redirect, http.StatusFound)
+		return
+	}
+
+	http.Redirect(w, r, "/dashboard", 302)
+}
+
+type UserSSOSAMLMetadataHandler struct {
+	handlers.PorterHandler
+}
+
+func NewUserSSOSAMLMetadataHandler(
+	config *config.Config,
+) *UserSSOSAMLMetadataHandler {
+	return &UserSSOSAMLMetadataHandler{
+		PorterHandler: handlers.NewDefaultPorterHandler(config, nil, nil),
+	}
+}
+
+func (p *UserSSOSAMLMetadataHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	_, sp, reqErr := getSAMLConfigFromURL(p.Config(), r)
+
+	if reqErr != nil {
+		p.HandleAPIError(w, r, reqErr)
+		return
+	}
+
+	metadata, err := sp.Metadata()
+
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	w.Write(metadata)
+}
+
+// getSAMLConfigFromURL returns the SAML config with the id of the URL, along with its
+// service provider
+func getSAMLConfigFromURL(config *config.Config, r *http.Request) (*models.SSOConfig, *sso.SAMLServiceProvider, apierrors.RequestError) {
+	configID, reqErr := requestutils.GetURLParamUint(r, types.URLParamSSOConfigID)
+
+	if reqErr != nil {
+		return nil, nil, reqErr
+	}
+
+	ssoConfig, err := getSSOConfig(config, configID)
+
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, apierrors.NewErrInternal(err)
+	} else if err != nil || ssoConfig.Kind != types.SSOKindSAML {
+		return nil, nil, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("SAML configuration %d not found", configID),
+			http.StatusNotFound,
+		)
+	}
+
+	sp, err := getSAMLServiceProvider(config, ssoConfig)
+
+	if err != nil {
+		return nil, nil, apierrors.NewErrInternal(err)
+	}
+
+	return ssoConfig, sp, nil
+}
@@ -0,0 +1,115 @@
+package user
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/oauth"
+)
+
+type UserSSOLoginHandler struct {
+	handlers.PorterHandlerReadWriter
+}
+
+func NewUserSSOLoginHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *UserSSOLoginHandler {
+	return &UserSSOLoginHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+	}
+}
+
+func (p *UserSSOLoginHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	request := &types.SSOLoginRequest{}
+
+	if ok := p.DecodeAndValidate(w, r, request); !ok {
+		return
+	}
+
+	ssoConfig, err := getSSOConfigForEmail(p.Config(), request.Email)
+
+	if err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	if ssoConfig == nil {
+		p.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+			fmt.Errorf("single sign-on is not configured for %s", models.GetEmailDomain(request.Email)),
+			http.StatusNotFound,
+		))
+
+		return
+	}
+
+	state := oauth.CreateRandomState()
+
+	if err := p.PopulateOAuthSession(w, r, state, false); err != nil {
+		p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+
+	var url string
+
+	switch ssoConfig.Kind {
+	case types.SSOKindOIDC:
+		provider, err := getOIDCProvider(p.Config(), ssoConfig)
+
+		if err != nil {
+			p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+
+		// the nonce and the config are stored in the session, since the callback is shared by
+		// all identity providers
+		nonce := oauth.CreateRandomState()
+
+		session, err := p.Config().Store.Get(r, p.Config().ServerConf.CookieName)
+
+		if err != nil {
+			p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+
+		session.Values["sso_config_id"] = ssoConfig.ID
+		session.Values["sso_nonce"] = nonce
+
+		if err := session.Save(r, w); err != nil {
+			p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+
+		url = provider.AuthCodeURL(state, nonce)
+	case types.SSOKindSAML:
+		sp, err := getSAMLServiceProvider(p.Config(), ssoConfig)
+
+		if err != nil {
+			p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+
+		var requestID string
+
+		url, requestID, err = sp.AuthnRequestURL("", time.Now())
+
+		if err != nil {
+			p.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+
+		// the session cookie is not sent with the cross-site post of the identity provider,
+		// so the request is bound to the browser with a cookie of its own
+		setSAMLRequestCookie(w, p.Config(), ssoConfig, requestID)
+	}
+
+	http.Redirect(w, r, url, 302)
+}
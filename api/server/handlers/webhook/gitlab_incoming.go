@@ -0,0 +1,235 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/porter-dev/porter/api/server/authz"
+	"github.com/porter-dev/porter/api/server/handlers"
+	"github.com/porter-dev/porter/api/server/shared"
+	"github.com/porter-dev/porter/api/server/shared/apierrors"
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/server/shared/requestutils"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/integrations/gitlab"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+
+	gitlabci "github.com/porter-dev/porter/internal/integrations/ci/gitlab"
+)
+
+type GitlabIncomingWebhookHandler struct {
+	handlers.PorterHandlerReadWriter
+	authz.KubernetesAgentGetter
+}
+
+func NewGitlabIncomingWebhookHandler(
+	config *config.Config,
+	decoderValidator shared.RequestDecoderValidator,
+	writer shared.ResultWriter,
+) *GitlabIncomingWebhookHandler {
+	return &GitlabIncomingWebhookHandler{
+		PorterHandlerReadWriter: handlers.NewDefaultPorterHandler(config, decoderValidator, writer),
+		KubernetesAgentGetter:   authz.NewOutOfClusterAgentGetter(config),
+	}
+}
+
+func (c *GitlabIncomingWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	event, err := gitlab.ParseMergeRequestEvent(r, c.Config().ServerConf.GitlabIncomingWebhookSecret)
+
+	if err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrForbidden(err))
+		return
+	}
+
+	// ignore events other than merge request events
+	if event == nil {
+		return
+	}
+
+	if err := c.processMergeRequestEvent(event, r); err != nil {
+		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+		return
+	}
+}
+
+func (c *GitlabIncomingWebhookHandler) processMergeRequestEvent(event *gitlab.MergeRequestEvent, r *http.Request) error {
+	// get the webhook id from the request
+	webhookID, reqErr := requestutils.GetURLParamString(r, types.URLParamIncomingWebhookID)
+
+	if reqErr != nil {
+		return fmt.Errorf(reqErr.Error())
+	}
+
+	owner, repo := splitProjectPath(event.Project.PathWithNamespace)
+
+	env, err := c.Repo().Environment().ReadEnvironmentByWebhookIDOwnerRepoName(webhookID, owner, repo)
+
+	if err != nil {
+		return err
+	}
+
+	gi, err := c.Repo().GitlabIntegration().ReadGitlabIntegration(env.ProjectID, env.GitlabIntegrationID)
+
+	if err != nil {
+		return err
+	}
+
+	client, err := gitlab.NewClientFromIntegration(gi, c.Repo())
+
+	if err != nil {
+		return err
+	}
+
+	mr := event.ObjectAttributes
+
+	depl, err := c.Repo().Environment().ReadDeploymentByGitDetails(env.ID, owner, repo, mr.IID)
+
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	} else if err != nil {
+		depl = nil
+	}
+
+	switch mr.Action {
+	case gitlab.MergeRequestActionOpen, gitlab.MergeRequestActionReopen:
+		if env.Mode != "auto" {
+			return nil
+		}
+
+		return c.deployMergeRequest(r, client, env, depl, event)
+	case gitlab.MergeRequestActionUpdate:
+		// only redeploy when commits were pushed to an active preview environment
+		if mr.Oldrev == "" || depl == nil || depl.Status == types.DeploymentStatusInactive {
+			return nil
+		}
+
+		return c.deployMergeRequest(r, client, env, depl, event)
+	case gitlab.MergeRequestActionClose, gitlab.MergeRequestActionMerge:
+		if depl == nil || depl.Status == types.DeploymentStatusInactive {
+			return nil
+		}
+
+		return c.deleteDeployment(r, depl, env)
+	}
+
+	return nil
+}
+
+func (c *GitlabIncomingWebhookHandler) deployMergeRequest(
+	r *http.Request,
+	client *gitlab.Client,
+	env *models.Environment,
+	depl *models.Deployment,
+	event *gitlab.MergeRequestEvent,
+) error {
+	mr := event.ObjectAttributes
+	namespace := gitlabci.GetPreviewNamespace(mr.IID, env.GitRepoName)
+
+	var err error
+
+	if depl == nil {
+		depl, err = c.Repo().Environment().CreateDeployment(&models.Deployment{
+			EnvironmentID: env.ID,
+			Namespace:     namespace,
+			Status:        types.DeploymentStatusCreating,
+			PullRequestID: mr.IID,
+			PRName:        mr.Title,
+			RepoName:      env.GitRepoName,
+			RepoOwner:     env.GitRepoOwner,
+			CommitSHA:     mr.LastCommit.ID,
+			PRBranchFrom:  mr.SourceBranch,
+			PRBranchInto:  mr.TargetBranch,
+		})
+	} else {
+		depl.Namespace = namespace
+		depl.Status = types.DeploymentStatusUpdating
+		depl.PRName = mr.Title
+		depl.CommitSHA = mr.LastCommit.ID
+		depl.PRBranchFrom = mr.SourceBranch
+		depl.PRBranchInto = mr.TargetBranch
+
+		depl, err = c.Repo().Environment().UpdateDeployment(depl)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	cluster, err := c.Repo().Cluster().ReadCluster(env.ProjectID, env.ClusterID)
+
+	if err != nil {
+		return err
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := agent.CreateNamespace(namespace); err != nil {
+		return err
+	}
+
+	_, err = client.CreatePipeline(r.Context(), event.Project.PathWithNamespace, mr.SourceBranch, map[string]string{
+		gitlabci.PreviewVariable: "true",
+		"PORTER_NAMESPACE":       namespace,
+		"PORTER_PULL_REQUEST_ID": strconv.FormatUint(uint64(mr.IID), 10),
+		"PORTER_PR_NAME":         mr.Title,
+		"PORTER_BRANCH_FROM":     mr.SourceBranch,
+		"PORTER_BRANCH_INTO":     mr.TargetBranch,
+		"PORTER_REPO_OWNER":      env.GitRepoOwner,
+		"PORTER_REPO_NAME":       env.GitRepoName,
+	})
+
+	return err
+}
+
+func (c *GitlabIncomingWebhookHandler) deleteDeployment(
+	r *http.Request,
+	depl *models.Deployment,
+	env *models.Environment,
+) error {
+	cluster, err := c.Repo().Cluster().ReadCluster(env.ProjectID, env.ClusterID)
+
+	if err != nil {
+		return err
+	}
+
+	agent, err := c.GetAgent(r, cluster, "")
+
+	if err != nil {
+		return err
+	}
+
+	// make sure we don't delete default or kube-system by checking for prefix, for now
+	if strings.Contains(depl.Namespace, "pr-") {
+		err = agent.DeleteNamespace(depl.Namespace)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	depl.Status = types.DeploymentStatusInactive
+
+	// update the deployment to mark it inactive
+	_, err = c.Repo().Environment().UpdateDeployment(depl)
+
+	return err
+}
+
+// splitProjectPath splits a GitLab project path into the group path and the project name
+func splitProjectPath(path string) (string, string) {
+	i := strings.LastIndex(path, "/")
+
+	if i == -1 {
+		return "", path
+	}
+
+	return path[:i], path[i+1:]
+}
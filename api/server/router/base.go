@@ -490,6 +490,102 @@ func GetBaseRoutes(
 		Router:   r,
 	})
 
+	// GET /api/sso/login -> user.NewUserSSOLoginHandler
+	ssoLoginStartEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: "/sso/login",
+			},
+			Scopes: []types.PermissionScope{},
+		},
+	)
+
+	ssoLoginStartHandler := user.NewUserSSOLoginHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: ssoLoginStartEndpoint,
+		Handler:  ssoLoginStartHandler,
+		Router:   r,
+	})
+
+	// GET /api/sso/oidc/callback -> user.NewUserSSOOIDCCallbackHandler
+	ssoOIDCCallbackEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: "/sso/oidc/callback",
+			},
+			Scopes: []types.PermissionScope{},
+		},
+	)
+
+	ssoOIDCCallbackHandler := user.NewUserSSOOIDCCallbackHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: ssoOIDCCallbackEndpoint,
+		Handler:  ssoOIDCCallbackHandler,
+		Router:   r,
+	})
+
+	// POST /api/sso/saml/{sso_config_id}/acs -> user.NewUserSSOSAMLACSHandler
+	ssoSAMLACSEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/sso/saml/{%s}/acs", types.URLParamSSOConfigID),
+			},
+			Scopes: []types.PermissionScope{},
+		},
+	)
+
+	ssoSAMLACSHandler := user.NewUserSSOSAMLACSHandler(
+		config,
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: ssoSAMLACSEndpoint,
+		Handler:  ssoSAMLACSHandler,
+		Router:   r,
+	})
+
+	// GET /api/sso/saml/{sso_config_id}/metadata -> user.NewUserSSOSAMLMetadataHandler
+	ssoSAMLMetadataEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("/sso/saml/{%s}/metadata", types.URLParamSSOConfigID),
+			},
+			Scopes: []types.PermissionScope{},
+		},
+	)
+
+	ssoSAMLMetadataHandler := user.NewUserSSOSAMLMetadataHandler(
+		config,
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: ssoSAMLMetadataEndpoint,
+		Handler:  ssoSAMLMetadataHandler,
+		Router:   r,
+	})
+
 	// GET /api/internal/credentials
 	getCredentialsEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -566,5 +662,32 @@ func GetBaseRoutes(
 		})
 	}
 
+	if config.ServerConf.GitlabIncomingWebhookSecret != "" {
+		// POST /api/gitlab/incoming_webhook/{webhook_id} -> webhook.NewGitlabIncomingWebhook
+		gitlabIncomingWebhookEndpoint := factory.NewAPIEndpoint(
+			&types.APIRequestMetadata{
+				Verb:   types.APIVerbCreate,
+				Method: types.HTTPVerbPost,
+				Path: &types.Path{
+					Parent:       basePath,
+					RelativePath: fmt.Sprintf("/gitlab/incoming_webhook/{%s}", types.URLParamIncomingWebhookID),
+				},
+				Scopes: []types.PermissionScope{},
+			},
+		)
+
+		gitlabIncomingWebhookHandler := webhook.NewGitlabIncomingWebhookHandler(
+			config,
+			factory.GetDecoderValidator(),
+			factory.GetResultWriter(),
+		)
+
+		routes = append(routes, &Route{
+			Endpoint: gitlabIncomingWebhookEndpoint,
+			Handler:  gitlabIncomingWebhookHandler,
+			Router:   r,
+		})
+	}
+
 	return routes
 }
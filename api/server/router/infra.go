@@ -169,6 +169,121 @@ func getInfraRoutes(
 		Router:   r,
 	})
 
+	// GET /api/projects/{project_id}/infras/{infra_id}/node_pools -> infra.NewInfraListNodePoolsHandler
+	listNodePoolsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/node_pools",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.InfraScope,
+			},
+		},
+	)
+
+	listNodePoolsHandler := infra.NewInfraListNodePoolsHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: listNodePoolsEndpoint,
+		Handler:  listNodePoolsHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/infras/{infra_id}/node_pools -> infra.NewInfraCreateNodePoolHandler
+	createNodePoolEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/node_pools",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.InfraScope,
+			},
+		},
+	)
+
+	createNodePoolHandler := infra.NewInfraCreateNodePoolHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: createNodePoolEndpoint,
+		Handler:  createNodePoolHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/infras/{infra_id}/node_pools/{node_pool_name} -> infra.NewInfraUpdateNodePoolHandler
+	updateNodePoolEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/node_pools/{%s}", relPath, types.URLParamNodePoolName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.InfraScope,
+			},
+		},
+	)
+
+	updateNodePoolHandler := infra.NewInfraUpdateNodePoolHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: updateNodePoolEndpoint,
+		Handler:  updateNodePoolHandler,
+		Router:   r,
+	})
+
+	// DELETE /api/projects/{project_id}/infras/{infra_id}/node_pools/{node_pool_name} -> infra.NewInfraDeleteNodePoolHandler
+	deleteNodePoolEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbDelete,
+			Method: types.HTTPVerbDelete,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/node_pools/{%s}", relPath, types.URLParamNodePoolName),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.InfraScope,
+			},
+		},
+	)
+
+	deleteNodePoolHandler := infra.NewInfraDeleteNodePoolHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: deleteNodePoolEndpoint,
+		Handler:  deleteNodePoolHandler,
+		Router:   r,
+	})
+
 	// POST /api/projects/{project_id}/infras/{infra_id}/retry_delete -> infra.NewInfraRetryDeleteHandler
 	retryDeleteEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -8,9 +8,12 @@ import (
 	"github.com/porter-dev/porter/api/server/handlers/cluster"
 	"github.com/porter-dev/porter/api/server/handlers/gitinstallation"
 	"github.com/porter-dev/porter/api/server/handlers/helmrepo"
+	"github.com/porter-dev/porter/api/server/handlers/image_policy"
 	"github.com/porter-dev/porter/api/server/handlers/infra"
 	"github.com/porter-dev/porter/api/server/handlers/project"
 	"github.com/porter-dev/porter/api/server/handlers/registry"
+	"github.com/porter-dev/porter/api/server/handlers/sso_config"
+	"github.com/porter-dev/porter/api/server/handlers/team"
 	"github.com/porter-dev/porter/api/server/shared"
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/types"
@@ -224,6 +227,34 @@ func getProjectRoutes(
 		Router:   r,
 	})
 
+	// GET /api/projects/{project_id}/cost -> project.NewProjectGetCostHandler
+	getCostEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/cost",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	getCostHandler := project.NewProjectGetCostHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: getCostEndpoint,
+		Handler:  getCostHandler,
+		Router:   r,
+	})
+
 	// GET /api/projects/{project_id}/billing -> project.NewProjectGetBillingHandler
 	getBillingEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -468,6 +499,317 @@ func getProjectRoutes(
 		Router:   r,
 	})
 
+	// GET /api/projects/{project_id}/teams -> team.NewTeamListHandler
+	listTeamsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbList,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/teams",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	listTeamsHandler := team.NewTeamListHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: listTeamsEndpoint,
+		Handler:  listTeamsHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/teams -> team.NewTeamCreateHandler
+	createTeamEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/teams",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	createTeamHandler := team.NewTeamCreateHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: createTeamEndpoint,
+		Handler:  createTeamHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/teams/{team_id} -> team.NewTeamUpdateHandler
+	updateTeamEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/teams/{%s}", relPath, types.URLParamTeamID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	updateTeamHandler := team.NewTeamUpdateHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: updateTeamEndpoint,
+		Handler:  updateTeamHandler,
+		Router:   r,
+	})
+
+	// DELETE /api/projects/{project_id}/teams/{team_id} -> team.NewTeamDeleteHandler
+	deleteTeamEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbDelete,
+			Method: types.HTTPVerbDelete,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/teams/{%s}", relPath, types.URLParamTeamID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	deleteTeamHandler := team.NewTeamDeleteHandler(
+		config,
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: deleteTeamEndpoint,
+		Handler:  deleteTeamHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/teams/{team_id}/members -> team.NewTeamAddMemberHandler
+	addTeamMemberEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/teams/{%s}/members", relPath, types.URLParamTeamID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	addTeamMemberHandler := team.NewTeamAddMemberHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: addTeamMemberEndpoint,
+		Handler:  addTeamMemberHandler,
+		Router:   r,
+	})
+
+	// DELETE /api/projects/{project_id}/teams/{team_id}/members -> team.NewTeamRemoveMemberHandler
+	removeTeamMemberEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbDelete,
+			Method: types.HTTPVerbDelete,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/teams/{%s}/members", relPath, types.URLParamTeamID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	removeTeamMemberHandler := team.NewTeamRemoveMemberHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: removeTeamMemberEndpoint,
+		Handler:  removeTeamMemberHandler,
+		Router:   r,
+	})
+
+	// GET /api/projects/{project_id}/sso -> sso_config.NewSSOConfigListHandler
+	listSSOConfigsEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbList,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/sso",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	listSSOConfigsHandler := sso_config.NewSSOConfigListHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: listSSOConfigsEndpoint,
+		Handler:  listSSOConfigsHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/sso -> sso_config.NewSSOConfigCreateHandler
+	createSSOConfigEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbCreate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/sso",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	createSSOConfigHandler := sso_config.NewSSOConfigCreateHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: createSSOConfigEndpoint,
+		Handler:  createSSOConfigHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/sso/{sso_config_id} -> sso_config.NewSSOConfigUpdateHandler
+	updateSSOConfigEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/sso/{%s}", relPath, types.URLParamSSOConfigID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	updateSSOConfigHandler := sso_config.NewSSOConfigUpdateHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: updateSSOConfigEndpoint,
+		Handler:  updateSSOConfigHandler,
+		Router:   r,
+	})
+
+	// DELETE /api/projects/{project_id}/sso/{sso_config_id} -> sso_config.NewSSOConfigDeleteHandler
+	deleteSSOConfigEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbDelete,
+			Method: types.HTTPVerbDelete,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/sso/{%s}", relPath, types.URLParamSSOConfigID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	deleteSSOConfigHandler := sso_config.NewSSOConfigDeleteHandler(
+		config,
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: deleteSSOConfigEndpoint,
+		Handler:  deleteSSOConfigHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/sso/{sso_config_id}/verify -> sso_config.NewSSOConfigVerifyHandler
+	verifySSOConfigEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: fmt.Sprintf("%s/sso/{%s}/verify", relPath, types.URLParamSSOConfigID),
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	verifySSOConfigHandler := sso_config.NewSSOConfigVerifyHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: verifySSOConfigEndpoint,
+		Handler:  verifySSOConfigHandler,
+		Router:   r,
+	})
+
 	// GET /api/projects/{project_id}/registries -> registry.NewRegistryListHandler
 	listRegistriesEndpoint := factory.NewAPIEndpoint(
 		&types.APIRequestMetadata{
@@ -1029,5 +1371,89 @@ func getProjectRoutes(
 		Router:   r,
 	})
 
+	// GET /api/projects/{project_id}/image_policy -> image_policy.NewGetImagePolicyHandler
+	getImagePolicyEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbGet,
+			Method: types.HTTPVerbGet,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/image_policy",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+			},
+		},
+	)
+
+	getImagePolicyHandler := image_policy.NewGetImagePolicyHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: getImagePolicyEndpoint,
+		Handler:  getImagePolicyHandler,
+		Router:   r,
+	})
+
+	// POST /api/projects/{project_id}/image_policy -> image_policy.NewUpdateImagePolicyHandler
+	updateImagePolicyEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbUpdate,
+			Method: types.HTTPVerbPost,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/image_policy",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	updateImagePolicyHandler := image_policy.NewUpdateImagePolicyHandler(
+		config,
+		factory.GetDecoderValidator(),
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: updateImagePolicyEndpoint,
+		Handler:  updateImagePolicyHandler,
+		Router:   r,
+	})
+
+	// DELETE /api/projects/{project_id}/image_policy -> image_policy.NewDeleteImagePolicyHandler
+	deleteImagePolicyEndpoint := factory.NewAPIEndpoint(
+		&types.APIRequestMetadata{
+			Verb:   types.APIVerbDelete,
+			Method: types.HTTPVerbDelete,
+			Path: &types.Path{
+				Parent:       basePath,
+				RelativePath: relPath + "/image_policy",
+			},
+			Scopes: []types.PermissionScope{
+				types.UserScope,
+				types.ProjectScope,
+				types.SettingsScope,
+			},
+		},
+	)
+
+	deleteImagePolicyHandler := image_policy.NewDeleteImagePolicyHandler(
+		config,
+		factory.GetResultWriter(),
+	)
+
+	routes = append(routes, &Route{
+		Endpoint: deleteImagePolicyEndpoint,
+		Handler:  deleteImagePolicyHandler,
+		Router:   r,
+	})
+
 	return routes, newPath
 }
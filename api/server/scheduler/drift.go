@@ -0,0 +1,150 @@
+package scheduler
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/helm"
+	"github.com/porter-dev/porter/internal/integrations/slack"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// DriftDetectionTask runs the drift check for every release with scheduled drift detection
+// enabled, and notifies the project's Slack integrations when new drift is detected
+type DriftDetectionTask struct {
+	config *config.Config
+}
+
+func NewDriftDetectionTask(config *config.Config) *DriftDetectionTask {
+	return &DriftDetectionTask{config}
+}
+
+func (t *DriftDetectionTask) Name() string {
+	return "drift_detection"
+}
+
+func (t *DriftDetectionTask) Run(now time.Time) error {
+	confs, err := t.config.Repo.DriftDetectionConfig().ListEnabledDriftDetectionConfigs()
+
+	if err != nil {
+		return err
+	}
+
+	for _, conf := range confs {
+		if !conf.IsDue(now) {
+			continue
+		}
+
+		// a failure for a single release should not block the checks for other releases
+		if err := t.checkRelease(conf, now); err != nil {
+			t.config.Logger.Error().Err(err).Msgf(
+				"drift check failed for release %s/%s in cluster %d", conf.Namespace, conf.Name, conf.ClusterID,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (t *DriftDetectionTask) checkRelease(conf *models.DriftDetectionConfig, now time.Time) error {
+	cluster, err := t.config.Repo.Cluster().ReadCluster(conf.ProjectID, conf.ClusterID)
+
+	if err != nil {
+		return err
+	}
+
+	helmAgent, err := helm.GetAgentOutOfClusterConfig(&helm.Form{
+		Cluster:                   cluster,
+		Repo:                      t.config.Repo,
+		DigitalOceanOAuth:         t.config.DOConf,
+		Storage:                   "secret",
+		Namespace:                 conf.Namespace,
+		AllowInClusterConnections: t.config.ServerConf.InitInCluster,
+	}, t.config.Logger)
+
+	if err != nil {
+		return err
+	}
+
+	drift, err := helmAgent.GetReleaseDrift(conf.Name)
+
+	if err != nil {
+		return err
+	}
+
+	digest := ""
+
+	if drift.Drifted {
+		driftBytes, err := json.Marshal(drift.Resources)
+
+		if err != nil {
+			return err
+		}
+
+		digest = fmt.Sprintf("%x", sha256.Sum256(driftBytes))
+	}
+
+	shouldNotify := drift.Drifted && digest != conf.LastDriftDigest
+
+	conf.LastCheckedAt = &now
+	conf.LastDriftDetected = drift.Drifted
+	conf.LastDriftDigest = digest
+
+	if _, err := t.config.Repo.DriftDetectionConfig().UpdateDriftDetectionConfig(conf); err != nil {
+		return err
+	}
+
+	if shouldNotify && !cluster.NotificationsDisabled {
+		return t.notify(cluster, drift)
+	}
+
+	return nil
+}
+
+func (t *DriftDetectionTask) notify(cluster *models.Cluster, drift *types.ReleaseDrift) error {
+	notifier, err := getReleaseNotifier(t.config, cluster, drift.Name, drift.Namespace)
+
+	if err != nil {
+		return err
+	}
+
+	return notifier.Notify(&slack.NotifyOpts{
+		ProjectID:   cluster.ProjectID,
+		ClusterID:   cluster.ID,
+		ClusterName: cluster.Name,
+		Status:      slack.StatusDriftDetected,
+		Info:        driftSummary(drift),
+		Name:        drift.Name,
+		Namespace:   drift.Namespace,
+		Timestamp:   &drift.CheckedAt,
+		Version:     drift.Revision,
+		URL:         getReleaseURL(t.config, cluster, drift.Name, drift.Namespace),
+	})
+}
+
+func driftSummary(drift *types.ReleaseDrift) string {
+	lines := make([]string, 0)
+
+	for _, res := range drift.Resources {
+		if res.Missing {
+			lines = append(lines, fmt.Sprintf("%s/%s: missing from cluster", res.Kind, res.Name))
+			continue
+		}
+
+		for _, diff := range res.Diffs {
+			lines = append(lines, fmt.Sprintf("%s/%s: %s", res.Kind, res.Name, diff.Path))
+		}
+	}
+
+	// keep the message short enough for a single Slack block
+	if len(lines) > 10 {
+		lines = append(lines[:10], fmt.Sprintf("... and %d more", len(lines)-10))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,209 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/alerts"
+	"github.com/porter-dev/porter/internal/helm"
+	"github.com/porter-dev/porter/internal/integrations/slack"
+	"github.com/porter-dev/porter/internal/kubernetes/prometheus"
+	"github.com/porter-dev/porter/internal/models"
+	"gorm.io/gorm"
+	v1 "k8s.io/api/core/v1"
+)
+
+// MetricAlertTask evaluates the metric alert rules of every release against the cluster's
+// Prometheus, and notifies the project's Slack integrations when an alert fires or resolves
+type MetricAlertTask struct {
+	config *config.Config
+}
+
+func NewMetricAlertTask(config *config.Config) *MetricAlertTask {
+	return &MetricAlertTask{config}
+}
+
+func (t *MetricAlertTask) Name() string {
+	return "metric_alerts"
+}
+
+// alertTarget caches the agent and the Prometheus service of a release's namespace for the
+// duration of a single run
+type alertTarget struct {
+	cluster   *models.Cluster
+	helmAgent *helm.Agent
+	promSvc   *v1.Service
+}
+
+func (t *MetricAlertTask) Run(now time.Time) error {
+	rules, err := t.config.Repo.MetricAlertRule().ListEnabledMetricAlertRules()
+
+	if err != nil {
+		return err
+	}
+
+	targets := make(map[string]*alertTarget)
+
+	for _, rule := range rules {
+		key := fmt.Sprintf("%d/%s", rule.ClusterID, rule.Namespace)
+
+		target, ok := targets[key]
+
+		if !ok {
+			target, err = t.getTarget(rule)
+
+			if err != nil {
+				t.config.Logger.Error().Err(err).Msgf(
+					"could not connect to cluster %d to evaluate metric alerts", rule.ClusterID,
+				)
+			}
+
+			// a nil target is cached as well, so that an unreachable cluster is only tried once
+			targets[key] = target
+		}
+
+		if target == nil {
+			continue
+		}
+
+		// a failure for a single rule should not block the evaluation of other rules
+		if err := t.evaluateRule(target, rule, now); err != nil {
+			t.config.Logger.Error().Err(err).Msgf(
+				"metric alert %d failed for release %s/%s in cluster %d",
+				rule.ID, rule.Namespace, rule.ReleaseName, rule.ClusterID,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (t *MetricAlertTask) getTarget(rule *models.MetricAlertRule) (*alertTarget, error) {
+	cluster, err := t.config.Repo.Cluster().ReadCluster(rule.ProjectID, rule.ClusterID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	helmAgent, err := helm.GetAgentOutOfClusterConfig(&helm.Form{
+		Cluster:                   cluster,
+		Repo:                      t.config.Repo,
+		DigitalOceanOAuth:         t.config.DOConf,
+		Storage:                   "secret",
+		Namespace:                 rule.Namespace,
+		AllowInClusterConnections: t.config.ServerConf.InitInCluster,
+	}, t.config.Logger)
+
+	if err != nil {
+		return nil, err
+	}
+
+	promSvc, found, err := prometheus.GetPrometheusService(helmAgent.K8sAgent.Clientset)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, fmt.Errorf("prometheus is not installed in cluster %d", cluster.ID)
+	}
+
+	return &alertTarget{cluster, helmAgent, promSvc}, nil
+}
+
+func (t *MetricAlertTask) evaluateRule(target *alertTarget, rule *models.MetricAlertRule, now time.Time) error {
+	helmRelease, err := target.helmAgent.GetRelease(rule.ReleaseName, 0, false)
+
+	if err != nil {
+		return err
+	}
+
+	var customMetric *models.CustomMetric
+
+	if rule.CustomMetricName != "" {
+		customMetric, err = t.config.Repo.CustomMetric().ReadCustomMetric(
+			rule.ProjectID, rule.ClusterID, rule.ReleaseName, rule.Namespace, rule.CustomMetricName,
+		)
+
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+	}
+
+	clientset := target.helmAgent.K8sAgent.Clientset
+
+	query, err := alerts.GetQuery(clientset, target.promSvc, rule, helmRelease, customMetric, now)
+
+	if err != nil {
+		return err
+	}
+
+	samples, err := prometheus.QueryInstant(clientset, target.promSvc, query)
+
+	if err != nil {
+		return err
+	}
+
+	prevNotifiedState := rule.LastNotifiedState
+	transition := alerts.Evaluate(rule, alerts.GetValue(rule, samples), now)
+
+	var notifyErr error
+
+	if transition != alerts.TransitionNone && !target.cluster.NotificationsDisabled {
+		notifyErr = notifyTransition(rule, prevNotifiedState, func() error {
+			return t.notify(target.cluster, rule, transition, now)
+		})
+	}
+
+	if _, err := t.config.Repo.MetricAlertRule().UpdateMetricAlertRule(rule); err != nil {
+		return err
+	}
+
+	return notifyErr
+}
+
+// notifyTransition sends the notification of a transition of an alert rule. If the notification
+// fails, the notified state of the rule is restored, so that the transition is evaluated and
+// notified again on the next run.
+func notifyTransition(rule *models.MetricAlertRule, prevNotifiedState types.MetricAlertState, notify func() error) error {
+	if err := notify(); err != nil {
+		rule.LastNotifiedState = prevNotifiedState
+		return err
+	}
+
+	return nil
+}
+
+func (t *MetricAlertTask) notify(
+	cluster *models.Cluster,
+	rule *models.MetricAlertRule,
+	transition alerts.Transition,
+	now time.Time,
+) error {
+	notifier, err := getReleaseNotifier(t.config, cluster, rule.ReleaseName, rule.Namespace)
+
+	if err != nil {
+		return err
+	}
+
+	status := slack.StatusAlertFiring
+
+	if transition == alerts.TransitionResolved {
+		status = slack.StatusAlertResolved
+	}
+
+	return notifier.Notify(&slack.NotifyOpts{
+		ProjectID:   cluster.ProjectID,
+		ClusterID:   cluster.ID,
+		ClusterName: cluster.Name,
+		Status:      status,
+		Info:        alerts.Describe(rule),
+		Name:        rule.ReleaseName,
+		Namespace:   rule.Namespace,
+		Timestamp:   &now,
+		URL:         getReleaseURL(t.config, cluster, rule.ReleaseName, rule.Namespace),
+	})
+}
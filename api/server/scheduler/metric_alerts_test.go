@@ -0,0 +1,61 @@
+package scheduler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/alerts"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+func TestNotifyTransitionRetriesFailedNotifications(t *testing.T) {
+	rule := &models.MetricAlertRule{
+		Metric:     types.MetricAlertMetricErrorRate,
+		Comparator: types.MetricAlertComparatorGreaterThan,
+		Threshold:  5,
+	}
+
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	high := 10.0
+	notified := 0
+
+	evaluate := func(now time.Time, notify func() error) error {
+		prevNotifiedState := rule.LastNotifiedState
+
+		if transition := alerts.Evaluate(rule, &high, now); transition != alerts.TransitionFiring {
+			return nil
+		}
+
+		return notifyTransition(rule, prevNotifiedState, notify)
+	}
+
+	failingNotify := func() error {
+		return fmt.Errorf("slack is unavailable")
+	}
+
+	if err := evaluate(now, failingNotify); err == nil {
+		t.Fatalf("expected the notification error to be returned")
+	}
+
+	if rule.LastNotifiedState == types.MetricAlertStateFiring {
+		t.Fatalf("expected the failed notification not to be recorded as sent")
+	}
+
+	// the firing alert is notified again on the next run, and only once it was sent
+	for i := 1; i <= 2; i++ {
+		err := evaluate(now.Add(time.Duration(i)*time.Minute), func() error {
+			notified++
+			return nil
+		})
+
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+	}
+
+	if notified != 1 || rule.LastNotifiedState != types.MetricAlertStateFiring {
+		t.Errorf("expected the firing alert to be notified once after the failure, got %d notifications", notified)
+	}
+}
@@ -0,0 +1,88 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/internal/kubernetes"
+	"github.com/porter-dev/porter/internal/kubernetes/quota"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+const namespacePolicyReconcileInterval = 10 * time.Minute
+
+// NamespacePolicyTask re-applies the stored namespace policies, so that resource quotas and
+// limit ranges which were changed or deleted in the cluster are restored
+type NamespacePolicyTask struct {
+	config  *config.Config
+	lastRun time.Time
+}
+
+func NewNamespacePolicyTask(config *config.Config) *NamespacePolicyTask {
+	return &NamespacePolicyTask{config: config}
+}
+
+func (t *NamespacePolicyTask) Name() string {
+	return "namespace_policy"
+}
+
+func (t *NamespacePolicyTask) Run(now time.Time) error {
+	if now.Sub(t.lastRun) < namespacePolicyReconcileInterval {
+		return nil
+	}
+
+	t.lastRun = now
+
+	policies, err := t.config.Repo.NamespacePolicy().ListNamespacePolicies()
+
+	if err != nil {
+		return err
+	}
+
+	// group the policies by cluster, so that a single agent is created per cluster
+	policiesByCluster := make(map[uint][]*models.NamespacePolicy)
+
+	for _, policy := range policies {
+		policiesByCluster[policy.ClusterID] = append(policiesByCluster[policy.ClusterID], policy)
+	}
+
+	for _, clusterPolicies := range policiesByCluster {
+		// a failure for a single cluster should not block the other clusters
+		if err := t.reconcileCluster(clusterPolicies); err != nil {
+			t.config.Logger.Error().Err(err).Msgf(
+				"could not reconcile namespace policies in cluster %d", clusterPolicies[0].ClusterID,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (t *NamespacePolicyTask) reconcileCluster(policies []*models.NamespacePolicy) error {
+	cluster, err := t.config.Repo.Cluster().ReadCluster(policies[0].ProjectID, policies[0].ClusterID)
+
+	if err != nil {
+		return err
+	}
+
+	agent, err := kubernetes.GetAgentOutOfClusterConfig(&kubernetes.OutOfClusterConfig{
+		Repo:                      t.config.Repo,
+		DigitalOceanOAuth:         t.config.DOConf,
+		Cluster:                   cluster,
+		AllowInClusterConnections: t.config.ServerConf.InitInCluster,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		if err := quota.Apply(agent.Clientset, policy.Namespace, policy.ToNamespacePolicyType()); err != nil {
+			t.config.Logger.Error().Err(err).Msgf(
+				"could not apply policy for namespace %s in cluster %d", policy.Namespace, policy.ClusterID,
+			)
+		}
+	}
+
+	return nil
+}
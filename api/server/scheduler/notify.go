@@ -0,0 +1,48 @@
+package scheduler
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/porter-dev/porter/api/server/shared/config"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/integrations/slack"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// getReleaseNotifier returns a notifier for the project's Slack integrations which respects
+// the notification config of the release
+func getReleaseNotifier(config *config.Config, cluster *models.Cluster, name, namespace string) (slack.Notifier, error) {
+	slackInts, err := config.Repo.SlackIntegration().ListSlackIntegrationsByProjectID(cluster.ProjectID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var notifConf *types.NotificationConfig
+
+	rel, err := config.Repo.Release().ReadRelease(cluster.ID, name, namespace)
+
+	if err == nil && rel.NotificationConfig != 0 {
+		conf, err := config.Repo.NotificationConfig().ReadNotificationConfig(rel.NotificationConfig)
+
+		if err != nil {
+			return nil, err
+		}
+
+		notifConf = conf.ToNotificationConfigType()
+	}
+
+	return slack.NewSlackNotifier(notifConf, slackInts...), nil
+}
+
+func getReleaseURL(config *config.Config, cluster *models.Cluster, name, namespace string) string {
+	return fmt.Sprintf(
+		"%s/applications/%s/%s/%s?project_id=%d",
+		config.ServerConf.ServerURL,
+		url.PathEscape(cluster.Name),
+		namespace,
+		name,
+		cluster.ProjectID,
+	)
+}
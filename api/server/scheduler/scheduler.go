@@ -0,0 +1,50 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/porter-dev/porter/api/server/shared/config"
+)
+
+// Task is a unit of background work which runs on every tick of the scheduler. Tasks are
+// responsible for determining whether any work is due at the given time.
+type Task interface {
+	Name() string
+	Run(now time.Time) error
+}
+
+// Scheduler runs a set of background tasks on a fixed interval. Only a single scheduler should
+// be running per Porter instance, since tasks send notifications.
+type Scheduler struct {
+	config   *config.Config
+	interval time.Duration
+	tasks    []Task
+}
+
+func NewScheduler(config *config.Config, interval time.Duration, tasks ...Task) *Scheduler {
+	return &Scheduler{config, interval, tasks}
+}
+
+// Start runs the tasks until the context is cancelled
+func (s *Scheduler) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.runTasks(now)
+		}
+	}
+}
+
+func (s *Scheduler) runTasks(now time.Time) {
+	for _, task := range s.tasks {
+		if err := task.Run(now); err != nil {
+			s.config.Logger.Error().Err(err).Msgf("scheduled task %s failed", task.Name())
+		}
+	}
+}
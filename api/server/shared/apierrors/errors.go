@@ -92,6 +92,35 @@ func (e *ErrPassThroughToClient) GetStatusCode() int {
 	return e.statusCode
 }
 
+// errors that are passed directly to the client, along with an error code and structured
+// details which the client can act on
+type ErrWithDetails struct {
+	err        error
+	statusCode int
+	code       uint
+	details    interface{}
+}
+
+func NewErrWithDetails(err error, statusCode int, code uint, details interface{}) RequestError {
+	return &ErrWithDetails{err, statusCode, code, details}
+}
+
+func (e *ErrWithDetails) Error() string {
+	return e.err.Error()
+}
+
+func (e *ErrWithDetails) InternalError() string {
+	return e.err.Error()
+}
+
+func (e *ErrWithDetails) ExternalError() string {
+	return e.err.Error()
+}
+
+func (e *ErrWithDetails) GetStatusCode() int {
+	return e.statusCode
+}
+
 type ErrorOpts struct {
 	Code uint
 }
@@ -135,6 +164,11 @@ func HandleAPIError(
 			resp.Code = opts[0].Code
 		}
 
+		if detailsErr, ok := err.(*ErrWithDetails); ok {
+			resp.Code = detailsErr.code
+			resp.Details = detailsErr.details
+		}
+
 		// write the status code
 		w.WriteHeader(err.GetStatusCode())
 
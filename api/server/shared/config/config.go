@@ -10,6 +10,7 @@ import (
 	"github.com/porter-dev/porter/internal/billing"
 	"github.com/porter-dev/porter/internal/helm/urlcache"
 	"github.com/porter-dev/porter/internal/integrations/powerdns"
+	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/notifier"
 	"github.com/porter-dev/porter/internal/oauth"
 	"github.com/porter-dev/porter/internal/repository"
@@ -66,6 +67,10 @@ type Config struct {
 	// GoogleConf is the configuration for a Google OAuth client
 	GoogleConf *oauth2.Config
 
+	// InstanceSSOConfig is the configuration of the single sign-on identity provider of the
+	// instance, if one is configured
+	InstanceSSOConfig *models.SSOConfig
+
 	// SlackConf is the configuration for a Slack OAuth client
 	SlackConf *oauth2.Config
 
@@ -39,6 +39,7 @@ type ServerConf struct {
 	GithubLoginEnabled bool   `env:"GITHUB_LOGIN_ENABLED,default=true"`
 
 	GithubIncomingWebhookSecret string `env:"GITHUB_INCOMING_WEBHOOK_SECRET"`
+	GitlabIncomingWebhookSecret string `env:"GITLAB_INCOMING_WEBHOOK_SECRET"`
 
 	GithubAppClientID      string `env:"GITHUB_APP_CLIENT_ID"`
 	GithubAppClientSecret  string `env:"GITHUB_APP_CLIENT_SECRET"`
@@ -51,6 +52,28 @@ type ServerConf struct {
 	GoogleClientSecret     string `env:"GOOGLE_CLIENT_SECRET"`
 	GoogleRestrictedDomain string `env:"GOOGLE_RESTRICTED_DOMAIN"`
 
+	// Single sign-on with an OIDC or SAML identity provider for the instance. Users with an
+	// email in one of the SSO domains log in with the identity provider, and are added to the
+	// SSO project on their first login if it is set.
+	SSOKind            string   `env:"SSO_KIND"`
+	SSODomains         []string `env:"SSO_DOMAINS"`
+	SSOEnforce         bool     `env:"SSO_ENFORCE,default=false"`
+	SSOProjectID       uint     `env:"SSO_PROJECT_ID"`
+	SSODefaultRoleKind string   `env:"SSO_DEFAULT_ROLE,default=developer"`
+	SSOGroupsClaim     string   `env:"SSO_GROUPS_CLAIM"`
+
+	// SSOGroupMappings maps groups of the identity provider to role kinds or teams of the SSO
+	// project, for example "platform=admin,engineering=developer,oncall=team:3"
+	SSOGroupMappings []string `env:"SSO_GROUP_MAPPINGS"`
+
+	SSOOIDCIssuerURL    string `env:"SSO_OIDC_ISSUER_URL"`
+	SSOOIDCClientID     string `env:"SSO_OIDC_CLIENT_ID"`
+	SSOOIDCClientSecret string `env:"SSO_OIDC_CLIENT_SECRET"`
+
+	SSOSAMLIdPSSOURL      string `env:"SSO_SAML_IDP_SSO_URL"`
+	SSOSAMLIdPEntityID    string `env:"SSO_SAML_IDP_ENTITY_ID"`
+	SSOSAMLIdPCertificate string `env:"SSO_SAML_IDP_CERTIFICATE"`
+
 	SendgridAPIKey                  string `env:"SENDGRID_API_KEY"`
 	SendgridPWResetTemplateID       string `env:"SENDGRID_PW_RESET_TEMPLATE_ID"`
 	SendgridPWGHTemplateID          string `env:"SENDGRID_PW_GH_TEMPLATE_ID"`
@@ -99,6 +122,11 @@ type ServerConf struct {
 
 	// Disable filtering for project creation
 	DisableAllowlist bool `env:"DISABLE_ALLOWLIST,default=false"`
+
+	// Enable background tasks, such as scheduled drift detection. This should only be enabled
+	// on a single replica of the server, since tasks send notifications.
+	SchedulerEnabled  bool          `env:"SCHEDULER_ENABLED,default=false"`
+	SchedulerInterval time.Duration `env:"SCHEDULER_INTERVAL,default=1m"`
 }
 
 // DBConf is the database configuration: if generated from environment variables,
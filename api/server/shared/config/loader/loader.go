@@ -181,6 +181,14 @@ func (e *EnvConfigLoader) LoadConfig() (res *config.Config, err error) {
 		})
 	}
 
+	if sc.SSOKind != "" {
+		res.InstanceSSOConfig, err = getInstanceSSOConfig(sc)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid SSO configuration: %w", err)
+		}
+	}
+
 	res.WSUpgrader = &websocket.Upgrader{
 		WSUpgrader: &gorillaws.Upgrader{
 			ReadBufferSize:  1024,
@@ -0,0 +1,113 @@
+package loader
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/porter-dev/porter/api/server/shared/config/env"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/auth/sso"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// getInstanceSSOConfig returns the configuration of the identity provider of the instance.
+// The domains of the instance are trusted, so they do not need to be verified.
+func getInstanceSSOConfig(sc *env.ServerConf) (*models.SSOConfig, error) {
+	res := &models.SSOConfig{
+		ProjectID:       sc.SSOProjectID,
+		Kind:            types.SSOKind(sc.SSOKind),
+		DomainsVerified: true,
+		EnforceSSO:      sc.SSOEnforce,
+		DefaultRoleKind: types.RoleKind(sc.SSODefaultRoleKind),
+		GroupsClaim:     sc.SSOGroupsClaim,
+	}
+
+	if len(sc.SSODomains) == 0 {
+		return nil, fmt.Errorf("SSO_DOMAINS must be set")
+	}
+
+	for _, domain := range sc.SSODomains {
+		res.Domains = append(res.Domains, models.SSODomain{
+			Domain: strings.ToLower(strings.TrimSpace(domain)),
+		})
+	}
+
+	if !isSSORoleKind(res.DefaultRoleKind) {
+		return nil, fmt.Errorf("invalid SSO_DEFAULT_ROLE %s", res.DefaultRoleKind)
+	}
+
+	for _, mapping := range sc.SSOGroupMappings {
+		group, target, ok := strings.Cut(mapping, "=")
+
+		if !ok || group == "" {
+			return nil, fmt.Errorf("invalid SSO_GROUP_MAPPINGS entry %s", mapping)
+		}
+
+		groupMapping := models.SSOGroupMapping{
+			Group: group,
+		}
+
+		if strings.HasPrefix(target, "team:") {
+			id, err := strconv.ParseUint(strings.TrimPrefix(target, "team:"), 10, 64)
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid team in SSO_GROUP_MAPPINGS entry %s", mapping)
+			}
+
+			groupMapping.TeamID = uint(id)
+		} else if isSSORoleKind(types.RoleKind(target)) {
+			groupMapping.RoleKind = types.RoleKind(target)
+		} else {
+			return nil, fmt.Errorf("invalid role in SSO_GROUP_MAPPINGS entry %s", mapping)
+		}
+
+		res.GroupMappings = append(res.GroupMappings, groupMapping)
+	}
+
+	switch res.Kind {
+	case types.SSOKindOIDC:
+		if sc.SSOOIDCIssuerURL == "" || sc.SSOOIDCClientID == "" || sc.SSOOIDCClientSecret == "" {
+			return nil, fmt.Errorf("SSO_OIDC_ISSUER_URL, SSO_OIDC_CLIENT_ID and SSO_OIDC_CLIENT_SECRET must be set")
+		}
+
+		disc, err := sso.DiscoverOIDC(context.Background(), sc.SSOOIDCIssuerURL)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res.OIDCIssuerURL = disc.Issuer
+		res.OIDCAuthURL = disc.AuthURL
+		res.OIDCTokenURL = disc.TokenURL
+		res.OIDCJWKSURI = disc.JWKSURI
+		res.OIDCClientID = sc.SSOOIDCClientID
+		res.OIDCClientSecret = []byte(sc.SSOOIDCClientSecret)
+	case types.SSOKindSAML:
+		if sc.SSOSAMLIdPSSOURL == "" || sc.SSOSAMLIdPCertificate == "" {
+			return nil, fmt.Errorf("SSO_SAML_IDP_SSO_URL and SSO_SAML_IDP_CERTIFICATE must be set")
+		}
+
+		if _, err := sso.ParseCertificate(sc.SSOSAMLIdPCertificate); err != nil {
+			return nil, err
+		}
+
+		res.SAMLIdPSSOURL = sc.SSOSAMLIdPSSOURL
+		res.SAMLIdPEntityID = sc.SSOSAMLIdPEntityID
+		res.SAMLIdPCertificate = sc.SSOSAMLIdPCertificate
+	default:
+		return nil, fmt.Errorf("invalid SSO_KIND %s", res.Kind)
+	}
+
+	return res, nil
+}
+
+func isSSORoleKind(kind types.RoleKind) bool {
+	switch kind {
+	case types.RoleAdmin, types.RoleDeveloper, types.RoleViewer, types.RoleMember:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,48 @@
+package types
+
+import "time"
+
+type SBOMFormat string
+
+const (
+	SBOMFormatSPDX      SBOMFormat = "spdx"
+	SBOMFormatCycloneDX SBOMFormat = "cyclonedx"
+)
+
+// BuildAttestation records the supply chain metadata of an image built for a release. The
+// refs point to OCI artifacts stored alongside the image in the registry.
+type BuildAttestation struct {
+	ID uint `json:"id"`
+
+	// Revision is the release revision which deployed the image, which is only set when
+	// attestations are read for a specific revision
+	Revision int `json:"revision,omitempty"`
+
+	ImageRepoURI string `json:"image_repo_uri"`
+	Tag          string `json:"tag"`
+	Digest       string `json:"digest"`
+	GitSHA       string `json:"git_sha,omitempty"`
+
+	SBOMFormat    SBOMFormat `json:"sbom_format,omitempty"`
+	SBOMRef       string     `json:"sbom_ref,omitempty"`
+	ProvenanceRef string     `json:"provenance_ref,omitempty"`
+	SignatureRef  string     `json:"signature_ref,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type CreateBuildAttestationRequest struct {
+	ImageRepoURI string `json:"image_repo_uri" form:"required"`
+	Tag          string `json:"tag" form:"required"`
+	Digest       string `json:"digest" form:"required,startswith=sha256:"`
+	GitSHA       string `json:"git_sha"`
+
+	SBOMFormat    SBOMFormat `json:"sbom_format" form:"omitempty,oneof=spdx cyclonedx"`
+	SBOMRef       string     `json:"sbom_ref"`
+	ProvenanceRef string     `json:"provenance_ref"`
+	SignatureRef  string     `json:"signature_ref"`
+}
+
+type CreateBuildAttestationResponse BuildAttestation
+
+type ListBuildAttestationsResponse []*BuildAttestation
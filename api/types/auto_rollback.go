@@ -0,0 +1,20 @@
+package types
+
+// AutoRollbackConfig configures the automatic rollback of a release to its previous revision
+// when the rollout of an upgrade fails its health checks
+type AutoRollbackConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// WindowSeconds is the time in seconds that the workloads of an upgraded release are
+	// watched for, which defaults to 5 minutes. The release is rolled back if the rollout has
+	// not completed once the window expires.
+	WindowSeconds uint `json:"window_seconds,omitempty"`
+
+	// CrashThreshold is the number of restarts of a container in the new pods of the release
+	// which triggers a rollback, which defaults to 3
+	CrashThreshold uint `json:"crash_threshold,omitempty"`
+}
+
+type GetAutoRollbackResponse AutoRollbackConfig
+
+type UpdateAutoRollbackRequest AutoRollbackConfig
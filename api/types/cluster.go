@@ -191,6 +191,9 @@ type ListNamespacesResponse struct {
 
 type CreateNamespaceRequest struct {
 	Name string `json:"name" form:"required"`
+
+	// Policy is an optional resource quota and limit range to apply to the namespace
+	Policy *NamespacePolicy `json:"policy,omitempty"`
 }
 
 type CreateNamespaceResponse struct {
@@ -0,0 +1,102 @@
+package types
+
+import "time"
+
+// CostAllocationMethod is the method used to attribute node costs to workloads
+type CostAllocationMethod string
+
+const (
+	// CostAllocationMethodRequests attributes node costs by the resources requested by pods
+	CostAllocationMethodRequests CostAllocationMethod = "requests"
+
+	// CostAllocationMethodUsage attributes node costs by the resources used by pods, as
+	// reported by Prometheus
+	CostAllocationMethodUsage CostAllocationMethod = "usage"
+)
+
+// CostAllocationGroup is the level at which costs are aggregated
+type CostAllocationGroup string
+
+const (
+	CostAllocationGroupCluster   CostAllocationGroup = "cluster"
+	CostAllocationGroupNamespace CostAllocationGroup = "namespace"
+	CostAllocationGroupRelease   CostAllocationGroup = "release"
+)
+
+// NodeTypePrice is the hourly price of a single node of a given instance type
+type NodeTypePrice struct {
+	InstanceType string  `json:"instance_type" form:"required"`
+	HourlyPrice  float64 `json:"hourly_price" form:"gte=0"`
+}
+
+// ClusterCostConfig contains the node prices used to compute costs for a cluster. Prices are
+// in USD.
+type ClusterCostConfig struct {
+	// DefaultHourlyPrice is used for nodes whose instance type does not have a price
+	DefaultHourlyPrice float64 `json:"default_hourly_price"`
+
+	NodePrices []*NodeTypePrice `json:"node_prices"`
+}
+
+type GetClusterCostConfigResponse ClusterCostConfig
+
+type UpdateClusterCostConfigRequest struct {
+	DefaultHourlyPrice float64          `json:"default_hourly_price" form:"gte=0"`
+	NodePrices         []*NodeTypePrice `json:"node_prices" form:"dive"`
+}
+
+type GetCostAllocationRequest struct {
+	// By is the level at which costs are aggregated, defaults to namespace
+	By CostAllocationGroup `schema:"by" form:"omitempty,oneof=namespace release"`
+
+	// Method is the method used to attribute node costs, defaults to requests
+	Method CostAllocationMethod `schema:"method" form:"omitempty,oneof=requests usage"`
+
+	// Since is the length of the reporting window, such as 24h or 7d. Defaults to 24h.
+	Since string `schema:"since"`
+}
+
+type GetProjectCostRequest struct {
+	Method CostAllocationMethod `schema:"method" form:"omitempty,oneof=requests usage"`
+	Since  string               `schema:"since"`
+}
+
+// CostAllocation is the cost attributed to a single namespace, release or cluster over the
+// reporting window
+type CostAllocation struct {
+	// Name is the name of the namespace, release or cluster. For release allocations, an empty
+	// name means the pods could not be linked to a release.
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+
+	CPUCoreHours  float64 `json:"cpu_core_hours"`
+	MemoryGBHours float64 `json:"memory_gb_hours"`
+
+	CPUCost    float64 `json:"cpu_cost"`
+	MemoryCost float64 `json:"memory_cost"`
+	TotalCost  float64 `json:"total_cost"`
+}
+
+type CostReport struct {
+	By     CostAllocationGroup  `json:"by"`
+	Method CostAllocationMethod `json:"method"`
+	Start  time.Time            `json:"start"`
+	End    time.Time            `json:"end"`
+
+	// TotalCost is the cost of all nodes over the reporting window
+	TotalCost     float64 `json:"total_cost"`
+	AllocatedCost float64 `json:"allocated_cost"`
+
+	// IdleCost is the cost of node capacity which was not allocated to any workload
+	IdleCost          float64 `json:"idle_cost"`
+	IdleCPUCoreHours  float64 `json:"idle_cpu_core_hours"`
+	IdleMemoryGBHours float64 `json:"idle_memory_gb_hours"`
+
+	// UnpricedNodes lists the nodes which had no price configured, and which are excluded from
+	// the total cost
+	UnpricedNodes []string `json:"unpriced_nodes,omitempty"`
+
+	Allocations []*CostAllocation `json:"allocations"`
+}
+
+type GetCostAllocationResponse CostReport
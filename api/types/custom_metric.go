@@ -0,0 +1,57 @@
+package types
+
+import (
+	"time"
+
+	"github.com/porter-dev/porter/internal/kubernetes/prometheus"
+)
+
+// CustomMetric is a saved PromQL query for the pods of a release. The query is a template
+// which is rendered with {{.Namespace}}, {{.Name}} and {{.PodSelector}}, where the pod
+// selector is a label selector matching the pods of the release, for example
+// `sum(rate(http_requests_total{ {{.PodSelector}} }[5m]))`.
+type CustomMetric struct {
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Name  string `json:"name"`
+	Query string `json:"query"`
+	Unit  string `json:"unit,omitempty"`
+}
+
+type ListCustomMetricsResponse []*CustomMetric
+
+// CreateCustomMetricRequest creates a custom metric, or updates the custom metric of the
+// release with the same name
+type CreateCustomMetricRequest struct {
+	Name  string `json:"name" form:"required,max=255"`
+	Query string `json:"query" form:"required"`
+	Unit  string `json:"unit"`
+}
+
+type DeleteCustomMetricRequest struct {
+	Name string `json:"name" schema:"name" form:"required"`
+}
+
+type QueryCustomMetricRequest struct {
+	Name string `schema:"name" form:"required"`
+
+	StartRange uint   `schema:"startrange" form:"required"`
+	EndRange   uint   `schema:"endrange" form:"required"`
+	Resolution string `schema:"resolution" form:"required"`
+}
+
+type QueryCustomMetricResponse struct {
+	Name   string                           `json:"name"`
+	Unit   string                           `json:"unit,omitempty"`
+	Query  string                           `json:"query"`
+	Series []*prometheus.CustomMetricSeries `json:"series"`
+}
+
+type ListReleaseMetricNamesRequest struct {
+	StartRange uint `schema:"startrange"`
+	EndRange   uint `schema:"endrange"`
+}
+
+type ListReleaseMetricNamesResponse []string
@@ -1,5 +1,12 @@
 package types
 
+import "time"
+
+const (
+	URLParamDatabaseID         URLParam = "database_id"
+	URLParamDatabaseSnapshotID URLParam = "database_snapshot_id"
+)
+
 type Database struct {
 	ID uint `json:"id"`
 
@@ -11,6 +18,9 @@ type Database struct {
 
 	ClusterID uint `json:"cluster_id"`
 
+	// The kind of infra the database was provisioned with (rds, cloudsql or dopg)
+	Kind InfraKind `json:"kind"`
+
 	InstanceID        string `json:"instance_id"`
 	InstanceEndpoint  string `json:"instance_endpoint"`
 	InstanceName      string `json:"instance_name"`
@@ -18,6 +28,11 @@ type Database struct {
 	InstanceDBFamily  string `json:"instance_db_family"`
 	InstanceDBVersion string `json:"instance_db_version"`
 	Status            string `json:"status"`
+
+	// The env group which contains the connection info for the database, which is managed
+	// by Porter and can be attached to applications
+	EnvGroupName      string `json:"env_group_name,omitempty"`
+	EnvGroupNamespace string `json:"env_group_namespace,omitempty"`
 }
 
 type ListDatabaseResponse []*Database
@@ -25,3 +40,39 @@ type ListDatabaseResponse []*Database
 type UpdateDatabaseStatusRequest struct {
 	Status string `json:"status" form:"required,oneof=destroying updating"`
 }
+
+// DatabaseSnapshotStatus is the status that a database snapshot can take
+type DatabaseSnapshotStatus string
+
+const (
+	DatabaseSnapshotPending   DatabaseSnapshotStatus = "pending"
+	DatabaseSnapshotCreating  DatabaseSnapshotStatus = "creating"
+	DatabaseSnapshotAvailable DatabaseSnapshotStatus = "available"
+	DatabaseSnapshotFailed    DatabaseSnapshotStatus = "failed"
+)
+
+type DatabaseSnapshot struct {
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	DatabaseID uint `json:"database_id"`
+
+	Name   string                 `json:"name"`
+	Status DatabaseSnapshotStatus `json:"status"`
+
+	// The id of the operation which created the snapshot
+	OperationID string `json:"operation_id"`
+}
+
+type ListDatabaseSnapshotsResponse []*DatabaseSnapshot
+
+type CreateDatabaseSnapshotRequest struct {
+	// Name of the snapshot, which must be a valid snapshot identifier for every supported
+	// provider
+	Name string `json:"name" form:"required,max=63"`
+}
+
+type CreateDatabaseSnapshotResponse struct {
+	Snapshot  *DatabaseSnapshot `json:"snapshot"`
+	Operation *Operation        `json:"operation"`
+}
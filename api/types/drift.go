@@ -0,0 +1,53 @@
+package types
+
+import "time"
+
+// DriftFieldDiff is a single field which differs between the release manifest and the live
+// object. Expected is nil if the field only exists on the live object, and Actual is nil if the
+// field has been removed from the live object.
+type DriftFieldDiff struct {
+	Path     string      `json:"path"`
+	Expected interface{} `json:"expected"`
+	Actual   interface{} `json:"actual"`
+}
+
+type ResourceDrift struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+
+	// Missing is true if the object exists in the release manifest but not in the cluster
+	Missing bool `json:"missing"`
+
+	Diffs []DriftFieldDiff `json:"diffs"`
+}
+
+type ReleaseDrift struct {
+	Name      string    `json:"name"`
+	Namespace string    `json:"namespace"`
+	Revision  int       `json:"revision"`
+	Drifted   bool      `json:"drifted"`
+	CheckedAt time.Time `json:"checked_at"`
+
+	// Resources contains only the resources which have drifted from the release manifest
+	Resources []*ResourceDrift `json:"resources"`
+}
+
+type GetReleaseDriftResponse ReleaseDrift
+
+type DriftDetectionConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// IntervalMinutes is how often the drift check runs
+	IntervalMinutes uint `json:"interval_minutes"`
+
+	LastCheckedAt     *time.Time `json:"last_checked_at,omitempty"`
+	LastDriftDetected bool       `json:"last_drift_detected"`
+}
+
+type GetDriftDetectionConfigResponse DriftDetectionConfig
+
+type UpdateDriftDetectionConfigRequest struct {
+	Enabled         bool `json:"enabled"`
+	IntervalMinutes uint `json:"interval_minutes" form:"omitempty,min=5"`
+}
@@ -10,6 +10,8 @@ type Environment struct {
 	GitRepoOwner      string `json:"git_repo_owner"`
 	GitRepoName       string `json:"git_repo_name"`
 
+	GitlabIntegrationID uint `json:"gitlab_integration_id,omitempty"`
+
 	Name                 string `json:"name"`
 	Mode                 string `json:"mode"`
 	DeploymentCount      uint   `json:"deployment_count"`
@@ -42,6 +44,23 @@ const (
 	DeploymentStatusFailed   DeploymentStatus = "failed"
 )
 
+type DeploymentServiceStatus string
+
+const (
+	DeploymentServiceStatusDeployed DeploymentServiceStatus = "deployed"
+	DeploymentServiceStatusFailed   DeploymentServiceStatus = "failed"
+	DeploymentServiceStatusSkipped  DeploymentServiceStatus = "skipped"
+)
+
+// DeploymentService is the status of a single application or job which was applied as
+// part of a deployment
+type DeploymentService struct {
+	Name    string                  `json:"name" form:"required"`
+	Kind    string                  `json:"kind"`
+	Status  DeploymentServiceStatus `json:"status" form:"required,oneof=deployed failed skipped"`
+	Message string                  `json:"message,omitempty"`
+}
+
 type Deployment struct {
 	*GitHubMetadata
 
@@ -55,6 +74,10 @@ type Deployment struct {
 	PullRequestID      uint             `json:"pull_request_id"`
 	InstallationID     uint             `json:"gh_installation_id"`
 	LastWorkflowRunURL string           `json:"last_workflow_run_url"`
+
+	// Services is the status of each application and job in the deployment, in the order
+	// they were applied
+	Services []*DeploymentService `json:"services,omitempty"`
 }
 
 type CreateGHDeploymentRequest struct {
@@ -70,8 +93,9 @@ type CreateDeploymentRequest struct {
 }
 
 type FinalizeDeploymentRequest struct {
-	Namespace string `json:"namespace" form:"required"`
-	Subdomain string `json:"subdomain" form:"required"`
+	Namespace string               `json:"namespace" form:"required"`
+	Subdomain string               `json:"subdomain" form:"required"`
+	Services  []*DeploymentService `json:"services" form:"dive"`
 }
 
 type UpdateDeploymentRequest struct {
@@ -92,6 +116,8 @@ type UpdateDeploymentStatusRequest struct {
 	PRBranchFrom string `json:"gh_pr_branch_from" form:"required"`
 	Status       string `json:"status" form:"required,oneof=created creating inactive failed"`
 	Namespace    string `json:"namespace" form:"required"`
+
+	Services []*DeploymentService `json:"services" form:"dive"`
 }
 
 type DeleteDeploymentRequest struct {
@@ -1,7 +1,8 @@
 package types
 
 const (
-	ErrCodeUnavailable uint = 601
+	ErrCodeUnavailable          uint = 601
+	ErrCodeImagePolicyViolation uint = 602
 )
 
 type ExternalError struct {
@@ -9,4 +10,7 @@ type ExternalError struct {
 	Code uint `json:"code,omitempty"`
 
 	Error string `json:"error"`
+
+	// Optional structured details of the error, which depend on the error code
+	Details interface{} `json:"details,omitempty"`
 }
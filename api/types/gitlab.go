@@ -0,0 +1,94 @@
+package types
+
+import "time"
+
+const URLParamGitlabIntegrationID URLParam = "gitlab_integration_id"
+
+type GitlabAuthMechanism string
+
+const (
+	GitlabAuthOAuth               GitlabAuthMechanism = "oauth"
+	GitlabAuthPersonalAccessToken GitlabAuthMechanism = "pat"
+)
+
+// GitlabIntegration is a connection to a GitLab instance (gitlab.com or self-hosted)
+type GitlabIntegration struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	ID        uint `json:"id"`
+	ProjectID uint `json:"project_id"`
+	UserID    uint `json:"user_id"`
+
+	// The URL of the GitLab instance, such as https://gitlab.com
+	InstanceURL string `json:"instance_url"`
+
+	AuthMechanism GitlabAuthMechanism `json:"auth_mechanism"`
+
+	// The GitLab username that Porter is authenticated as. This is empty for OAuth
+	// integrations which have not completed the OAuth flow.
+	Username string `json:"username"`
+}
+
+// CreateGitlabIntegrationRequest creates a GitLab integration. Either a personal access
+// token, or the client ID and secret of a GitLab OAuth application must be set. When an
+// OAuth application is used, the integration must be authorized through the OAuth flow.
+type CreateGitlabIntegrationRequest struct {
+	InstanceURL string `json:"instance_url" form:"omitempty,url"`
+
+	PersonalAccessToken string `json:"personal_access_token" form:"required_without=AppClientID"`
+
+	AppClientID     string `json:"app_client_id" form:"required_without=PersonalAccessToken"`
+	AppClientSecret string `json:"app_client_secret" form:"required_with=AppClientID"`
+}
+
+type CreateGitlabIntegrationResponse GitlabIntegration
+
+type ListGitlabIntegrationsResponse []*GitlabIntegration
+
+// GitlabRepoRequest identifies a GitLab repository by its full path, such as
+// "group/subgroup/name"
+type GitlabRepoRequest struct {
+	Repo string `schema:"repo" form:"required"`
+}
+
+type GetGitlabContentsRequest struct {
+	GitlabRepoRequest
+
+	Branch string `schema:"branch" form:"required"`
+	Dir    string `schema:"dir"`
+}
+
+type GetGitlabProcfileRequest struct {
+	GitlabRepoRequest
+
+	Branch string `schema:"branch" form:"required"`
+	Path   string `schema:"path" form:"required"`
+}
+
+// CreateGitlabCIRequest generates a GitLab CI pipeline which deploys a release on every
+// push to a branch
+type CreateGitlabCIRequest struct {
+	Repo   string `json:"repo" form:"required"`
+	Branch string `json:"branch"`
+
+	ClusterID        uint   `json:"cluster_id" form:"required"`
+	ReleaseName      string `json:"release_name" form:"required"`
+	ReleaseNamespace string `json:"release_namespace" form:"required"`
+
+	// If DryRun is set, the pipeline is generated but is not committed to the repository
+	DryRun bool `json:"dry_run"`
+}
+
+type CreateGitlabCIResponse struct {
+	Path     string `json:"path"`
+	Contents string `json:"contents"`
+}
+
+// CreateGitlabEnvironmentRequest creates a preview environment for a GitLab repository,
+// which is deployed for each merge request
+type CreateGitlabEnvironmentRequest struct {
+	Repo      string `json:"repo" form:"required"`
+	ClusterID uint   `json:"cluster_id" form:"required"`
+
+	CreateEnvironmentRequest
+}
@@ -0,0 +1,50 @@
+package types
+
+// ImagePolicy restricts the images which can be deployed to a cluster. A policy can be set
+// for a project, which applies to every cluster in the project, or for a single cluster, which
+// takes precedence over the project policy.
+type ImagePolicy struct {
+	// RequireConnectedRegistry rejects images which are not stored in a registry connected
+	// to the project
+	RequireConnectedRegistry bool `json:"require_connected_registry"`
+
+	// DisallowMutableTags rejects images which are untagged or use a mutable tag. Images
+	// which are referenced by digest are always allowed.
+	DisallowMutableTags bool `json:"disallow_mutable_tags"`
+
+	// MutableTags is the list of tags which are considered mutable. Defaults to "latest".
+	MutableTags []string `json:"mutable_tags,omitempty"`
+
+	// RequireSignature rejects images which do not have a cosign signature which can be
+	// verified with the public key
+	RequireSignature bool `json:"require_signature"`
+
+	// PublicKey is a PEM-encoded public key used to verify image signatures
+	PublicKey string `json:"public_key,omitempty"`
+}
+
+type GetImagePolicyResponse struct {
+	// Policy is nil if no policy is set
+	Policy *ImagePolicy `json:"policy"`
+
+	// Inherited is true if the policy of a cluster is inherited from the project
+	Inherited bool `json:"inherited"`
+}
+
+type UpdateImagePolicyRequest ImagePolicy
+
+type ImagePolicyViolationReason string
+
+const (
+	ImagePolicyViolationInvalidImage        ImagePolicyViolationReason = "invalid_image"
+	ImagePolicyViolationUnconnectedRegistry ImagePolicyViolationReason = "unconnected_registry"
+	ImagePolicyViolationMutableTag          ImagePolicyViolationReason = "mutable_tag"
+	ImagePolicyViolationInvalidSignature    ImagePolicyViolationReason = "invalid_signature"
+)
+
+// ImagePolicyViolation is a single image which was rejected by an image policy
+type ImagePolicyViolation struct {
+	Image   string                     `json:"image"`
+	Reason  ImagePolicyViolationReason `json:"reason"`
+	Message string                     `json:"message"`
+}
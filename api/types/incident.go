@@ -0,0 +1,113 @@
+package types
+
+import "time"
+
+const URLParamIncidentRecordID URLParam = "incident_record_id"
+
+// IncidentStatus is the status of an incident record
+type IncidentStatus string
+
+const (
+	IncidentStatusOpen         IncidentStatus = "open"
+	IncidentStatusAcknowledged IncidentStatus = "acknowledged"
+	IncidentStatusResolved     IncidentStatus = "resolved"
+)
+
+// IncidentNoteKind is the kind of an entry in the timeline of an incident
+type IncidentNoteKind string
+
+const (
+	// IncidentNoteKindNote is a note added by a user
+	IncidentNoteKindNote IncidentNoteKind = "note"
+
+	// IncidentNoteKindStatus records a change to the status of the incident
+	IncidentNoteKindStatus IncidentNoteKind = "status"
+
+	// IncidentNoteKindAssignment records a change to the assignee of the incident
+	IncidentNoteKindAssignment IncidentNoteKind = "assignment"
+
+	// IncidentNoteKindAgent is a message reported by the porter-agent
+	IncidentNoteKindAgent IncidentNoteKind = "agent"
+)
+
+// IncidentRecord is the Porter-side record of an incident reported by the porter-agent
+type IncidentRecord struct {
+	ID uint `json:"id"`
+
+	// AgentIncidentID is the ID of the incident in the porter-agent
+	AgentIncidentID string `json:"agent_incident_id"`
+
+	ReleaseName string         `json:"release_name"`
+	Namespace   string         `json:"namespace"`
+	Status      IncidentStatus `json:"status"`
+	Summary     string         `json:"summary"`
+
+	// AssigneeID is the ID of the user the incident is assigned to, or 0 if it is not assigned
+	AssigneeID    uint   `json:"assignee_id"`
+	AssigneeEmail string `json:"assignee_email,omitempty"`
+
+	StartedAt      time.Time  `json:"started_at"`
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty"`
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty"`
+}
+
+// IncidentNote is an entry in the timeline of an incident
+type IncidentNote struct {
+	ID        uint             `json:"id"`
+	CreatedAt time.Time        `json:"created_at"`
+	Kind      IncidentNoteKind `json:"kind"`
+	Text      string           `json:"text"`
+
+	// UserID and UserEmail identify the user who added the entry, and are not set for entries
+	// which were added by Porter
+	UserID    uint   `json:"user_id,omitempty"`
+	UserEmail string `json:"user_email,omitempty"`
+}
+
+// IncidentDeployEvent is a revision of the release of an incident which was deployed around
+// the time of the incident
+type IncidentDeployEvent struct {
+	Revision     int       `json:"revision"`
+	Status       string    `json:"status"`
+	ChartVersion string    `json:"chart_version"`
+	Description  string    `json:"description"`
+	DeployedAt   time.Time `json:"deployed_at"`
+}
+
+type ListIncidentRecordsRequest struct {
+	Status      IncidentStatus `schema:"status"`
+	ReleaseName string         `schema:"release_name"`
+	Namespace   string         `schema:"namespace"`
+}
+
+type ListIncidentRecordsResponse []*IncidentRecord
+
+type GetIncidentRecordResponse struct {
+	*IncidentRecord
+
+	Timeline     []*IncidentNote        `json:"timeline"`
+	DeployEvents []*IncidentDeployEvent `json:"deploy_events"`
+}
+
+type AcknowledgeIncidentRequest struct {
+	// AssigneeID assigns the incident to a user of the project. The incident is assigned to
+	// the acknowledging user if it is not set.
+	AssigneeID uint `json:"assignee_id"`
+}
+
+type AssignIncidentRequest struct {
+	AssigneeID uint `json:"assignee_id" form:"required"`
+}
+
+type CreateIncidentNoteRequest struct {
+	Text string `json:"text" form:"required"`
+}
+
+type ResolveIncidentRequest struct {
+	// Note is added to the timeline of the incident, if it is set
+	Note string `json:"note"`
+}
+
+type GetIncidentPostmortemResponse struct {
+	Markdown string `json:"markdown"`
+}
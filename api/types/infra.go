@@ -29,9 +29,21 @@ const (
 	InfraAKS  InfraKind = "aks"
 	InfraACR  InfraKind = "acr"
 
-	InfraRDS InfraKind = "rds"
+	InfraRDS      InfraKind = "rds"
+	InfraCloudSQL InfraKind = "cloudsql"
+	InfraDOPG     InfraKind = "dopg"
 )
 
+// IsDatabaseKind returns true if the infra kind provisions a database
+func IsDatabaseKind(kind InfraKind) bool {
+	switch kind {
+	case InfraRDS, InfraCloudSQL, InfraDOPG:
+		return true
+	}
+
+	return false
+}
+
 type Infra struct {
 	ID uint `json:"id"`
 
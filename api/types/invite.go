@@ -11,6 +11,9 @@ type Invite struct {
 	Email    string `json:"email"`
 	Accepted bool   `json:"accepted"`
 	Kind     string `json:"kind"`
+
+	// TeamID is the team the invited user is added to when the invite is accepted
+	TeamID uint `json:"team_id,omitempty"`
 }
 
 type GetInviteResponse Invite
@@ -18,6 +21,11 @@ type GetInviteResponse Invite
 type CreateInviteRequest struct {
 	Email string `json:"email,required"`
 	Kind  string `json:"kind,required"`
+
+	// TeamID is optional, and adds the invited user to a team of the project when the
+	// invite is accepted. If the kind is not set, the user only gets the permissions of
+	// the team.
+	TeamID uint `json:"team_id"`
 }
 
 type CreateInviteResponse struct {
@@ -0,0 +1,52 @@
+package types
+
+import "time"
+
+const (
+	// DefaultLogSearchLimit is the number of lines returned by a log search if the request
+	// does not set a limit
+	DefaultLogSearchLimit uint = 100
+
+	// MaxLogSearchLimit is the maximum number of lines returned by a single log search
+	MaxLogSearchLimit uint = 1000
+)
+
+// SearchLogsRequest searches the historical logs of a release or namespace
+type SearchLogsRequest struct {
+	// StartRange and EndRange are unix timestamps in seconds. The search covers the last
+	// hour if they are not set.
+	StartRange uint `schema:"start_range"`
+	EndRange   uint `schema:"end_range"`
+
+	// Query is the text each line must contain, or a regular expression each line must match
+	// if Regex is set
+	Query string `schema:"query"`
+	Regex bool   `schema:"regex"`
+
+	PodName       string `schema:"pod_name"`
+	ContainerName string `schema:"container_name"`
+
+	Limit uint `schema:"limit"`
+
+	// Cursor is the next_cursor of the previous page of results
+	Cursor string `schema:"cursor"`
+}
+
+// LogLine is a single line of the historical logs of a pod
+type LogLine struct {
+	Timestamp     time.Time `json:"timestamp"`
+	PodName       string    `json:"pod_name"`
+	ContainerName string    `json:"container_name"`
+	Line          string    `json:"line"`
+}
+
+// SearchLogsResponse contains a page of log lines, ordered from oldest to newest
+type SearchLogsResponse struct {
+	Logs []*LogLine `json:"logs"`
+
+	// NextCursor is set if there are more lines which match the search
+	NextCursor string `json:"next_cursor,omitempty"`
+
+	// Backend is the name of the log backend which served the search
+	Backend string `json:"backend"`
+}
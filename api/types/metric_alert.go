@@ -0,0 +1,86 @@
+package types
+
+import "time"
+
+const (
+	URLParamMetricAlertRuleID URLParam = "metric_alert_rule_id"
+)
+
+// MetricAlertMetric is the metric of a release which an alert rule is evaluated on
+type MetricAlertMetric string
+
+const (
+	// MetricAlertMetricLatencyP95 is the p95 latency of the requests to the release's
+	// ingresses, in seconds
+	MetricAlertMetricLatencyP95 MetricAlertMetric = "latency_p95"
+
+	// MetricAlertMetricErrorRate is the percentage of the requests to the release's ingresses
+	// which returned a 5xx status code
+	MetricAlertMetricErrorRate MetricAlertMetric = "error_rate"
+
+	// MetricAlertMetricMemoryLimit is the memory usage of the release's containers as a
+	// percentage of their memory limit
+	MetricAlertMetricMemoryLimit MetricAlertMetric = "memory_limit"
+
+	// MetricAlertMetricHPAMaxReplicas is the number of replicas of the release's autoscalers as
+	// a percentage of their maximum number of replicas
+	MetricAlertMetricHPAMaxReplicas MetricAlertMetric = "hpa_max_replicas"
+
+	// MetricAlertMetricCustom is a custom metric saved for the release
+	MetricAlertMetricCustom MetricAlertMetric = "custom"
+)
+
+type MetricAlertComparator string
+
+const (
+	MetricAlertComparatorGreaterThan        MetricAlertComparator = ">"
+	MetricAlertComparatorGreaterThanOrEqual MetricAlertComparator = ">="
+	MetricAlertComparatorLessThan           MetricAlertComparator = "<"
+	MetricAlertComparatorLessThanOrEqual    MetricAlertComparator = "<="
+)
+
+type MetricAlertState string
+
+const (
+	MetricAlertStateOK      MetricAlertState = "ok"
+	MetricAlertStatePending MetricAlertState = "pending"
+	MetricAlertStateFiring  MetricAlertState = "firing"
+	MetricAlertStateNoData  MetricAlertState = "no_data"
+)
+
+type MetricAlertRule struct {
+	ID uint `json:"id"`
+
+	Name   string            `json:"name"`
+	Metric MetricAlertMetric `json:"metric"`
+
+	// CustomMetricName is the name of the custom metric of the release, if the metric is custom
+	CustomMetricName string `json:"custom_metric_name,omitempty"`
+
+	Comparator MetricAlertComparator `json:"comparator"`
+	Threshold  float64               `json:"threshold"`
+
+	// ForMinutes is how long the threshold must be crossed before the alert fires
+	ForMinutes uint `json:"for_minutes"`
+
+	Enabled bool `json:"enabled"`
+
+	State           MetricAlertState `json:"state"`
+	LastValue       *float64         `json:"last_value,omitempty"`
+	LastEvaluatedAt *time.Time       `json:"last_evaluated_at,omitempty"`
+	FiringSince     *time.Time       `json:"firing_since,omitempty"`
+}
+
+type ListMetricAlertRulesResponse []*MetricAlertRule
+
+type CreateMetricAlertRuleRequest struct {
+	Name             string                `json:"name" form:"required,max=255"`
+	Metric           MetricAlertMetric     `json:"metric" form:"required,oneof=latency_p95 error_rate memory_limit hpa_max_replicas custom"`
+	CustomMetricName string                `json:"custom_metric_name"`
+	Comparator       MetricAlertComparator `json:"comparator" form:"required,oneof=> >= < <="`
+	Threshold        float64               `json:"threshold"`
+	ForMinutes       uint                  `json:"for_minutes"`
+	Enabled          bool                  `json:"enabled"`
+}
+
+type UpdateMetricAlertRuleRequest CreateMetricAlertRuleRequest
@@ -0,0 +1,42 @@
+package types
+
+// NamespaceQuota limits the total resources which can be requested in a namespace. Resource
+// amounts use Kubernetes quantities, such as "2" or "500m" for CPU and "4Gi" for memory.
+// Fields which are not set are not limited.
+type NamespaceQuota struct {
+	CPU                    string `json:"cpu,omitempty"`
+	Memory                 string `json:"memory,omitempty"`
+	Pods                   uint   `json:"pods,omitempty"`
+	PersistentVolumeClaims uint   `json:"persistent_volume_claims,omitempty"`
+	Storage                string `json:"storage,omitempty"`
+}
+
+// NamespaceLimitRange sets the default and maximum resources for each container in a
+// namespace
+type NamespaceLimitRange struct {
+	DefaultCPURequest    string `json:"default_cpu_request,omitempty"`
+	DefaultMemoryRequest string `json:"default_memory_request,omitempty"`
+	DefaultCPULimit      string `json:"default_cpu_limit,omitempty"`
+	DefaultMemoryLimit   string `json:"default_memory_limit,omitempty"`
+	MaxCPU               string `json:"max_cpu,omitempty"`
+	MaxMemory            string `json:"max_memory,omitempty"`
+}
+
+// NamespacePolicy is the resource quota and limit range which Porter maintains for a namespace
+type NamespacePolicy struct {
+	Quota      *NamespaceQuota      `json:"quota,omitempty"`
+	LimitRange *NamespaceLimitRange `json:"limit_range,omitempty"`
+}
+
+// NamespaceQuotaUsage is the current usage of each resource limited by the namespace quota
+type NamespaceQuotaUsage struct {
+	Hard map[string]string `json:"hard"`
+	Used map[string]string `json:"used"`
+}
+
+type GetNamespacePolicyResponse struct {
+	Policy *NamespacePolicy     `json:"policy"`
+	Usage  *NamespaceQuotaUsage `json:"usage,omitempty"`
+}
+
+type UpdateNamespacePolicyRequest NamespacePolicy
@@ -0,0 +1,50 @@
+package types
+
+import "github.com/porter-dev/porter/internal/kubernetes/nodes"
+
+const URLParamNodePoolName URLParam = "node_pool_name"
+
+// NodePool is a group of nodes with the same instance type in a provisioned cluster
+type NodePool struct {
+	Name         string            `json:"name"`
+	InstanceType string            `json:"instance_type"`
+	MinSize      uint              `json:"min_size"`
+	MaxSize      uint              `json:"max_size"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	Taints       []string          `json:"taints,omitempty"`
+
+	// Managed is false for node pools which exist in the cluster but are not configured
+	// through the infra values, such as pools created outside of Porter
+	Managed bool `json:"managed"`
+
+	Nodes []*nodes.NodeWithUsageData `json:"nodes"`
+}
+
+type ListNodePoolsResponse []*NodePool
+
+type CreateNodePoolRequest struct {
+	*InfraCredentials
+
+	Name         string            `json:"name" form:"required,hostname_rfc1123,max=40"`
+	InstanceType string            `json:"instance_type" form:"required"`
+	MinSize      uint              `json:"min_size"`
+	MaxSize      uint              `json:"max_size" form:"required,gtefield=MinSize"`
+	Labels       map[string]string `json:"labels"`
+	Taints       []string          `json:"taints"`
+}
+
+type UpdateNodePoolRequest struct {
+	*InfraCredentials
+
+	// Fields which are not set are not changed
+	InstanceType string `json:"instance_type"`
+	MinSize      *uint  `json:"min_size"`
+	MaxSize      *uint  `json:"max_size"`
+}
+
+type DeleteNodePoolRequest struct {
+	*InfraCredentials
+
+	// SkipDrain removes the node pool without cordoning and draining its nodes first
+	SkipDrain bool `json:"skip_drain"`
+}
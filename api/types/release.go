@@ -15,13 +15,15 @@ type Release struct {
 }
 
 type PorterRelease struct {
-	ID              uint             `json:"id"`
-	WebhookToken    string           `json:"webhook_token"`
-	LatestVersion   string           `json:"latest_version"`
-	GitActionConfig *GitActionConfig `json:"git_action_config,omitempty"`
-	ImageRepoURI    string           `json:"image_repo_uri"`
-	BuildConfig     *BuildConfig     `json:"build_config,omitempty"`
-	Tags            []string         `json:"tags,omitempty"`
+	ID              uint                `json:"id"`
+	WebhookToken    string              `json:"webhook_token"`
+	LatestVersion   string              `json:"latest_version"`
+	GitActionConfig *GitActionConfig    `json:"git_action_config,omitempty"`
+	ImageRepoURI    string              `json:"image_repo_uri"`
+	BuildConfig     *BuildConfig        `json:"build_config,omitempty"`
+	Tags            []string            `json:"tags,omitempty"`
+	DeployHooks     *ReleaseHooks       `json:"deploy_hooks,omitempty"`
+	AutoRollback    *AutoRollbackConfig `json:"auto_rollback,omitempty"`
 }
 
 type GetReleaseResponse Release
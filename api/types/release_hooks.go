@@ -0,0 +1,39 @@
+package types
+
+type ReleaseHookType string
+
+const (
+	// ReleaseHookPreDeploy runs before a release is upgraded, and a failure aborts the upgrade
+	ReleaseHookPreDeploy ReleaseHookType = "pre_deploy"
+
+	// ReleaseHookPostDeploy runs once the workloads of an upgraded release have rolled out
+	ReleaseHookPostDeploy ReleaseHookType = "post_deploy"
+)
+
+const (
+	// ReleaseStepUpgradeEventID and ReleaseStepUpgradeIndex identify the release step which
+	// records the result of an upgrade that was run in the background, which is the case for
+	// releases with a pre-deploy hook
+	ReleaseStepUpgradeEventID       = "upgrade"
+	ReleaseStepUpgradeIndex   int64 = 340
+)
+
+// ReleaseHook is a command which is run as a job with the image and env groups of a release
+type ReleaseHook struct {
+	Command string `json:"command" form:"required"`
+
+	// TimeoutSeconds is the maximum run time of the job, which defaults to 10 minutes
+	TimeoutSeconds uint `json:"timeout_seconds,omitempty"`
+}
+
+type ReleaseHooks struct {
+	PreDeploy  *ReleaseHook `json:"pre_deploy,omitempty"`
+	PostDeploy *ReleaseHook `json:"post_deploy,omitempty"`
+}
+
+type GetReleaseHooksResponse ReleaseHooks
+
+type UpdateReleaseHooksRequest struct {
+	PreDeploy  *ReleaseHook `json:"pre_deploy,omitempty"`
+	PostDeploy *ReleaseHook `json:"post_deploy,omitempty"`
+}
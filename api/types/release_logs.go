@@ -0,0 +1,29 @@
+package types
+
+// StreamReleaseLogsRequest selects the logs which are streamed from the pods of a release
+type StreamReleaseLogsRequest struct {
+	// Container limits the logs to containers with this name. The logs of every container
+	// are streamed if it is empty.
+	Container string `schema:"container"`
+
+	// SinceSeconds only streams logs which are newer than this many seconds
+	SinceSeconds int64 `schema:"since_seconds"`
+
+	// TailLines limits the number of lines streamed from each container when the stream
+	// starts
+	TailLines int64 `schema:"tail_lines"`
+
+	// Grep is a regular expression which every streamed line must match
+	Grep string `schema:"grep"`
+
+	// Follow keeps the stream open and picks up new pods as they appear
+	Follow bool `schema:"follow"`
+}
+
+// ReleaseLogLine is a single line of the logs of a release, which is sent as a websocket
+// message by the release logs stream
+type ReleaseLogLine struct {
+	PodName       string `json:"pod_name"`
+	ContainerName string `json:"container_name"`
+	Line          string `json:"line"`
+}
@@ -0,0 +1,56 @@
+package types
+
+import "time"
+
+const URLParamRemoteBuildID URLParam = "build_id"
+
+// RemoteBuilder is the tool which builds images in the cluster
+type RemoteBuilder string
+
+const (
+	RemoteBuilderKaniko   RemoteBuilder = "kaniko"
+	RemoteBuilderBuildKit RemoteBuilder = "buildkit"
+)
+
+// RemoteBuildStatus is the status of an image build running in the cluster
+type RemoteBuildStatus string
+
+const (
+	// the build job was created, and is waiting for the build context to be uploaded
+	RemoteBuildStatusPending RemoteBuildStatus = "pending"
+
+	RemoteBuildStatusBuilding  RemoteBuildStatus = "building"
+	RemoteBuildStatusSucceeded RemoteBuildStatus = "succeeded"
+	RemoteBuildStatusFailed    RemoteBuildStatus = "failed"
+)
+
+type RemoteBuild struct {
+	ID           string            `json:"id"`
+	Namespace    string            `json:"namespace"`
+	Builder      RemoteBuilder     `json:"builder"`
+	ImageRepoURI string            `json:"image_repo_uri"`
+	Tag          string            `json:"tag"`
+	Status       RemoteBuildStatus `json:"status"`
+	Message      string            `json:"message,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+	CompletedAt  *time.Time        `json:"completed_at,omitempty"`
+}
+
+type CreateRemoteBuildRequest struct {
+	ImageRepoURI string        `json:"image_repo_uri" form:"required"`
+	Tag          string        `json:"tag" form:"required"`
+	Builder      RemoteBuilder `json:"builder" form:"omitempty,oneof=kaniko buildkit"`
+
+	// Dockerfile is the path of the Dockerfile, relative to the root of the build context
+	Dockerfile string            `json:"dockerfile"`
+	BuildArgs  map[string]string `json:"build_args"`
+
+	// CacheFrom and CacheTo are image refs in the same registry as the image, which are used
+	// to import and export the build cache
+	CacheFrom []string `json:"cache_from"`
+	CacheTo   string   `json:"cache_to"`
+}
+
+type CreateRemoteBuildResponse RemoteBuild
+
+type GetRemoteBuildResponse RemoteBuild
@@ -7,6 +7,10 @@ const (
 	RoleDeveloper RoleKind = "developer"
 	RoleViewer    RoleKind = "viewer"
 	RoleCustom    RoleKind = "custom"
+
+	// RoleMember grants no permissions by itself, and is the role of users who only have
+	// the permissions of their teams in a project
+	RoleMember RoleKind = "member"
 )
 
 type Role struct {
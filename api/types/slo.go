@@ -0,0 +1,89 @@
+package types
+
+import "time"
+
+const (
+	URLParamSLOID URLParam = "slo_id"
+)
+
+type SLOKind string
+
+const (
+	// SLOKindAvailability is an objective for the percentage of requests to the release's
+	// ingresses which do not return a 5xx status code
+	SLOKindAvailability SLOKind = "availability"
+
+	// SLOKindLatency is an objective for the percentage of requests to the release's ingresses
+	// which complete within the latency threshold
+	SLOKindLatency SLOKind = "latency"
+)
+
+const (
+	DefaultSLOWindowDays uint = 30
+
+	// FastBurnRateThreshold and SlowBurnRateThreshold are the 1h and 6h burn rates above which
+	// the error budget of a 30 day window would be spent in about 2 and 5 days respectively
+	FastBurnRateThreshold float64 = 14.4
+	SlowBurnRateThreshold float64 = 6
+)
+
+type SLO struct {
+	ID uint `json:"id"`
+
+	Name string  `json:"name"`
+	Kind SLOKind `json:"kind"`
+
+	// Objective is the target percentage of good requests, such as 99.9
+	Objective float64 `json:"objective"`
+
+	// LatencyThresholdMS is the latency under which a request is good, for latency objectives
+	LatencyThresholdMS uint `json:"latency_threshold_ms,omitempty"`
+
+	// WindowDays is the rolling window which the error budget is computed over
+	WindowDays uint `json:"window_days"`
+
+	// BlockDeploys is true if `porter update` should refuse to deploy the release while the
+	// error budget is exhausted
+	BlockDeploys bool `json:"block_deploys"`
+}
+
+type ListSLOsResponse []*SLO
+
+type CreateSLORequest struct {
+	Name               string  `json:"name" form:"required,max=255"`
+	Kind               SLOKind `json:"kind" form:"required,oneof=availability latency"`
+	Objective          float64 `json:"objective" form:"required,gt=0,lt=100"`
+	LatencyThresholdMS uint    `json:"latency_threshold_ms"`
+	WindowDays         uint    `json:"window_days" form:"omitempty,max=90"`
+	BlockDeploys       bool    `json:"block_deploys"`
+}
+
+type UpdateSLORequest CreateSLORequest
+
+type SLOStatus struct {
+	SLO *SLO `json:"slo"`
+
+	// TotalRequests is the number of requests to the release in the window of the SLO
+	TotalRequests float64 `json:"total_requests"`
+
+	// Compliance is the percentage of good requests in the window of the SLO, which is nil if
+	// the release did not receive any requests
+	Compliance *float64 `json:"compliance,omitempty"`
+
+	// ErrorBudgetRemaining is the fraction of the error budget which has not been spent, and
+	// is negative once the budget is overspent
+	ErrorBudgetRemaining float64 `json:"error_budget_remaining"`
+	BudgetExhausted      bool    `json:"budget_exhausted"`
+
+	// BurnRate1h and BurnRate6h are how many times faster than sustainable the error budget
+	// was spent over the last hour and the last 6 hours
+	BurnRate1h *float64 `json:"burn_rate_1h,omitempty"`
+	BurnRate6h *float64 `json:"burn_rate_6h,omitempty"`
+
+	// Burning is true if either burn rate is above its threshold
+	Burning bool `json:"burning"`
+
+	EvaluatedAt time.Time `json:"evaluated_at"`
+}
+
+type GetSLOStatusesResponse []*SLOStatus
@@ -0,0 +1,98 @@
+package types
+
+import "time"
+
+const URLParamSSOConfigID URLParam = "sso_config_id"
+
+type SSOKind string
+
+const (
+	SSOKindOIDC SSOKind = "oidc"
+	SSOKindSAML SSOKind = "saml"
+)
+
+// SSOGroupMapping maps a group of the identity provider to a role kind in the project or to
+// a team of the project. Users in the group get the role kind, or are added to the team,
+// when they log in.
+type SSOGroupMapping struct {
+	Group    string   `json:"group" form:"required,max=255"`
+	RoleKind RoleKind `json:"role_kind,omitempty" form:"omitempty,oneof=admin developer viewer member"`
+	TeamID   uint     `json:"team_id,omitempty"`
+}
+
+// SSOConfig is the configuration of a single sign-on identity provider of a project. Users
+// with an email in one of the domains of the config log in with the identity provider once
+// the domains are verified, and are added to the project on their first login.
+type SSOConfig struct {
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	ProjectID uint      `json:"project_id"`
+
+	Kind SSOKind `json:"kind"`
+
+	Domains         []string `json:"domains"`
+	DomainsVerified bool     `json:"domains_verified"`
+
+	// VerificationRecord is the value of the TXT record to create at
+	// _porter-verification.<domain> for every domain of the config
+	VerificationRecord string `json:"verification_record"`
+
+	// EnforceSSO disables password login for users with an email in the domains of the config
+	EnforceSSO bool `json:"enforce_sso"`
+
+	// DefaultRoleKind is the role kind of users who are not in a group mapped to a role kind
+	DefaultRoleKind RoleKind `json:"default_role_kind"`
+
+	// GroupsClaim is the name of the OIDC claim or of the SAML attribute with the groups of
+	// the user, and defaults to "groups"
+	GroupsClaim   string             `json:"groups_claim,omitempty"`
+	GroupMappings []*SSOGroupMapping `json:"group_mappings"`
+
+	OIDCIssuerURL string `json:"oidc_issuer_url,omitempty"`
+	OIDCClientID  string `json:"oidc_client_id,omitempty"`
+
+	// OIDCRedirectURL is the redirect url to register with the identity provider
+	OIDCRedirectURL string `json:"oidc_redirect_url,omitempty"`
+
+	SAMLIdPSSOURL      string `json:"saml_idp_sso_url,omitempty"`
+	SAMLIdPEntityID    string `json:"saml_idp_entity_id,omitempty"`
+	SAMLIdPCertificate string `json:"saml_idp_certificate,omitempty"`
+
+	// SAMLEntityID and SAMLACSURL are the entity id and the assertion consumer service url to
+	// register with the identity provider. The entity id is the url of the metadata of Porter.
+	SAMLEntityID string `json:"saml_entity_id,omitempty"`
+	SAMLACSURL   string `json:"saml_acs_url,omitempty"`
+}
+
+type ListSSOConfigsResponse []*SSOConfig
+
+type CreateSSOConfigRequest struct {
+	Kind SSOKind `json:"kind" form:"required,oneof=oidc saml"`
+
+	UpdateSSOConfigRequest
+}
+
+// UpdateSSOConfigRequest replaces the configuration of an identity provider. The client
+// secret is kept if it is empty, and changing the domains requires verifying them again.
+type UpdateSSOConfigRequest struct {
+	Domains         []string           `json:"domains" form:"required,min=1,dive,fqdn"`
+	EnforceSSO      bool               `json:"enforce_sso"`
+	DefaultRoleKind RoleKind           `json:"default_role_kind" form:"required,oneof=admin developer viewer member"`
+	GroupsClaim     string             `json:"groups_claim" form:"max=255"`
+	GroupMappings   []*SSOGroupMapping `json:"group_mappings" form:"dive"`
+
+	OIDCIssuerURL    string `json:"oidc_issuer_url" form:"omitempty,url"`
+	OIDCClientID     string `json:"oidc_client_id"`
+	OIDCClientSecret string `json:"oidc_client_secret"`
+
+	SAMLIdPSSOURL      string `json:"saml_idp_sso_url" form:"omitempty,url"`
+	SAMLIdPEntityID    string `json:"saml_idp_entity_id"`
+	SAMLIdPCertificate string `json:"saml_idp_certificate"`
+}
+
+// SSOLoginRequest starts a single sign-on login with the identity provider of the domain of
+// an email
+type SSOLoginRequest struct {
+	Email       string `schema:"email" form:"required,email"`
+	RedirectURI string `schema:"redirect_uri"`
+}
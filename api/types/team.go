@@ -0,0 +1,43 @@
+package types
+
+import "time"
+
+const URLParamTeamID URLParam = "team_id"
+
+// Team is a group of users in a project. Members of a team inherit the permissions of the
+// role kind of the team, in addition to the permissions of their own role in the project.
+type Team struct {
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	ProjectID uint      `json:"project_id"`
+
+	Name string   `json:"name"`
+	Kind RoleKind `json:"kind"`
+
+	Members []*TeamMember `json:"members"`
+}
+
+type TeamMember struct {
+	UserID uint   `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+type ListTeamsResponse []*Team
+
+type CreateTeamRequest struct {
+	Name string `json:"name" form:"required,max=255"`
+	Kind string `json:"kind" form:"required,oneof=admin developer viewer"`
+}
+
+type UpdateTeamRequest struct {
+	Name string `json:"name" form:"max=255"`
+	Kind string `json:"kind" form:"omitempty,oneof=admin developer viewer"`
+}
+
+type AddTeamMemberRequest struct {
+	UserID uint `json:"user_id" form:"required"`
+}
+
+type RemoveTeamMemberRequest struct {
+	UserID uint `schema:"user_id" form:"required"`
+}
@@ -0,0 +1,56 @@
+package types
+
+type TopologyNodeStatus string
+
+const (
+	TopologyNodeStatusHealthy     TopologyNodeStatus = "healthy"
+	TopologyNodeStatusProgressing TopologyNodeStatus = "progressing"
+	TopologyNodeStatusDegraded    TopologyNodeStatus = "degraded"
+	TopologyNodeStatusMissing     TopologyNodeStatus = "missing"
+	TopologyNodeStatusUnknown     TopologyNodeStatus = "unknown"
+)
+
+type TopologyEdgeType string
+
+const (
+	// TopologyEdgeControl links a controller to the objects it owns (Deployment -> ReplicaSet -> Pod)
+	TopologyEdgeControl TopologyEdgeType = "control"
+
+	// TopologyEdgeLabel links an object with a label selector to the pods it selects
+	TopologyEdgeLabel TopologyEdgeType = "label"
+
+	// TopologyEdgeSpec links objects that reference each other by name in their spec
+	TopologyEdgeSpec TopologyEdgeType = "spec"
+
+	// TopologyEdgeEnvGroup links a workload to the env group configmaps and secrets it reads from
+	TopologyEdgeEnvGroup TopologyEdgeType = "env_group"
+)
+
+type TopologyNode struct {
+	ID        string             `json:"id"`
+	Kind      string             `json:"kind"`
+	Name      string             `json:"name"`
+	Namespace string             `json:"namespace"`
+	Status    TopologyNodeStatus `json:"status"`
+	Message   string             `json:"message,omitempty"`
+
+	// InChart is true when the object is declared in the release manifest, and false when
+	// the object was discovered in the cluster (for example, pods and replicasets)
+	InChart bool `json:"in_chart"`
+
+	// EnvGroup is the name of the env group that a configmap or secret belongs to
+	EnvGroup string `json:"env_group,omitempty"`
+}
+
+type TopologyEdge struct {
+	Source string           `json:"source"`
+	Target string           `json:"target"`
+	Type   TopologyEdgeType `json:"type"`
+}
+
+type ReleaseTopology struct {
+	Nodes []*TopologyNode `json:"nodes"`
+	Edges []*TopologyEdge `json:"edges"`
+}
+
+type GetReleaseTopologyResponse ReleaseTopology
@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/url"
@@ -10,6 +11,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cli/cli/git"
 	"github.com/fatih/color"
@@ -91,14 +93,20 @@ func apply(_ *types.GetAuthenticatedUserResponse, client *api.Client, args []str
 		return err
 	}
 
+	if err := prepareResourceGroup(resGroup); err != nil {
+		return err
+	}
+
 	basePath, err := os.Getwd()
 
 	if err != nil {
 		return err
 	}
 
+	services := newServiceStatuses()
+
 	worker := worker.NewWorker()
-	worker.RegisterDriver("deploy", NewPorterDriver)
+	worker.RegisterDriver("deploy", newPorterDriverFunc(services))
 	worker.RegisterDriver("build-image", preview.NewBuildDriver)
 	worker.RegisterDriver("push-image", preview.NewPushDriver)
 	worker.RegisterDriver("update-config", preview.NewUpdateConfigDriver)
@@ -115,7 +123,7 @@ func apply(_ *types.GetAuthenticatedUserResponse, client *api.Client, args []str
 			return fmt.Errorf("namespace must be set by PORTER_NAMESPACE")
 		}
 
-		deploymentHook, err := NewDeploymentHook(client, resGroup, deplNamespace)
+		deploymentHook, err := NewDeploymentHook(client, resGroup, services, deplNamespace)
 
 		if err != nil {
 			return err
@@ -171,11 +179,23 @@ func hasDeploymentHookEnvVars() bool {
 type ApplicationConfig struct {
 	WaitForJob bool
 
+	// If set to true, this waits for the workloads of the application to become healthy after
+	// it is deployed. This defaults to true when another resource depends on the application.
+	WaitForHealthy bool
+
+	// WaitForHealthyTimeout is the number of seconds to wait for the application to become
+	// healthy, which defaults to 10 minutes
+	WaitForHealthyTimeout uint
+
 	// If set to true, this does not run an update, it only creates the initial application and job,
 	// skipping subsequent updates
 	OnlyCreate bool
 
 	Build struct {
+		// From is the name of a build-image or push-image resource whose image is deployed,
+		// so that services built from the same source share a single build
+		From string
+
 		UseCache   bool `mapstructure:"use_cache"`
 		Method     string
 		Context    string
@@ -184,19 +204,69 @@ type ApplicationConfig struct {
 		Builder    string
 		Buildpacks []string
 		Env        map[string]string
+
+		// RemoteBuilder is the in-cluster builder ("kaniko" or "buildkit") used when the
+		// method is "remote"
+		RemoteBuilder string `mapstructure:"remote_builder"`
+
+		// CacheFrom and CacheTo are registry images used as a build cache. For buildpack
+		// builds, CacheTo is the pack cache image.
+		CacheFrom []string `mapstructure:"cache_from"`
+		CacheTo   string   `mapstructure:"cache_to"`
+
+		Attestation preview.AttestationConfig
 	}
 
 	EnvGroups []types.EnvGroupMeta `mapstructure:"env_groups"`
 
+	// Hooks are the jobs which run before and after the application is upgraded. If set, they
+	// replace the hooks of the release, and the hooks are removed if this is set but empty.
+	Hooks *struct {
+		PreDeploy  *ReleaseHookConfig `mapstructure:"pre_deploy"`
+		PostDeploy *ReleaseHookConfig `mapstructure:"post_deploy"`
+	}
+
+	// AutoRollback rolls the application back to its previous revision if the rollout of an
+	// upgrade fails its health checks. If set, this replaces the auto-rollback config of the
+	// release.
+	AutoRollback *struct {
+		Enabled bool
+
+		// Window is the time in seconds that the rollout is watched for
+		Window uint
+
+		// CrashThreshold is the number of container restarts which triggers a rollback
+		CrashThreshold uint `mapstructure:"crash_threshold"`
+	} `mapstructure:"auto_rollback"`
+
 	Values map[string]interface{}
 }
 
+type ReleaseHookConfig struct {
+	Command string
+
+	// Timeout is the maximum run time of the hook in seconds
+	Timeout uint
+}
+
+func (c *ReleaseHookConfig) toReleaseHook() *types.ReleaseHook {
+	if c == nil {
+		return nil
+	}
+
+	return &types.ReleaseHook{
+		Command:        c.Command,
+		TimeoutSeconds: c.Timeout,
+	}
+}
+
 type Driver struct {
 	source      *preview.Source
 	target      *preview.Target
 	output      map[string]interface{}
 	lookupTable *map[string]drivers.Driver
 	logger      *zerolog.Logger
+	services    *serviceStatuses
 }
 
 func NewPorterDriver(resource *models.Resource, opts *drivers.SharedDriverOpts) (drivers.Driver, error) {
@@ -250,10 +320,14 @@ func (d *Driver) Apply(resource *models.Resource) (*models.Resource, error) {
 	}
 
 	if d.source.IsApplication {
-		return d.applyApplication(resource, client, shouldCreate)
+		resource, err = d.applyApplication(resource, client, shouldCreate)
+	} else {
+		resource, err = d.applyAddon(resource, client, shouldCreate)
 	}
 
-	return d.applyAddon(resource, client, shouldCreate)
+	d.services.set(name, d.source.Name, err)
+
+	return resource, err
 }
 
 // Simple apply for addons
@@ -317,10 +391,21 @@ func (d *Driver) applyApplication(resource *models.Resource, client *api.Client,
 		return nil, err
 	}
 
+	if from := appConfig.Build.From; from != "" {
+		image, err := d.getSharedBuildImage(from)
+
+		if err != nil {
+			return nil, err
+		}
+
+		appConfig.Build.Method = "registry"
+		appConfig.Build.Image = image
+	}
+
 	method := appConfig.Build.Method
 
-	if method != "pack" && method != "docker" && method != "registry" {
-		return nil, fmt.Errorf("method should either be \"docker\", \"pack\" or \"registry\"")
+	if method != "pack" && method != "docker" && method != "registry" && method != "remote" {
+		return nil, fmt.Errorf("method should either be \"docker\", \"pack\", \"registry\" or \"remote\"")
 	}
 
 	fullPath, err := filepath.Abs(appConfig.Build.Context)
@@ -364,10 +449,18 @@ func (d *Driver) applyApplication(resource *models.Resource, client *api.Client,
 		Method:          deploy.DeployBuildType(method),
 		EnvGroups:       appConfig.EnvGroups,
 		UseCache:        appConfig.Build.UseCache,
+		RemoteBuilder:   types.RemoteBuilder(appConfig.Build.RemoteBuilder),
+		CacheFrom:       appConfig.Build.CacheFrom,
+		CacheTo:         appConfig.Build.CacheTo,
+		Attest:          appConfig.Build.Attestation.ToAttestOpts(),
+	}
+
+	if err := sharedOpts.Attest.Validate(); err != nil {
+		return nil, err
 	}
 
-	if appConfig.Build.UseCache {
-		// set the docker config so that pack caching can use the repo credentials
+	if appConfig.Build.UseCache || appConfig.Build.CacheTo != "" || sharedOpts.Attest.Enabled() {
+		// set the docker config so that pack caching and attestations can use the repo credentials
 		err := config.SetDockerConfig(client)
 
 		if err != nil {
@@ -381,7 +474,17 @@ func (d *Driver) applyApplication(resource *models.Resource, client *api.Client,
 		if err != nil {
 			return nil, err
 		}
+
+		// hooks can only be set once the release exists, so they run from the next upgrade
+		if err = d.updateHooks(resource, client, appConfig); err != nil {
+			return nil, err
+		}
 	} else if !appConfig.OnlyCreate {
+		// the hooks are updated before the upgrade, so that the upgrade runs the new hooks
+		if err = d.updateHooks(resource, client, appConfig); err != nil {
+			return nil, err
+		}
+
 		resource, err = d.updateApplication(resource, client, sharedOpts, appConfig)
 
 		if err != nil {
@@ -423,11 +526,98 @@ func (d *Driver) applyApplication(resource *models.Resource, client *api.Client,
 
 			return nil, fmt.Errorf("error waiting for job %s: %w", resource.Name, err)
 		}
+	} else if d.source.Name != "job" && appConfig.WaitForHealthy {
+		color.New(color.FgYellow).Printf("Waiting for %s release '%s' to become healthy\n", d.source.Name, resource.Name)
+
+		timeout := 10 * time.Minute
+
+		if appConfig.WaitForHealthyTimeout != 0 {
+			timeout = time.Duration(appConfig.WaitForHealthyTimeout) * time.Second
+		}
+
+		err = wait.WaitForRelease(client, &wait.WaitOpts{
+			ProjectID: d.target.Project,
+			ClusterID: d.target.Cluster,
+			Namespace: d.target.Namespace,
+			Name:      resource.Name,
+		}, timeout)
+
+		if err != nil {
+			return nil, fmt.Errorf("error waiting for %s to become healthy: %w", resource.Name, err)
+		}
 	}
 
 	return resource, err
 }
 
+// updateHooks sets the pre-deploy and post-deploy hooks and the auto-rollback config of the
+// release, if they are set in the application config
+func (d *Driver) updateHooks(resource *models.Resource, client *api.Client, appConf *ApplicationConfig) error {
+	if appConf.AutoRollback != nil {
+		_, err := client.UpdateAutoRollback(
+			context.Background(),
+			d.target.Project,
+			d.target.Cluster,
+			d.target.Namespace,
+			resource.Name,
+			&types.UpdateAutoRollbackRequest{
+				Enabled:        appConf.AutoRollback.Enabled,
+				WindowSeconds:  appConf.AutoRollback.Window,
+				CrashThreshold: appConf.AutoRollback.CrashThreshold,
+			},
+		)
+
+		if err != nil {
+			return fmt.Errorf("could not update auto-rollback config of %s: %w", resource.Name, err)
+		}
+	}
+
+	if appConf.Hooks == nil {
+		return nil
+	}
+
+	_, err := client.UpdateReleaseHooks(
+		context.Background(),
+		d.target.Project,
+		d.target.Cluster,
+		d.target.Namespace,
+		resource.Name,
+		&types.UpdateReleaseHooksRequest{
+			PreDeploy:  appConf.Hooks.PreDeploy.toReleaseHook(),
+			PostDeploy: appConf.Hooks.PostDeploy.toReleaseHook(),
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not update hooks of %s: %w", resource.Name, err)
+	}
+
+	return nil
+}
+
+// getSharedBuildImage returns the image built or pushed by a shared build step
+func (d *Driver) getSharedBuildImage(from string) (string, error) {
+	driver, ok := (*d.lookupTable)[from]
+
+	if !ok {
+		return "", fmt.Errorf("build resource %s does not exist", from)
+	}
+
+	output, err := driver.Output()
+
+	if err != nil {
+		return "", err
+	}
+
+	image, ok := output["image"].(string)
+
+	if !ok || image == "" {
+		return "", fmt.Errorf("build resource %s did not output an image", from)
+	}
+
+	return image, nil
+}
+
 func (d *Driver) createApplication(resource *models.Resource, client *api.Client, sharedOpts *deploy.SharedOpts, appConf *ApplicationConfig) (*models.Resource, error) {
 	// create new release
 	color.New(color.FgGreen).Printf("Creating %s release: %s\n", d.source.Name, resource.Name)
@@ -567,6 +757,14 @@ func (d *Driver) updateApplication(resource *models.Resource, client *api.Client
 				return nil, err
 			}
 		}
+
+		if sharedOpts.Attest.Enabled() {
+			err = updateAgent.Attest()
+
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	err = updateAgent.UpdateImageAndValues(appConf.Values)
@@ -638,14 +836,21 @@ func (d *Driver) getAddonConfig(resource *models.Resource) (map[string]interface
 type DeploymentHook struct {
 	client                                                                    *api.Client
 	resourceGroup                                                             *switchboardTypes.ResourceGroup
+	services                                                                  *serviceStatuses
 	gitInstallationID, projectID, clusterID, prID, actionID                   uint
 	branchFrom, branchInto, namespace, repoName, repoOwner, prName, commitSHA string
 }
 
-func NewDeploymentHook(client *api.Client, resourceGroup *switchboardTypes.ResourceGroup, namespace string) (*DeploymentHook, error) {
+func NewDeploymentHook(
+	client *api.Client,
+	resourceGroup *switchboardTypes.ResourceGroup,
+	services *serviceStatuses,
+	namespace string,
+) (*DeploymentHook, error) {
 	res := &DeploymentHook{
 		client:        client,
 		resourceGroup: resourceGroup,
+		services:      services,
 		namespace:     namespace,
 	}
 
@@ -725,8 +930,7 @@ func (t *DeploymentHook) PreApply() error {
 		},
 	)
 
-	// TODO: case this on the response status code rather than text
-	if err != nil && strings.Contains(err.Error(), "deployment not found") {
+	if err != nil && errors.Is(err, api.ErrNotFound) {
 		// in this case, create the deployment
 		_, err = t.client.CreateDeployment(
 			context.Background(),
@@ -850,6 +1054,7 @@ func (t *DeploymentHook) PostApply(populatedData map[string]interface{}) error {
 		&types.FinalizeDeploymentRequest{
 			Namespace: t.namespace,
 			Subdomain: strings.Join(subdomains, ","),
+			Services:  t.services.list(t.resourceGroup),
 		},
 	)
 
@@ -879,6 +1084,7 @@ func (t *DeploymentHook) OnError(err error) {
 				},
 				PRBranchFrom: t.branchFrom,
 				Status:       string(types.DeploymentStatusFailed),
+				Services:     t.services.list(t.resourceGroup),
 			},
 		)
 	}
@@ -0,0 +1,255 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/switchboard/pkg/drivers"
+	"github.com/porter-dev/switchboard/pkg/models"
+	switchboardTypes "github.com/porter-dev/switchboard/pkg/types"
+)
+
+// prepareResourceGroup validates the dependencies between the resources of a porter.yaml file,
+// and sets the implicit dependencies and defaults of each deployed service:
+//
+//   - a service which reuses the image of a shared build step with "build.from" depends on
+//     that step
+//   - a service which another service depends on waits until it is healthy before its
+//     dependents are deployed, unless "waitForHealthy" is set to false
+func prepareResourceGroup(resGroup *switchboardTypes.ResourceGroup) error {
+	resources := make(map[string]*switchboardTypes.Resource)
+
+	for _, resource := range resGroup.Resources {
+		if _, exists := resources[resource.Name]; exists {
+			return fmt.Errorf("resource %s is defined more than once", resource.Name)
+		}
+
+		resources[resource.Name] = resource
+	}
+
+	for _, resource := range resGroup.Resources {
+		if !isDeployResource(resource) {
+			continue
+		}
+
+		from := getBuildFrom(resource)
+
+		if from == "" {
+			continue
+		}
+
+		buildResource, exists := resources[from]
+
+		if !exists {
+			return fmt.Errorf("resource %s uses the image of %s, which does not exist", resource.Name, from)
+		}
+
+		if buildResource.Driver != "build-image" && buildResource.Driver != "push-image" {
+			return fmt.Errorf(
+				"resource %s uses the image of %s, which must use the build-image or push-image driver",
+				resource.Name, from,
+			)
+		}
+
+		if !containsString(resource.DependsOn, from) {
+			resource.DependsOn = append(resource.DependsOn, from)
+		}
+	}
+
+	dependedOn := make(map[string]bool)
+
+	for _, resource := range resGroup.Resources {
+		for _, dep := range resource.DependsOn {
+			if _, exists := resources[dep]; !exists {
+				return fmt.Errorf("resource %s depends on %s, which does not exist", resource.Name, dep)
+			}
+
+			dependedOn[dep] = true
+		}
+	}
+
+	if err := checkDependencyCycles(resGroup.Resources, resources); err != nil {
+		return err
+	}
+
+	for _, resource := range resGroup.Resources {
+		if !isDeployResource(resource) || !dependedOn[resource.Name] {
+			continue
+		}
+
+		if resource.Config == nil {
+			resource.Config = make(map[string]interface{})
+		}
+
+		if _, ok := resource.Config["waitForHealthy"]; !ok {
+			resource.Config["waitForHealthy"] = true
+		}
+	}
+
+	return nil
+}
+
+// checkDependencyCycles returns an error naming the resources in a dependency cycle, if one
+// exists
+func checkDependencyCycles(
+	resGroup []*switchboardTypes.Resource,
+	resources map[string]*switchboardTypes.Resource,
+) error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int)
+
+	var visit func(name string, path []string) error
+
+	visit = func(name string, path []string) error {
+		path = append(path, name)
+
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("resources have a circular dependency: %s", strings.Join(path, " -> "))
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+
+		for _, dep := range resources[name].DependsOn {
+			if err := visit(dep, path); err != nil {
+				return err
+			}
+		}
+
+		state[name] = visited
+
+		return nil
+	}
+
+	for _, resource := range resGroup {
+		if err := visit(resource.Name, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isDeployResource returns true if the resource is deployed as a release by the default driver
+func isDeployResource(resource *switchboardTypes.Resource) bool {
+	return resource.Driver == "" || resource.Driver == "deploy"
+}
+
+func getBuildFrom(resource *switchboardTypes.Resource) string {
+	build, ok := resource.Config["build"].(map[string]interface{})
+
+	if !ok {
+		return ""
+	}
+
+	from, _ := build["from"].(string)
+
+	return from
+}
+
+func containsString(vals []string, val string) bool {
+	for _, v := range vals {
+		if v == val {
+			return true
+		}
+	}
+
+	return false
+}
+
+// serviceStatuses records the status of each service deployed by "porter apply", so that
+// the deployment hook can report the status of each service separately
+type serviceStatuses struct {
+	mu       sync.Mutex
+	services map[string]*types.DeploymentService
+}
+
+func newServiceStatuses() *serviceStatuses {
+	return &serviceStatuses{
+		services: make(map[string]*types.DeploymentService),
+	}
+}
+
+// set records the result of deploying a service. Resources are applied concurrently, so
+// this may be called from multiple goroutines.
+func (s *serviceStatuses) set(name, kind string, err error) {
+	if s == nil {
+		return
+	}
+
+	service := &types.DeploymentService{
+		Name:   name,
+		Kind:   kind,
+		Status: types.DeploymentServiceStatusDeployed,
+	}
+
+	if err != nil {
+		service.Status = types.DeploymentServiceStatusFailed
+		service.Message = err.Error()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.services[name] = service
+}
+
+// list returns the status of each service in the resource group, in the order the services
+// are defined. Services which were not deployed, because a service they depend on failed,
+// are marked as skipped.
+func (s *serviceStatuses) list(resGroup *switchboardTypes.ResourceGroup) []*types.DeploymentService {
+	res := make([]*types.DeploymentService, 0)
+
+	if s == nil {
+		return res
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, resource := range resGroup.Resources {
+		if !isDeployResource(resource) {
+			continue
+		}
+
+		if service, ok := s.services[resource.Name]; ok {
+			res = append(res, service)
+			continue
+		}
+
+		kind, _ := resource.Source["name"].(string)
+
+		res = append(res, &types.DeploymentService{
+			Name:   resource.Name,
+			Kind:   kind,
+			Status: types.DeploymentServiceStatusSkipped,
+		})
+	}
+
+	return res
+}
+
+// newPorterDriverFunc returns a driver constructor for deployed services, which records the
+// status of each service
+func newPorterDriverFunc(services *serviceStatuses) drivers.DriverFunc {
+	return func(resource *models.Resource, opts *drivers.SharedDriverOpts) (drivers.Driver, error) {
+		driver, err := NewPorterDriver(resource, opts)
+
+		if err != nil {
+			return nil, err
+		}
+
+		driver.(*Driver).services = services
+
+		return driver, nil
+	}
+}
@@ -0,0 +1,282 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	costBy     string
+	costSince  string
+	costMethod string
+)
+
+var costCmd = &cobra.Command{
+	Use:   "cost",
+	Short: "Reports the cost of the current cluster, attributed to namespaces or releases",
+	Long: fmt.Sprintf(`
+%s
+
+Reports the cost of the current cluster over a time window. Node costs are computed from the
+hourly prices configured with "porter cost prices set", and are attributed to namespaces or
+releases by the resources they request or, with --method usage, by the resources they used
+as reported by Prometheus. Capacity that is not attributed to any workload is reported as idle.
+
+  %s
+
+To report the cost of every cluster in the project, use --by cluster:
+
+  %s
+`,
+		color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter cost\":"),
+		color.New(color.FgGreen, color.Bold).Sprintf("porter cost --by release --since 7d"),
+		color.New(color.FgGreen, color.Bold).Sprintf("porter cost --by cluster --since 30d"),
+	),
+	Run: func(cmd *cobra.Command, args []string) {
+		err := checkLoginAndRun(args, getCost)
+
+		if err != nil {
+			os.Exit(1)
+		}
+	},
+}
+
+var costPricesCmd = &cobra.Command{
+	Use:   "prices",
+	Short: "Lists the hourly node prices configured for the current cluster",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := checkLoginAndRun(args, listCostPrices)
+
+		if err != nil {
+			os.Exit(1)
+		}
+	},
+}
+
+var costPricesSetCmd = &cobra.Command{
+	Use:   "set [instance-type] [hourly-price]",
+	Args:  cobra.ExactArgs(2),
+	Short: "Sets the hourly price of a node instance type for the current cluster",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := checkLoginAndRun(args, setCostPrice)
+
+		if err != nil {
+			os.Exit(1)
+		}
+	},
+}
+
+var costPricesSetDefaultCmd = &cobra.Command{
+	Use:   "set-default [hourly-price]",
+	Args:  cobra.ExactArgs(1),
+	Short: "Sets the hourly price used for nodes without an instance type price",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := checkLoginAndRun(args, setDefaultCostPrice)
+
+		if err != nil {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(costCmd)
+
+	costCmd.AddCommand(costPricesCmd)
+	costPricesCmd.AddCommand(costPricesSetCmd)
+	costPricesCmd.AddCommand(costPricesSetDefaultCmd)
+
+	costCmd.Flags().StringVar(
+		&costBy,
+		"by",
+		"namespace",
+		"the level to aggregate costs at (\"namespace\", \"release\" or \"cluster\")",
+	)
+
+	costCmd.Flags().StringVar(
+		&costSince,
+		"since",
+		"24h",
+		"the length of the reporting window, such as 12h or 7d",
+	)
+
+	costCmd.Flags().StringVar(
+		&costMethod,
+		"method",
+		"requests",
+		"how node costs are attributed (\"requests\" or \"usage\")",
+	)
+
+	costCmd.Flags().StringVar(
+		&output,
+		"output",
+		"",
+		"the output format to use (\"json\")",
+	)
+}
+
+func getCost(_ *types.GetAuthenticatedUserResponse, client *api.Client, args []string) error {
+	var report *types.GetCostAllocationResponse
+	var err error
+
+	if costBy == string(types.CostAllocationGroupCluster) {
+		report, err = client.GetProjectCost(context.Background(), cliConf.Project, &types.GetProjectCostRequest{
+			Method: types.CostAllocationMethod(costMethod),
+			Since:  costSince,
+		})
+	} else {
+		report, err = client.GetClusterCost(context.Background(), cliConf.Project, cliConf.Cluster, &types.GetCostAllocationRequest{
+			By:     types.CostAllocationGroup(costBy),
+			Method: types.CostAllocationMethod(costMethod),
+			Since:  costSince,
+		})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if output == "json" {
+		bytes, err := json.MarshalIndent(report, "", "  ")
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(bytes))
+
+		return nil
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, '\t', 0)
+
+	if report.By == types.CostAllocationGroupRelease {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", "NAMESPACE", "RELEASE", "CPU (CORE-HRS)", "MEMORY (GB-HRS)", "COST")
+	} else {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", strings.ToUpper(string(report.By)), "CPU (CORE-HRS)", "MEMORY (GB-HRS)", "COST")
+	}
+
+	for _, alloc := range report.Allocations {
+		name := alloc.Name
+
+		if name == "" {
+			name = "(no release)"
+		}
+
+		if report.By == types.CostAllocationGroupRelease {
+			fmt.Fprintf(w, "%s\t%s\t%.2f\t%.2f\t$%.2f\n", alloc.Namespace, name, alloc.CPUCoreHours, alloc.MemoryGBHours, alloc.TotalCost)
+		} else {
+			fmt.Fprintf(w, "%s\t%.2f\t%.2f\t$%.2f\n", name, alloc.CPUCoreHours, alloc.MemoryGBHours, alloc.TotalCost)
+		}
+	}
+
+	w.Flush()
+
+	fmt.Println()
+	fmt.Printf("Window:    %s to %s\n", report.Start.Format("2006-01-02 15:04"), report.End.Format("2006-01-02 15:04"))
+	fmt.Printf("Total:     $%.2f\n", report.TotalCost)
+	fmt.Printf("Allocated: $%.2f\n", report.AllocatedCost)
+	fmt.Printf("Idle:      $%.2f (%.2f core-hrs, %.2f GB-hrs)\n", report.IdleCost, report.IdleCPUCoreHours, report.IdleMemoryGBHours)
+
+	if len(report.UnpricedNodes) > 0 {
+		color.New(color.FgYellow).Printf(
+			"\n%d node(s) have no price configured and are not included in the total. Use \"porter cost prices set\" to configure node prices.\n",
+			len(report.UnpricedNodes),
+		)
+	}
+
+	return nil
+}
+
+func listCostPrices(_ *types.GetAuthenticatedUserResponse, client *api.Client, args []string) error {
+	conf, err := client.GetClusterCostConfig(context.Background(), cliConf.Project, cliConf.Cluster)
+
+	if err != nil {
+		return err
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 3, 8, 2, '\t', 0)
+
+	fmt.Fprintf(w, "%s\t%s\n", "INSTANCE TYPE", "HOURLY PRICE")
+
+	for _, price := range conf.NodePrices {
+		fmt.Fprintf(w, "%s\t$%.4f\n", price.InstanceType, price.HourlyPrice)
+	}
+
+	fmt.Fprintf(w, "%s\t$%.4f\n", "(default)", conf.DefaultHourlyPrice)
+
+	w.Flush()
+
+	return nil
+}
+
+func setCostPrice(_ *types.GetAuthenticatedUserResponse, client *api.Client, args []string) error {
+	price, err := strconv.ParseFloat(args[1], 64)
+
+	if err != nil {
+		return fmt.Errorf("invalid hourly price %s", args[1])
+	}
+
+	return updateCostConfig(client, func(conf *types.UpdateClusterCostConfigRequest) {
+		for _, nodePrice := range conf.NodePrices {
+			if nodePrice.InstanceType == args[0] {
+				nodePrice.HourlyPrice = price
+				return
+			}
+		}
+
+		conf.NodePrices = append(conf.NodePrices, &types.NodeTypePrice{
+			InstanceType: args[0],
+			HourlyPrice:  price,
+		})
+	})
+}
+
+func setDefaultCostPrice(_ *types.GetAuthenticatedUserResponse, client *api.Client, args []string) error {
+	price, err := strconv.ParseFloat(args[0], 64)
+
+	if err != nil {
+		return fmt.Errorf("invalid hourly price %s", args[0])
+	}
+
+	return updateCostConfig(client, func(conf *types.UpdateClusterCostConfigRequest) {
+		conf.DefaultHourlyPrice = price
+	})
+}
+
+func updateCostConfig(client *api.Client, update func(conf *types.UpdateClusterCostConfigRequest)) error {
+	conf, err := client.GetClusterCostConfig(context.Background(), cliConf.Project, cliConf.Cluster)
+
+	if err != nil {
+		return err
+	}
+
+	req := &types.UpdateClusterCostConfigRequest{
+		DefaultHourlyPrice: conf.DefaultHourlyPrice,
+		NodePrices:         conf.NodePrices,
+	}
+
+	update(req)
+
+	_, err = client.UpdateClusterCostConfig(context.Background(), cliConf.Project, cliConf.Cluster, req)
+
+	if err != nil {
+		return err
+	}
+
+	color.New(color.FgGreen).Println("Updated node prices for the current cluster")
+
+	return nil
+}
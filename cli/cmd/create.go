@@ -175,6 +175,20 @@ func init() {
 		"Whether to use cache (currently in beta)",
 	)
 
+	createCmd.PersistentFlags().StringArrayVar(
+		&cacheFrom,
+		"cache-from",
+		[]string{},
+		"Registry image to use as a build cache for Dockerfile builds. Can be passed multiple times.",
+	)
+
+	createCmd.PersistentFlags().StringVar(
+		&cacheTo,
+		"cache-to",
+		"",
+		"Registry image to export the build cache to. For buildpack builds, this is the pack cache image.",
+	)
+
 	createCmd.PersistentFlags().MarkDeprecated("force-build", "--force-build is deprecated")
 }
 
@@ -243,6 +257,8 @@ func createFull(_ *types.GetAuthenticatedUserResponse, client *api.Client, args
 				Method:          buildMethod,
 				AdditionalEnv:   additionalEnv,
 				UseCache:        useCache,
+				CacheFrom:       cacheFrom,
+				CacheTo:         cacheTo,
 			},
 			Kind:        args[0],
 			ReleaseName: name,
@@ -273,6 +289,13 @@ func createFull(_ *types.GetAuthenticatedUserResponse, client *api.Client, args
 
 			err = config.SetDockerConfig(createAgent.Client)
 
+			if err != nil {
+				return err
+			}
+		} else if cacheTo != "" {
+			// set the docker config so that pack can use the registry credentials for caching
+			err := config.SetDockerConfig(createAgent.Client)
+
 			if err != nil {
 				return err
 			}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -54,6 +55,12 @@ in your remote Git repository. For example, if a Dockerfile is found at ./docker
 specify it as follows:
 
   %s
+
+If a local Docker daemon is not available, you can build the image from a Dockerfile inside the
+cluster by passing the flag "--remote-build". The build context is uploaded to the cluster, and the
+image is built and pushed by a Kaniko job, or a BuildKit job when "--remote-builder buildkit" is set:
+
+  %s
 `,
 		color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter update\":"),
 		color.New(color.FgGreen, color.Bold).Sprintf("porter update --app example-app"),
@@ -61,6 +68,7 @@ specify it as follows:
 		color.New(color.FgGreen, color.Bold).Sprintf("porter update --app remote-git-app --source github"),
 		color.New(color.FgGreen, color.Bold).Sprintf("porter update --app example-app --values my-values.yaml"),
 		color.New(color.FgGreen, color.Bold).Sprintf("porter update --app example-app --method docker --dockerfile ./docker/prod.Dockerfile"),
+		color.New(color.FgGreen, color.Bold).Sprintf("porter update --app example-app --remote-build"),
 	),
 	Run: func(cmd *cobra.Command, args []string) {
 		err := checkLoginAndRun(args, updateFull)
@@ -159,9 +167,16 @@ the following command would push the image "gcr.io/snowflake-123456/nginx:new-ta
 This command will not use your pre-saved authentication set up via "docker login," so if you
 are using an image registry that was created outside of Porter, make sure that you have
 linked it via "porter connect".
+
+Once the image is pushed, an SBOM can be generated with the --sbom flag, and the image can be
+signed with a cosign key passed via the --sign-key flag. The --provenance flag attaches a signed
+attestation recording the git SHA and build config. These require the syft and cosign CLIs:
+
+  %s
 `,
 		color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter update push\":"),
 		color.New(color.FgGreen, color.Bold).Sprintf("porter update push --app nginx --tag new-tag"),
+		color.New(color.FgGreen, color.Bold).Sprintf("porter update push --app nginx --tag new-tag --sbom spdx --provenance --sign-key cosign.key"),
 	),
 	Run: func(cmd *cobra.Command, args []string) {
 		err := checkLoginAndRun(args, updatePush)
@@ -213,6 +228,14 @@ var stream bool
 var buildFlagsEnv []string
 var forcePush bool
 var useCache bool
+var remoteBuild bool
+var remoteBuilder string
+var cacheFrom []string
+var cacheTo string
+var sbomFormat string
+var provenance bool
+var signKey string
+var forceDeploy bool
 
 func init() {
 	buildFlagsEnv = []string{}
@@ -292,7 +315,56 @@ func init() {
 		&method,
 		"method",
 		"",
-		"the build method to use (\"docker\" or \"pack\")",
+		"the build method to use (\"docker\", \"pack\" or \"remote\")",
+	)
+
+	updateCmd.PersistentFlags().BoolVar(
+		&remoteBuild,
+		"remote-build",
+		false,
+		"build the image from a Dockerfile inside the cluster, without a local Docker daemon",
+	)
+
+	updateCmd.PersistentFlags().StringVar(
+		&remoteBuilder,
+		"remote-builder",
+		"kaniko",
+		"the builder to use for remote builds (\"kaniko\" or \"buildkit\")",
+	)
+
+	updateCmd.PersistentFlags().StringArrayVar(
+		&cacheFrom,
+		"cache-from",
+		[]string{},
+		"Registry image to use as a build cache for Dockerfile builds. Can be passed multiple times.",
+	)
+
+	updateCmd.PersistentFlags().StringVar(
+		&cacheTo,
+		"cache-to",
+		"",
+		"Registry image to export the build cache to. For buildpack builds, this is the pack cache image.",
+	)
+
+	updateCmd.PersistentFlags().StringVar(
+		&sbomFormat,
+		"sbom",
+		"",
+		"generate an SBOM for the image in the given format (\"spdx\" or \"cyclonedx\")",
+	)
+
+	updateCmd.PersistentFlags().BoolVar(
+		&provenance,
+		"provenance",
+		false,
+		"attach a signed provenance attestation to the image, which requires --sign-key",
+	)
+
+	updateCmd.PersistentFlags().StringVar(
+		&signKey,
+		"sign-key",
+		"",
+		"cosign key reference used to sign the image, such as a path to a private key or a KMS URI",
 	)
 
 	updateCmd.PersistentFlags().BoolVar(
@@ -316,6 +388,13 @@ func init() {
 		"set this to force push an image (images tagged with \"latest\" have this set by default)",
 	)
 
+	updateCmd.PersistentFlags().BoolVar(
+		&forceDeploy,
+		"force",
+		false,
+		"deploy even if an SLO of the application which blocks deploys has exhausted its error budget",
+	)
+
 	updateCmd.PersistentFlags().MarkDeprecated("force-build", "--force-build is now deprecated")
 
 	updateCmd.PersistentFlags().MarkDeprecated("force-push", "--force-push is now deprecated")
@@ -353,6 +432,10 @@ func updateFull(_ *types.GetAuthenticatedUserResponse, client *api.Client, args
 		}
 	}
 
+	if err := checkErrorBudgets(client); err != nil {
+		return err
+	}
+
 	color.New(color.FgGreen).Println("Deploying app:", app)
 
 	updateAgent, err := updateGetAgent(client)
@@ -429,6 +512,10 @@ func updatePush(_ *types.GetAuthenticatedUserResponse, client *api.Client, args
 }
 
 func updateUpgrade(_ *types.GetAuthenticatedUserResponse, client *api.Client, args []string) error {
+	if err := checkErrorBudgets(client); err != nil {
+		return err
+	}
+
 	updateAgent, err := updateGetAgent(client)
 
 	if err != nil {
@@ -439,6 +526,45 @@ func updateUpgrade(_ *types.GetAuthenticatedUserResponse, client *api.Client, ar
 }
 
 // HELPER METHODS
+
+// checkErrorBudgets returns an error if an SLO of the application which blocks deploys has
+// exhausted its error budget, unless --force is set. The deploy is not blocked if the SLOs
+// cannot be evaluated, for example if Prometheus is unavailable.
+func checkErrorBudgets(client *api.Client) error {
+	statuses, err := client.GetSLOStatuses(context.Background(), cliConf.Project, cliConf.Cluster, namespace, app)
+
+	if err != nil {
+		color.New(color.FgYellow).Fprintf(os.Stderr, "Could not check the error budgets of %s: %s\n", app, err.Error())
+		return nil
+	}
+
+	exhausted := make([]string, 0)
+
+	for _, status := range statuses {
+		if status.SLO.BlockDeploys && status.BudgetExhausted {
+			exhausted = append(exhausted, status.SLO.Name)
+		}
+	}
+
+	if len(exhausted) == 0 {
+		return nil
+	}
+
+	if forceDeploy {
+		color.New(color.FgYellow).Fprintf(
+			os.Stderr, "Deploying with --force, although the error budget is exhausted for: %s\n", strings.Join(exhausted, ", "),
+		)
+
+		return nil
+	}
+
+	return fmt.Errorf(
+		"the error budget is exhausted for: %s. Deploys of %s are blocked until the budget recovers, or pass --force to deploy anyway",
+		strings.Join(exhausted, ", "),
+		app,
+	)
+}
+
 func updateGetAgent(client *api.Client) (*deploy.DeployAgent, error) {
 	var buildMethod deploy.DeployBuildType
 
@@ -446,6 +572,14 @@ func updateGetAgent(client *api.Client) (*deploy.DeployAgent, error) {
 		buildMethod = deploy.DeployBuildType(method)
 	}
 
+	if remoteBuild {
+		if buildMethod == deploy.DeployBuildTypePack {
+			return nil, fmt.Errorf("remote builds require a Dockerfile and cannot be used with --method pack")
+		}
+
+		buildMethod = deploy.DeployBuildTypeRemote
+	}
+
 	// add additional env, if they exist
 	additionalEnv := make(map[string]string)
 
@@ -455,6 +589,17 @@ func updateGetAgent(client *api.Client) (*deploy.DeployAgent, error) {
 		}
 	}
 
+	attestOpts := &deploy.AttestOpts{
+		SBOMFormat: types.SBOMFormat(sbomFormat),
+		Provenance: provenance,
+		SigningKey: signKey,
+	}
+
+	// validate the attestation options before building, so that the build is not wasted
+	if err := attestOpts.Validate(); err != nil {
+		return nil, err
+	}
+
 	// initialize the update agent
 	return deploy.NewDeployAgent(client, app, &deploy.DeployOpts{
 		SharedOpts: &deploy.SharedOpts{
@@ -467,6 +612,10 @@ func updateGetAgent(client *api.Client) (*deploy.DeployAgent, error) {
 			Method:          buildMethod,
 			AdditionalEnv:   additionalEnv,
 			UseCache:        useCache,
+			RemoteBuilder:   types.RemoteBuilder(remoteBuilder),
+			CacheFrom:       cacheFrom,
+			CacheTo:         cacheTo,
+			Attest:          attestOpts,
 		},
 		Local: source != "github",
 	})
@@ -486,7 +635,8 @@ func updateBuildWithAgent(updateAgent *deploy.DeployAgent) error {
 		})
 	}
 
-	if useCache {
+	// set the docker config so that pack can use the registry credentials for caching
+	if useCache || cacheTo != "" {
 		err := config.SetDockerConfig(updateAgent.Client)
 
 		if err != nil {
@@ -558,6 +708,11 @@ func updateBuildWithAgent(updateAgent *deploy.DeployAgent) error {
 		})
 	}
 
+	// images which are pushed by the build are attested here, since there is no push step
+	if useCache || updateAgent.Opts.ImagePushedByBuild() {
+		return updateAttestWithAgent(updateAgent)
+	}
+
 	return nil
 }
 
@@ -568,6 +723,12 @@ func updatePushWithAgent(updateAgent *deploy.DeployAgent) error {
 		return nil
 	}
 
+	if updateAgent.Opts.ImagePushedByBuild() {
+		color.New(color.FgGreen).Println("Skipping image push for", app, "as the image was pushed by the build")
+
+		return nil
+	}
+
 	// push the deployment
 	color.New(color.FgGreen).Println("Pushing new image for", app)
 
@@ -604,6 +765,57 @@ func updatePushWithAgent(updateAgent *deploy.DeployAgent) error {
 		})
 	}
 
+	return updateAttestWithAgent(updateAgent)
+}
+
+func updateAttestWithAgent(updateAgent *deploy.DeployAgent) error {
+	if !updateAgent.Opts.Attest.Enabled() {
+		return nil
+	}
+
+	color.New(color.FgGreen).Println("Attaching SBOM, provenance and signature for", app)
+
+	if stream {
+		updateAgent.StreamEvent(types.SubEvent{
+			EventID: "attest",
+			Name:    "Attest",
+			Index:   250,
+			Status:  types.EventStatusInProgress,
+			Info:    "",
+		})
+	}
+
+	// the docker config is used by syft and cosign to authenticate with the registry
+	err := config.SetDockerConfig(updateAgent.Client)
+
+	if err == nil {
+		err = updateAgent.Attest()
+	}
+
+	if err != nil {
+		if stream {
+			updateAgent.StreamEvent(types.SubEvent{
+				EventID: "attest",
+				Name:    "Attest",
+				Index:   260,
+				Status:  types.EventStatusFailed,
+				Info:    err.Error(),
+			})
+		}
+
+		return err
+	}
+
+	if stream {
+		updateAgent.StreamEvent(types.SubEvent{
+			EventID: "attest",
+			Name:    "Attest",
+			Index:   270,
+			Status:  types.EventStatusSuccess,
+			Info:    "",
+		})
+	}
+
 	return nil
 }
 
@@ -0,0 +1,347 @@
+package deploy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/git"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
+)
+
+const (
+	provenanceBuilderID = "https://porter.run/cli"
+	provenanceBuildType = "https://porter.run/build"
+)
+
+// AttestOpts configures the supply chain artifacts which are attached to a built image.
+// SBOMs are generated with the syft CLI, and images are signed and attested with the
+// cosign CLI, which must both be installed.
+type AttestOpts struct {
+	// SBOMFormat is the format of the generated SBOM. No SBOM is generated if empty.
+	SBOMFormat types.SBOMFormat
+
+	// Provenance attaches a signed provenance attestation to the image, which records the
+	// git SHA and build config. This requires a signing key.
+	Provenance bool
+
+	// SigningKey is a cosign key reference, such as the path to a private key or a KMS URI.
+	// The image is signed if this is set.
+	SigningKey string
+}
+
+// Enabled returns true if any supply chain artifact should be attached to the image
+func (o *AttestOpts) Enabled() bool {
+	return o != nil && (o.SBOMFormat != "" || o.Provenance || o.SigningKey != "")
+}
+
+// Validate checks that the options are consistent and that the required CLIs are installed
+func (o *AttestOpts) Validate() error {
+	if !o.Enabled() {
+		return nil
+	}
+
+	if o.SBOMFormat != "" && o.SBOMFormat != types.SBOMFormatSPDX && o.SBOMFormat != types.SBOMFormatCycloneDX {
+		return fmt.Errorf("sbom format must be either \"spdx\" or \"cyclonedx\"")
+	}
+
+	if o.Provenance && o.SigningKey == "" {
+		return fmt.Errorf("a signing key is required to attach a provenance attestation")
+	}
+
+	if o.SBOMFormat != "" {
+		if _, err := exec.LookPath("syft"); err != nil {
+			return fmt.Errorf("syft must be installed to generate SBOMs: https://github.com/anchore/syft")
+		}
+	}
+
+	if _, err := exec.LookPath("cosign"); err != nil {
+		return fmt.Errorf("cosign must be installed to attach SBOMs and signatures: https://github.com/sigstore/cosign")
+	}
+
+	return nil
+}
+
+// AttestAgent generates the SBOM, provenance attestation and signature of an image which
+// has been pushed to the registry, and records them on the release
+type AttestAgent struct {
+	*SharedOpts
+
+	APIClient   *api.Client
+	ReleaseName string
+	ImageRepo   string
+	Tag         string
+
+	// GitSHA is the commit the image was built from. If empty, the last commit of the
+	// local repository is used.
+	GitSHA string
+
+	// SourceURI is the URI of the repository the image was built from
+	SourceURI string
+
+	// BuildConfig is recorded in the provenance attestation, so it must not contain secrets
+	BuildConfig map[string]interface{}
+}
+
+// Attest attaches the configured artifacts to the image and records their refs on the release
+func (a *AttestAgent) Attest() (*types.CreateBuildAttestationResponse, error) {
+	opts := a.SharedOpts.Attest
+
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	digest, err := GetImageDigest(fmt.Sprintf("%s:%s", a.ImageRepo, a.Tag))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve image digest: %w", err)
+	}
+
+	if a.GitSHA == "" {
+		if commit, err := git.LastCommit(); err == nil {
+			a.GitSHA = commit.Sha
+		}
+	}
+
+	// all artifacts refer to the image by digest, since tags are mutable
+	imageRef := fmt.Sprintf("%s@%s", a.ImageRepo, digest)
+
+	req := &types.CreateBuildAttestationRequest{
+		ImageRepoURI: a.ImageRepo,
+		Tag:          a.Tag,
+		Digest:       digest,
+		GitSHA:       a.GitSHA,
+	}
+
+	dir, err := ioutil.TempDir("", "porter-attest")
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer os.RemoveAll(dir)
+
+	if opts.SBOMFormat != "" {
+		sbomPath := filepath.Join(dir, "sbom.json")
+
+		if err := generateSBOM(imageRef, opts.SBOMFormat, sbomPath); err != nil {
+			return nil, err
+		}
+
+		// a signed SBOM is attached as an attestation, otherwise it is attached as-is
+		if opts.SigningKey != "" {
+			err = runCosign("attest", "--key", opts.SigningKey, "--type", cosignSBOMType(opts.SBOMFormat), "--predicate", sbomPath, imageRef)
+			req.SBOMRef = cosignArtifactRef(a.ImageRepo, digest, "att")
+		} else {
+			err = runCosign("attach", "sbom", "--sbom", sbomPath, "--type", string(opts.SBOMFormat), "--input-format", "json", imageRef)
+			req.SBOMRef = cosignArtifactRef(a.ImageRepo, digest, "sbom")
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("could not attach sbom: %w", err)
+		}
+
+		req.SBOMFormat = opts.SBOMFormat
+	}
+
+	if opts.Provenance {
+		provenancePath := filepath.Join(dir, "provenance.json")
+
+		if err := a.writeProvenance(digest, provenancePath); err != nil {
+			return nil, err
+		}
+
+		err = runCosign("attest", "--key", opts.SigningKey, "--type", "slsaprovenance", "--predicate", provenancePath, imageRef)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not attach provenance attestation: %w", err)
+		}
+
+		req.ProvenanceRef = cosignArtifactRef(a.ImageRepo, digest, "att")
+	}
+
+	if opts.SigningKey != "" {
+		if err := runCosign("sign", "--key", opts.SigningKey, imageRef); err != nil {
+			return nil, fmt.Errorf("could not sign image: %w", err)
+		}
+
+		req.SignatureRef = cosignArtifactRef(a.ImageRepo, digest, "sig")
+	}
+
+	return a.APIClient.CreateBuildAttestation(
+		context.Background(),
+		a.ProjectID,
+		a.ClusterID,
+		a.Namespace,
+		a.ReleaseName,
+		req,
+	)
+}
+
+// GetImageDigest returns the digest of an image in the registry, using the credentials in
+// the docker config
+func GetImageDigest(image string) (string, error) {
+	ref, err := name.ParseReference(image)
+
+	if err != nil {
+		return "", err
+	}
+
+	desc, err := remote.Head(ref, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+
+	if err != nil {
+		return "", err
+	}
+
+	return desc.Digest.String(), nil
+}
+
+// provenancePredicate is a SLSA v0.2 provenance predicate
+type provenancePredicate struct {
+	Builder struct {
+		ID string `json:"id"`
+	} `json:"builder"`
+
+	BuildType string `json:"buildType"`
+
+	Invocation struct {
+		ConfigSource struct {
+			URI    string            `json:"uri,omitempty"`
+			Digest map[string]string `json:"digest,omitempty"`
+		} `json:"configSource"`
+
+		Parameters map[string]interface{} `json:"parameters,omitempty"`
+	} `json:"invocation"`
+
+	Metadata struct {
+		BuildFinishedOn time.Time `json:"buildFinishedOn"`
+	} `json:"metadata"`
+
+	Materials []provenanceMaterial `json:"materials,omitempty"`
+}
+
+type provenanceMaterial struct {
+	URI    string            `json:"uri"`
+	Digest map[string]string `json:"digest"`
+}
+
+func (a *AttestAgent) writeProvenance(digest, path string) error {
+	predicate := &provenancePredicate{}
+
+	predicate.Builder.ID = provenanceBuilderID
+	predicate.BuildType = fmt.Sprintf("%s/%s", provenanceBuildType, a.Method)
+	predicate.Invocation.Parameters = a.BuildConfig
+	predicate.Metadata.BuildFinishedOn = time.Now().UTC()
+
+	if a.GitSHA != "" {
+		predicate.Invocation.ConfigSource.URI = a.SourceURI
+		predicate.Invocation.ConfigSource.Digest = map[string]string{
+			"sha1": a.GitSHA,
+		}
+
+		if a.SourceURI != "" {
+			predicate.Materials = append(predicate.Materials, provenanceMaterial{
+				URI: a.SourceURI,
+				Digest: map[string]string{
+					"sha1": a.GitSHA,
+				},
+			})
+		}
+	}
+
+	bytes, err := json.MarshalIndent(predicate, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, bytes, 0600)
+}
+
+// GetAttestBuildConfig returns the build config to record in a provenance attestation. Only
+// the names of build args are recorded, since their values may be secret.
+func GetAttestBuildConfig(opts *SharedOpts, env map[string]string, extra map[string]interface{}) map[string]interface{} {
+	buildArgs := make([]string, 0, len(env))
+
+	for key := range env {
+		buildArgs = append(buildArgs, key)
+	}
+
+	sort.Strings(buildArgs)
+
+	res := map[string]interface{}{
+		"method":     string(opts.Method),
+		"context":    opts.LocalPath,
+		"build_args": buildArgs,
+	}
+
+	if opts.LocalDockerfile != "" {
+		res["dockerfile"] = opts.LocalDockerfile
+	}
+
+	if opts.RemoteBuilder != "" {
+		res["remote_builder"] = string(opts.RemoteBuilder)
+	}
+
+	for key, val := range extra {
+		res[key] = val
+	}
+
+	return res
+}
+
+func generateSBOM(imageRef string, format types.SBOMFormat, path string) error {
+	out, err := os.Create(path)
+
+	if err != nil {
+		return err
+	}
+
+	defer out.Close()
+
+	cmd := exec.Command("syft", imageRef, "--quiet", "--output", fmt.Sprintf("%s-json", format))
+	cmd.Stdout = out
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("could not generate sbom: %w", err)
+	}
+
+	return nil
+}
+
+// runCosign runs a cosign command, which reads the key password from COSIGN_PASSWORD or
+// prompts for it
+func runCosign(args ...string) error {
+	cmd := exec.Command("cosign", args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run()
+}
+
+func cosignSBOMType(format types.SBOMFormat) string {
+	if format == types.SBOMFormatSPDX {
+		return "spdxjson"
+	}
+
+	return "cyclonedx"
+}
+
+// cosignArtifactRef returns the ref of an artifact which cosign stores alongside an image,
+// such as "repo:sha256-<hex>.sig"
+func cosignArtifactRef(imageRepo, digest, suffix string) string {
+	return fmt.Sprintf("%s:%s.%s", imageRepo, strings.Replace(digest, ":", "-", 1), suffix)
+}
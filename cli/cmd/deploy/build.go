@@ -1,11 +1,16 @@
 package deploy
 
 import (
+	"compress/gzip"
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/docker/docker/pkg/archive"
 	api "github.com/porter-dev/porter/api/client"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/cli/cmd/docker"
@@ -50,6 +55,8 @@ func (b *BuildAgent) BuildDocker(
 		DockerfilePath:    dockerfilePath,
 		IsDockerfileInCtx: isDockerfileInCtx,
 		UseCache:          b.UseCache,
+		CacheFrom:         b.CacheFrom,
+		CacheTo:           b.CacheTo,
 	}
 
 	return dockerAgent.BuildLocal(
@@ -80,12 +87,149 @@ func (b *BuildAgent) BuildPack(dockerAgent *docker.Agent, dst, tag, prevTag stri
 		BuildContext: dst,
 		Env:          b.Env,
 		UseCache:     b.UseCache,
+		CacheTo:      b.CacheTo,
 	}
 
 	// call builder
 	return packAgent.Build(opts, buildConfig, fmt.Sprintf("%s:%s", b.ImageRepo, "pack-cache"))
 }
 
+// BuildRemote uploads the build context to the cluster and builds the image from a
+// Dockerfile with an in-cluster builder, which pushes the image to the registry once
+// the build succeeds. It does not require a local Docker daemon.
+func (b *BuildAgent) BuildRemote(basePath, buildCtx, dockerfilePath, tag string) error {
+	if dockerfilePath == "" {
+		dockerfilePath = filepath.Join(buildCtx, "Dockerfile")
+	}
+
+	buildCtx, dockerfilePath, isDockerfileInCtx, err := ResolveDockerPaths(
+		basePath,
+		buildCtx,
+		dockerfilePath,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	tar, err := archive.TarWithOptions(buildCtx, &archive.TarOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	defer tar.Close()
+
+	if !isDockerfileInCtx {
+		dockerfileCtx, err := os.Open(dockerfilePath)
+
+		if err != nil {
+			return fmt.Errorf("unable to open Dockerfile: %v", err)
+		}
+
+		defer dockerfileCtx.Close()
+
+		// add the dockerfile to the build context
+		tar, dockerfilePath, err = docker.AddDockerfileToBuildContext(dockerfileCtx, tar)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	build, err := b.APIClient.CreateRemoteBuild(
+		context.Background(),
+		b.ProjectID,
+		b.ClusterID,
+		b.Namespace,
+		&types.CreateRemoteBuildRequest{
+			ImageRepoURI: b.ImageRepo,
+			Tag:          tag,
+			Builder:      b.RemoteBuilder,
+			Dockerfile:   filepath.ToSlash(dockerfilePath),
+			BuildArgs:    b.Env,
+			CacheFrom:    b.CacheFrom,
+			CacheTo:      b.CacheTo,
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	// compress the build context while it is being uploaded
+	pr, pw := io.Pipe()
+
+	go func() {
+		gw := gzip.NewWriter(pw)
+
+		_, err := io.Copy(gw, tar)
+
+		if err == nil {
+			err = gw.Close()
+		}
+
+		pw.CloseWithError(err)
+	}()
+
+	_, err = b.APIClient.UploadRemoteBuildContext(
+		context.Background(),
+		b.ProjectID,
+		b.ClusterID,
+		b.Namespace,
+		build.ID,
+		pr,
+	)
+
+	pr.Close()
+
+	if err != nil {
+		return fmt.Errorf("could not upload build context: %w", err)
+	}
+
+	err = b.APIClient.StreamRemoteBuildLogs(
+		context.Background(),
+		b.ProjectID,
+		b.ClusterID,
+		b.Namespace,
+		build.ID,
+		os.Stderr,
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not stream build logs: %w", err)
+	}
+
+	// the log stream can end before the build job is marked as complete, so we poll
+	// for the final status of the build
+	for {
+		status, err := b.APIClient.GetRemoteBuild(
+			context.Background(),
+			b.ProjectID,
+			b.ClusterID,
+			b.Namespace,
+			build.ID,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		switch status.Status {
+		case types.RemoteBuildStatusSucceeded:
+			return nil
+		case types.RemoteBuildStatusFailed:
+			if status.Message != "" {
+				return fmt.Errorf("remote build failed: %s", status.Message)
+			}
+
+			return fmt.Errorf("remote build failed")
+		}
+
+		time.Sleep(2 * time.Second)
+	}
+}
+
 // ResolveDockerPaths returns a path to the dockerfile that is either relative or absolute, and a path
 // to the build context that is absolute.
 //
@@ -224,7 +224,7 @@ func (c *CreateAgent) CreateFromDocker(
 
 	// detect the build config
 	if opts.Method != "" {
-		if opts.Method == DeployBuildTypeDocker {
+		if opts.Method == DeployBuildTypeDocker || opts.Method == DeployBuildTypeRemote {
 			if opts.LocalDockerfile == "" {
 				hasDockerfile := c.HasDefaultDockerfile(opts.LocalPath)
 
@@ -265,11 +265,15 @@ func (c *CreateAgent) CreateFromDocker(
 		"tag":        imageTag,
 	}
 
-	// create docker agent
-	agent, err := docker.NewAgentWithAuthGetter(c.Client, opts.ProjectID)
+	// create docker agent, which is not needed when the image is built in the cluster
+	var agent *docker.Agent
 
-	if err != nil {
-		return "", err
+	if opts.Method != DeployBuildTypeRemote {
+		agent, err = docker.NewAgentWithAuthGetter(c.Client, opts.ProjectID)
+
+		if err != nil {
+			return "", err
+		}
 	}
 
 	env, err := GetEnvForRelease(c.Client, mergedValues, opts.ProjectID, opts.ClusterID, opts.Namespace)
@@ -309,7 +313,33 @@ func (c *CreateAgent) CreateFromDocker(
 		ImageExists: false,
 	}
 
-	if opts.Method == DeployBuildTypeDocker {
+	// the repository must exist before a build which pushes the image itself
+	if !opts.SharedOpts.UseCache && opts.ImagePushedByBuild() {
+		err = c.Client.CreateRepository(
+			context.Background(),
+			opts.ProjectID,
+			regID,
+			&types.CreateRegistryRepositoryRequest{
+				ImageRepoURI: imageURL,
+			},
+		)
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if opts.Method == DeployBuildTypeRemote {
+		var basePath string
+
+		basePath, err = filepath.Abs(".")
+
+		if err != nil {
+			return "", err
+		}
+
+		err = buildAgent.BuildRemote(basePath, opts.LocalPath, opts.LocalDockerfile, imageTag)
+	} else if opts.Method == DeployBuildTypeDocker {
 		var basePath string
 
 		basePath, err = filepath.Abs(".")
@@ -327,7 +357,7 @@ func (c *CreateAgent) CreateFromDocker(
 		return "", err
 	}
 
-	if !opts.SharedOpts.UseCache {
+	if !opts.SharedOpts.UseCache && !opts.ImagePushedByBuild() {
 		// create repository
 		err = c.Client.CreateRepository(
 			context.Background(),
@@ -349,6 +379,21 @@ func (c *CreateAgent) CreateFromDocker(
 		}
 	}
 
+	if opts.Attest.Enabled() {
+		attestAgent := &AttestAgent{
+			SharedOpts:  opts.SharedOpts,
+			APIClient:   c.Client,
+			ReleaseName: opts.ReleaseName,
+			ImageRepo:   imageURL,
+			Tag:         imageTag,
+			BuildConfig: GetAttestBuildConfig(opts.SharedOpts, env, nil),
+		}
+
+		if _, err := attestAgent.Attest(); err != nil {
+			return "", err
+		}
+	}
+
 	subdomain, err := c.CreateSubdomainIfRequired(mergedValues)
 
 	if err != nil {
@@ -8,9 +8,11 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/porter-dev/porter/api/client"
 	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/cli/cmd/deploy/wait"
 	"github.com/porter-dev/porter/cli/cmd/docker"
 	"github.com/porter-dev/porter/cli/cmd/github"
 	"github.com/porter-dev/porter/internal/templater/utils"
@@ -26,6 +28,10 @@ const (
 
 	// uses cloud-native build pack to build and push images
 	DeployBuildTypePack DeployBuildType = "pack"
+
+	// builds and pushes images from a Dockerfile inside the cluster, without a local
+	// Docker daemon
+	DeployBuildTypeRemote DeployBuildType = "remote"
 )
 
 // DeployAgent handles the deployment and redeployment of an application on Porter
@@ -42,6 +48,7 @@ type DeployAgent struct {
 	imageExists    bool
 	imageRepo      string
 	dockerfilePath string
+	gitSHA         string
 }
 
 // DeployOpts are the options for creating a new DeployAgent
@@ -75,14 +82,16 @@ func NewDeployAgent(client *client.Client, app string, opts *DeployOpts) (*Deplo
 		strings.ToUpper(app), "-", "_", -1,
 	))
 
-	// get docker agent
-	agent, err := docker.NewAgentWithAuthGetter(client, opts.ProjectID)
+	// get docker agent, which is not needed when the image is built in the cluster
+	if opts.Method != DeployBuildTypeRemote {
+		agent, err := docker.NewAgentWithAuthGetter(client, opts.ProjectID)
 
-	if err != nil {
-		return nil, err
-	}
+		if err != nil {
+			return nil, err
+		}
 
-	deployAgent.agent = agent
+		deployAgent.agent = agent
+	}
 
 	// if build method is not set, determine based on release config
 	if opts.Method == "" {
@@ -101,7 +110,7 @@ func NewDeployAgent(client *client.Client, app string, opts *DeployOpts) (*Deplo
 		}
 	}
 
-	if deployAgent.Opts.Method == DeployBuildTypeDocker {
+	if deployAgent.Opts.Method == DeployBuildTypeDocker || deployAgent.Opts.Method == DeployBuildTypeRemote {
 		if release.GitActionConfig != nil {
 			deployAgent.dockerfilePath = release.GitActionConfig.DockerfilePath
 		}
@@ -140,7 +149,9 @@ func NewDeployAgent(client *client.Client, app string, opts *DeployOpts) (*Deplo
 	err = coalesceEnvGroups(deployAgent.Client, deployAgent.Opts.ProjectID, deployAgent.Opts.ClusterID,
 		deployAgent.Opts.Namespace, deployAgent.Opts.EnvGroups, deployAgent.Release.Config)
 
-	deployAgent.imageExists = deployAgent.agent.CheckIfImageExists(deployAgent.imageRepo, deployAgent.tag)
+	if deployAgent.agent != nil {
+		deployAgent.imageExists = deployAgent.agent.CheckIfImageExists(deployAgent.imageRepo, deployAgent.tag)
+	}
 
 	return deployAgent, err
 }
@@ -153,10 +164,10 @@ type GetBuildEnvOpts struct {
 // GetBuildEnv retrieves the build env from the release config and returns it.
 //
 // It returns a flattened map of all environment variables including:
-//    1. container.env.normal from the release config
-//    2. container.env.build from the release config
-//    3. container.env.synced from the release config
-//    4. any additional env var that was passed into the DeployAgent as opts.SharedOpts.AdditionalEnv
+//  1. container.env.normal from the release config
+//  2. container.env.build from the release config
+//  3. container.env.synced from the release config
+//  4. any additional env var that was passed into the DeployAgent as opts.SharedOpts.AdditionalEnv
 func (d *DeployAgent) GetBuildEnv(opts *GetBuildEnvOpts) (map[string]string, error) {
 	conf := d.Release.Config
 
@@ -292,6 +303,8 @@ func (d *DeployAgent) Build(overrideBuildConfig *types.BuildConfig) error {
 			shortRef := fmt.Sprintf("%.7s", zipResp.LatestCommitSHA)
 			d.tag = shortRef
 		}
+
+		d.gitSHA = zipResp.LatestCommitSHA
 	} else {
 		basePath, err = filepath.Abs(".")
 
@@ -300,13 +313,6 @@ func (d *DeployAgent) Build(overrideBuildConfig *types.BuildConfig) error {
 		}
 	}
 
-	currTag, err := d.pullCurrentReleaseImage()
-
-	// if image is not found, don't return an error
-	if err != nil && err != docker.PullImageErrNotFound {
-		return err
-	}
-
 	buildAgent := &BuildAgent{
 		SharedOpts:  d.Opts.SharedOpts,
 		APIClient:   d.Client,
@@ -315,6 +321,22 @@ func (d *DeployAgent) Build(overrideBuildConfig *types.BuildConfig) error {
 		ImageExists: d.imageExists,
 	}
 
+	if d.Opts.Method == DeployBuildTypeRemote {
+		return buildAgent.BuildRemote(
+			basePath,
+			buildCtx,
+			d.dockerfilePath,
+			d.tag,
+		)
+	}
+
+	currTag, err := d.pullCurrentReleaseImage()
+
+	// if image is not found, don't return an error
+	if err != nil && err != docker.PullImageErrNotFound {
+		return err
+	}
+
 	if d.Opts.Method == DeployBuildTypeDocker {
 		return buildAgent.BuildDocker(
 			d.agent,
@@ -335,11 +357,38 @@ func (d *DeployAgent) Build(overrideBuildConfig *types.BuildConfig) error {
 	return buildAgent.BuildPack(d.agent, buildCtx, d.tag, currTag, buildConfig)
 }
 
-// Push pushes a local image to the remote repository linked in the release
+// Push pushes a local image to the remote repository linked in the release. This is a
+// no-op if the image was already pushed by the build.
 func (d *DeployAgent) Push() error {
+	if d.Opts.ImagePushedByBuild() {
+		return nil
+	}
+
 	return d.agent.PushImage(fmt.Sprintf("%s:%s", d.imageRepo, d.tag))
 }
 
+// Attest attaches the SBOM, provenance attestation and signature configured in the deploy
+// options to the pushed image, and records them on the release
+func (d *DeployAgent) Attest() error {
+	attestAgent := &AttestAgent{
+		SharedOpts:  d.Opts.SharedOpts,
+		APIClient:   d.Client,
+		ReleaseName: d.App,
+		ImageRepo:   d.imageRepo,
+		Tag:         d.tag,
+		GitSHA:      d.gitSHA,
+		BuildConfig: GetAttestBuildConfig(d.Opts.SharedOpts, d.env, nil),
+	}
+
+	if d.Release.GitActionConfig != nil {
+		attestAgent.SourceURI = fmt.Sprintf("https://github.com/%s", d.Release.GitActionConfig.GitRepo)
+	}
+
+	_, err := attestAgent.Attest()
+
+	return err
+}
+
 // UpdateImageAndValues updates the current image for a release, along with new
 // configuration passed in via overrrideValues. If overrideValues is nil, it just
 // reuses the configuration set for the application. If overrideValues is not nil,
@@ -395,7 +444,9 @@ func (d *DeployAgent) UpdateImageAndValues(overrideValues map[string]interface{}
 		return err
 	}
 
-	return d.Client.UpgradeRelease(
+	since := time.Now()
+
+	err = d.Client.UpgradeRelease(
 		context.Background(),
 		d.Opts.ProjectID,
 		d.Opts.ClusterID,
@@ -405,6 +456,36 @@ func (d *DeployAgent) UpdateImageAndValues(overrideValues map[string]interface{}
 			Values: string(bytes),
 		},
 	)
+
+	if err != nil {
+		return err
+	}
+
+	// releases with a pre-deploy hook are upgraded in the background once the hook succeeds
+	if preDeploy := d.getPreDeployHook(); preDeploy != nil {
+		timeout := 10 * time.Minute
+
+		if preDeploy.TimeoutSeconds != 0 {
+			timeout = time.Duration(preDeploy.TimeoutSeconds) * time.Second
+		}
+
+		return wait.WaitForUpgrade(d.Client, &wait.WaitOpts{
+			ProjectID: d.Opts.ProjectID,
+			ClusterID: d.Opts.ClusterID,
+			Namespace: d.Release.Namespace,
+			Name:      d.Release.Name,
+		}, since, timeout+5*time.Minute)
+	}
+
+	return nil
+}
+
+func (d *DeployAgent) getPreDeployHook() *types.ReleaseHook {
+	if d.Release.PorterRelease == nil || d.Release.DeployHooks == nil {
+		return nil
+	}
+
+	return d.Release.DeployHooks.PreDeploy
 }
 
 type SyncedEnvSection struct {
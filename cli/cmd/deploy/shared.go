@@ -20,6 +20,16 @@ type SharedOpts struct {
 	AdditionalEnv   map[string]string
 	EnvGroups       []types.EnvGroupMeta
 	UseCache        bool
+	RemoteBuilder   types.RemoteBuilder
+	CacheFrom       []string
+	CacheTo         string
+	Attest          *AttestOpts
+}
+
+// ImagePushedByBuild returns true if the build pushes the image to the registry itself,
+// so that there is no separate push step
+func (o *SharedOpts) ImagePushedByBuild() bool {
+	return o.Method == DeployBuildTypeRemote || (o.Method == DeployBuildTypePack && o.CacheTo != "")
 }
 
 func coalesceEnvGroups(
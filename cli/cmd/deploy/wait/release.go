@@ -0,0 +1,65 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
+)
+
+// workloadKinds are the kinds of objects in a release which must be healthy for the release
+// to be considered healthy
+var workloadKinds = map[string]bool{
+	"Deployment":  true,
+	"StatefulSet": true,
+	"DaemonSet":   true,
+}
+
+// WaitForRelease waits for every workload declared in a release to become healthy. It returns
+// an error if a workload is still not healthy once the timeout has passed.
+func WaitForRelease(client *api.Client, opts *WaitOpts, timeout time.Duration) error {
+	timeWait := time.Now().Add(timeout)
+
+	// give the controllers time to pick up the new revision before reading the status
+	time.Sleep(5 * time.Second)
+
+	var unhealthy []string
+
+	for time.Now().Before(timeWait) {
+		topology, err := client.GetReleaseTopology(context.Background(), opts.ProjectID, opts.ClusterID, opts.Namespace, opts.Name)
+
+		if err != nil {
+			return err
+		}
+
+		unhealthy = make([]string, 0)
+
+		for _, node := range topology.Nodes {
+			if !node.InChart || !workloadKinds[node.Kind] || node.Status == types.TopologyNodeStatusHealthy {
+				continue
+			}
+
+			msg := fmt.Sprintf("%s/%s is %s", node.Kind, node.Name, node.Status)
+
+			if node.Message != "" {
+				msg = fmt.Sprintf("%s: %s", msg, node.Message)
+			}
+
+			unhealthy = append(unhealthy, msg)
+		}
+
+		if len(unhealthy) == 0 {
+			return nil
+		}
+
+		color.New(color.FgYellow).Printf("Waiting for %s to become healthy (%s)\n", opts.Name, strings.Join(unhealthy, ", "))
+
+		time.Sleep(10 * time.Second)
+	}
+
+	return fmt.Errorf("timed out waiting for release to become healthy: %s", strings.Join(unhealthy, ", "))
+}
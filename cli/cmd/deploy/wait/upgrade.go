@@ -0,0 +1,65 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fatih/color"
+	api "github.com/porter-dev/porter/api/client"
+	"github.com/porter-dev/porter/api/types"
+)
+
+// WaitForUpgrade waits for an upgrade which the server runs in the background, which is the
+// case for releases with a pre-deploy hook. It prints the status of the pre-deploy hook, and
+// returns an error if the hook or the upgrade failed.
+func WaitForUpgrade(client *api.Client, opts *WaitOpts, since time.Time, timeout time.Duration) error {
+	timeWait := time.Now().Add(timeout)
+	printed := make(map[string]bool)
+
+	for time.Now().Before(timeWait) {
+		steps, err := client.GetReleaseSteps(context.Background(), opts.ProjectID, opts.ClusterID, opts.Namespace, opts.Name)
+
+		if err != nil {
+			return err
+		}
+
+		for _, step := range *steps {
+			// steps are recorded with a precision of one second
+			if step.Time < since.Unix() {
+				continue
+			}
+
+			key := fmt.Sprintf("%s-%d-%d", step.EventID, step.Index, step.Status)
+
+			if step.EventID == string(types.ReleaseHookPreDeploy) && !printed[key] {
+				printed[key] = true
+
+				switch step.Status {
+				case types.EventStatusInProgress:
+					color.New(color.FgYellow).Printf("Running pre-deploy hook: %s\n", step.Info)
+				case types.EventStatusSuccess:
+					color.New(color.FgGreen).Println("Pre-deploy hook succeeded")
+				case types.EventStatusFailed:
+					color.New(color.FgRed).Printf("Pre-deploy hook failed:\n%s\n", step.Info)
+				}
+			}
+
+			if step.EventID != types.ReleaseStepUpgradeEventID || step.Index != types.ReleaseStepUpgradeIndex {
+				continue
+			}
+
+			if step.Status == types.EventStatusFailed {
+				return fmt.Errorf("upgrade failed: %s", step.Info)
+			}
+
+			if step.Status == types.EventStatusSuccess {
+				return nil
+			}
+		}
+
+		time.Sleep(5 * time.Second)
+	}
+
+	return fmt.Errorf("timed out waiting for upgrade")
+}
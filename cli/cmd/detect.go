@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/porter-dev/porter/internal/integrations/buildpacks"
+	"github.com/spf13/cobra"
+)
+
+var (
+	detectTarball string
+	detectBuilder string
+)
+
+var detectCmd = &cobra.Command{
+	Use:   "detect [path]",
+	Args:  cobra.MaximumNArgs(1),
+	Short: "Detects the builder, buildpacks and start command for a source directory",
+	Long: fmt.Sprintf(`
+%s
+
+Detects the runtime of the application in a local directory, which defaults to the current
+directory, and prints the builder, buildpacks and start command that "porter create" and
+"porter update" use when building with --method pack.
+
+  %s
+
+To detect the runtime of an application packaged as a tarball, use --tarball. The path
+argument is then a directory inside the tarball:
+
+  %s
+`,
+		color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter detect\":"),
+		color.New(color.FgGreen, color.Bold).Sprintf("porter detect ./web"),
+		color.New(color.FgGreen, color.Bold).Sprintf("porter detect --tarball ./app.tar.gz web"),
+	),
+	Run: func(cmd *cobra.Command, args []string) {
+		err := detect(args)
+
+		if err != nil {
+			color.New(color.FgRed).Fprintf(os.Stderr, "error: %s\n", err.Error())
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(detectCmd)
+
+	detectCmd.PersistentFlags().StringVar(
+		&detectTarball,
+		"tarball",
+		"",
+		"detect the runtime of a tarball instead of a local directory",
+	)
+
+	detectCmd.PersistentFlags().StringVar(
+		&detectBuilder,
+		"builder",
+		buildpacks.PaketoBuilder,
+		fmt.Sprintf("the builder to detect buildpacks for (%s or %s)", buildpacks.PaketoBuilder, buildpacks.HerokuBuilder),
+	)
+}
+
+func detect(args []string) error {
+	var fileSystem buildpacks.FileSystem
+	dir := ""
+
+	if len(args) == 1 {
+		dir = args[0]
+	}
+
+	if detectTarball != "" {
+		f, err := os.Open(detectTarball)
+
+		if err != nil {
+			return err
+		}
+
+		defer f.Close()
+
+		fileSystem, err = buildpacks.NewTarballFileSystem(f)
+
+		if err != nil {
+			return err
+		}
+
+		dir = filepath.ToSlash(dir)
+	} else {
+		if dir == "" {
+			dir = "."
+		}
+
+		absDir, err := filepath.Abs(dir)
+
+		if err != nil {
+			return err
+		}
+
+		fileSystem = buildpacks.NewLocalFileSystem(absDir)
+		dir = ""
+	}
+
+	builderInfoMap, err := buildpacks.Detect(fileSystem, dir)
+
+	if err != nil {
+		return err
+	}
+
+	builder, ok := builderInfoMap[detectBuilder]
+
+	if !ok {
+		return fmt.Errorf("builder must be one of %s or %s", buildpacks.PaketoBuilder, buildpacks.HerokuBuilder)
+	}
+
+	if len(builder.Detected) == 0 {
+		return fmt.Errorf("no runtime detected: a Dockerfile is required to build this application")
+	}
+
+	names := make([]string, 0, len(builder.Detected))
+	bps := make([]string, 0, len(builder.Detected))
+
+	for _, bp := range builder.Detected {
+		names = append(names, bp.Name)
+		bps = append(bps, bp.Buildpack)
+	}
+
+	fmt.Printf("Runtime:       %s\n", strings.Join(names, ", "))
+	fmt.Printf("Builder:       %s\n", builder.Builders[0])
+	fmt.Printf("Buildpacks:    %s\n", strings.Join(bps, ", "))
+
+	if startCmd := buildpacks.GetStartCommand(fileSystem, dir, builder.Detected); startCmd != "" {
+		fmt.Printf("Start command: %s\n", startCmd)
+	} else {
+		fmt.Printf("Start command: %s\n", color.New(color.FgYellow).Sprintf("(set by the buildpacks)"))
+	}
+
+	return nil
+}
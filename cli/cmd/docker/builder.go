@@ -27,6 +27,14 @@ type BuildOpts struct {
 	IsDockerfileInCtx bool
 	UseCache          bool
 
+	// CacheFrom are registry image refs to use as a build cache, which are pulled before
+	// the build if they exist
+	CacheFrom []string
+
+	// CacheTo is a registry image ref that the build is pushed to so that subsequent builds
+	// can use it as a cache. For buildpack builds, this is the pack cache image.
+	CacheTo string
+
 	Env map[string]string
 }
 
@@ -67,17 +75,45 @@ func (a *Agent) BuildLocal(opts *BuildOpts) error {
 	inlineCacheVal := "1"
 	buildArgs["BUILDKIT_INLINE_CACHE"] = &inlineCacheVal
 
+	cacheFrom := []string{
+		fmt.Sprintf("%s:%s", opts.ImageRepo, opts.CurrentTag),
+	}
+
+	// the cache-to image from a previous build is also used as a cache
+	registryCache := append([]string{}, opts.CacheFrom...)
+
+	if opts.CacheTo != "" {
+		registryCache = append(registryCache, opts.CacheTo)
+	}
+
+	// cache images must exist locally to be used by the builder, so we pull them first
+	for _, cacheImage := range registryCache {
+		fmt.Printf("attempting to pull cache image: %s\n", cacheImage)
+
+		err := a.PullImage(cacheImage)
+
+		// if the cache image is not found, don't return an error
+		if err == PullImageErrNotFound {
+			continue
+		} else if err != nil {
+			fmt.Fprintf(os.Stderr, "could not pull cache image %s: %s\n", cacheImage, err.Error())
+			continue
+		}
+
+		cacheFrom = append(cacheFrom, cacheImage)
+	}
+
+	image := fmt.Sprintf("%s:%s", opts.ImageRepo, opts.Tag)
+
 	out, err := a.ImageBuild(context.Background(), tar, types.ImageBuildOptions{
 		Dockerfile: dockerfilePath,
 		BuildArgs:  buildArgs,
 		Tags: []string{
-			fmt.Sprintf("%s:%s", opts.ImageRepo, opts.Tag),
-		},
-		CacheFrom: []string{
-			fmt.Sprintf("%s:%s", opts.ImageRepo, opts.CurrentTag),
+			image,
 		},
-		Remove:   true,
-		Platform: "linux/amd64",
+		CacheFrom: cacheFrom,
+		Remove:    true,
+		Platform:  "linux/amd64",
 	})
 
 	if err != nil {
@@ -88,7 +124,20 @@ func (a *Agent) BuildLocal(opts *BuildOpts) error {
 
 	termFd, isTerm := term.GetFdInfo(os.Stderr)
 
-	return jsonmessage.DisplayJSONMessagesStream(out.Body, os.Stderr, termFd, isTerm, nil)
+	err = jsonmessage.DisplayJSONMessagesStream(out.Body, os.Stderr, termFd, isTerm, nil)
+
+	if err != nil || opts.CacheTo == "" {
+		return err
+	}
+
+	// export the build to the registry so that it can be used as a cache by other runners
+	err = a.TagImage(image, opts.CacheTo)
+
+	if err != nil {
+		return err
+	}
+
+	return a.PushImage(opts.CacheTo)
 }
 
 // AddDockerfileToBuildContext from a ReadCloser, returns a new archive and
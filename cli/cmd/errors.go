@@ -22,7 +22,7 @@ func checkLoginAndRun(args []string, runner func(user *types.GetAuthenticatedUse
 	if err != nil {
 		red := color.New(color.FgRed)
 
-		if strings.Contains(err.Error(), "Forbidden") {
+		if errors.Is(err, api.ErrForbidden) {
 			red.Print("You are not logged in. Log in using \"porter auth login\"\n")
 			return ErrNotLoggedIn
 		} else if strings.Contains(err.Error(), "connection refused") {
@@ -41,7 +41,7 @@ func checkLoginAndRun(args []string, runner func(user *types.GetAuthenticatedUse
 	if err != nil {
 		red := color.New(color.FgRed)
 
-		if strings.Contains(err.Error(), "403") {
+		if errors.Is(err, api.ErrForbidden) {
 			red.Print("You do not have the necessary permissions to view this resource")
 			return nil
 		} else if strings.Contains(err.Error(), "connection refused") {
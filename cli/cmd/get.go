@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/fatih/color"
 	api "github.com/porter-dev/porter/api/client"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/spf13/cobra"
@@ -42,8 +43,26 @@ var getValuesCmd = &cobra.Command{
 	},
 }
 
+// getAttestationsCmd represents the "porter get attestations" command
+var getAttestationsCmd = &cobra.Command{
+	Use:   "attestations [release]",
+	Args:  cobra.ExactArgs(1),
+	Short: "Lists the image digests, SBOMs, provenance attestations and signatures recorded for a release.",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := checkLoginAndRun(args, getAttestations)
+
+		if err != nil {
+			os.Exit(1)
+		}
+	},
+}
+
 var output string
 
+var getGraph bool
+
+var getRevision uint
+
 func init() {
 	getCmd.PersistentFlags().StringVar(
 		&namespace,
@@ -59,7 +78,22 @@ func init() {
 		"the output format to use (\"yaml\" or \"json\")",
 	)
 
+	getCmd.Flags().BoolVar(
+		&getGraph,
+		"graph",
+		false,
+		"print the live resource graph of the release",
+	)
+
+	getAttestationsCmd.Flags().UintVar(
+		&getRevision,
+		"revision",
+		0,
+		"only list the attestations of the image deployed by this revision",
+	)
+
 	getCmd.AddCommand(getValuesCmd)
+	getCmd.AddCommand(getAttestationsCmd)
 
 	rootCmd.AddCommand(getCmd)
 }
@@ -72,6 +106,10 @@ type getReleaseInfo struct {
 }
 
 func get(_ *types.GetAuthenticatedUserResponse, client *api.Client, args []string) error {
+	if getGraph {
+		return getTopology(client, args[0])
+	}
+
 	rel, err := client.GetRelease(context.Background(), cliConf.Project, cliConf.Cluster, namespace, args[0])
 
 	if err != nil {
@@ -140,3 +178,179 @@ func getValues(_ *types.GetAuthenticatedUserResponse, client *api.Client, args [
 
 	return nil
 }
+
+func getAttestations(_ *types.GetAuthenticatedUserResponse, client *api.Client, args []string) error {
+	resp, err := client.ListBuildAttestations(context.Background(), cliConf.Project, cliConf.Cluster, namespace, args[0], getRevision)
+
+	if err != nil {
+		return err
+	}
+
+	attestations := *resp
+
+	if output == "yaml" {
+		bytes, err := yaml.Marshal(attestations)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(bytes))
+
+		return nil
+	} else if output == "json" {
+		bytes, err := json.Marshal(attestations)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(bytes))
+
+		return nil
+	}
+
+	if len(attestations) == 0 {
+		color.New(color.FgYellow).Println("No attestations found for", args[0])
+		return nil
+	}
+
+	for _, attestation := range attestations {
+		color.New(color.FgGreen, color.Bold).Printf("%s:%s\n", attestation.ImageRepoURI, attestation.Tag)
+		fmt.Printf("  Digest:      %s\n", attestation.Digest)
+
+		if attestation.GitSHA != "" {
+			fmt.Printf("  Git SHA:     %s\n", attestation.GitSHA)
+		}
+
+		if attestation.SBOMRef != "" {
+			fmt.Printf("  SBOM (%s): %s\n", attestation.SBOMFormat, attestation.SBOMRef)
+		}
+
+		if attestation.ProvenanceRef != "" {
+			fmt.Printf("  Provenance:  %s\n", attestation.ProvenanceRef)
+		}
+
+		if attestation.SignatureRef != "" {
+			fmt.Printf("  Signature:   %s\n", attestation.SignatureRef)
+		}
+
+		fmt.Printf("  Created at:  %s\n", attestation.CreatedAt)
+	}
+
+	return nil
+}
+
+func getTopology(client *api.Client, name string) error {
+	graph, err := client.GetReleaseTopology(context.Background(), cliConf.Project, cliConf.Cluster, namespace, name)
+
+	if err != nil {
+		return err
+	}
+
+	if output == "yaml" {
+		bytes, err := yaml.Marshal(graph)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(bytes))
+
+		return nil
+	} else if output == "json" {
+		bytes, err := json.Marshal(graph)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(bytes))
+
+		return nil
+	}
+
+	nodes := make(map[string]*types.TopologyNode)
+	children := make(map[string][]string)
+	hasParent := make(map[string]bool)
+
+	for _, node := range graph.Nodes {
+		nodes[node.ID] = node
+	}
+
+	for _, edge := range graph.Edges {
+		if _, exists := nodes[edge.Target]; !exists {
+			continue
+		}
+
+		children[edge.Source] = append(children[edge.Source], edge.Target)
+		hasParent[edge.Target] = true
+	}
+
+	printed := make(map[string]bool)
+
+	for _, node := range graph.Nodes {
+		if !hasParent[node.ID] {
+			printTopologyNode(nodes, children, printed, node.ID, "", "")
+		}
+	}
+
+	// nodes that only appear in cycles have no root, so we print them last
+	for _, node := range graph.Nodes {
+		if !printed[node.ID] {
+			printTopologyNode(nodes, children, printed, node.ID, "", "")
+		}
+	}
+
+	return nil
+}
+
+func printTopologyNode(
+	nodes map[string]*types.TopologyNode,
+	children map[string][]string,
+	printed map[string]bool,
+	id, prefix, childPrefix string,
+) {
+	node := nodes[id]
+
+	line := fmt.Sprintf("%s%s/%s %s", prefix, node.Kind, node.Name, topologyStatusString(node.Status))
+
+	if node.EnvGroup != "" {
+		line += fmt.Sprintf(" (env group %s)", node.EnvGroup)
+	}
+
+	if node.Message != "" {
+		line += fmt.Sprintf(" %s", node.Message)
+	}
+
+	// a node can be reached from multiple parents, so we only expand it once
+	if printed[id] {
+		fmt.Println(line + " (see above)")
+		return
+	}
+
+	fmt.Println(line)
+
+	printed[id] = true
+
+	for i, childID := range children[id] {
+		if i == len(children[id])-1 {
+			printTopologyNode(nodes, children, printed, childID, childPrefix+"└── ", childPrefix+"    ")
+		} else {
+			printTopologyNode(nodes, children, printed, childID, childPrefix+"├── ", childPrefix+"│   ")
+		}
+	}
+}
+
+func topologyStatusString(status types.TopologyNodeStatus) string {
+	switch status {
+	case types.TopologyNodeStatusHealthy:
+		return color.New(color.FgGreen).Sprintf("[%s]", status)
+	case types.TopologyNodeStatusProgressing:
+		return color.New(color.FgYellow).Sprintf("[%s]", status)
+	case types.TopologyNodeStatusDegraded, types.TopologyNodeStatusMissing:
+		return color.New(color.FgRed).Sprintf("[%s]", status)
+	}
+
+	return fmt.Sprintf("[%s]", status)
+}
@@ -1,12 +1,17 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"regexp"
+	"sync"
+	"time"
 
+	"github.com/fatih/color"
 	api "github.com/porter-dev/porter/api/client"
 	"github.com/porter-dev/porter/api/types"
-	"github.com/porter-dev/porter/cli/cmd/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -15,7 +20,32 @@ import (
 var logsCmd = &cobra.Command{
 	Use:   "logs [release]",
 	Args:  cobra.ExactArgs(1),
-	Short: "Logs the output from a given application.",
+	Short: "Logs the output from every pod of a given application.",
+	Long: fmt.Sprintf(`
+%s
+
+Logs the output from every pod and container of a release, including the pods of the jobs
+run by the release. Each line is prefixed with the pod and container it was written by. For
+example:
+
+  %s
+
+To keep streaming the logs, and to pick up new pods as they are created during a rollout,
+use the --follow flag. To only show the lines of the last hour which contain an error from
+the "web" container, use:
+
+  %s
+
+To search the stored logs of a release, including the logs of pods which no longer exist, use
+the --query flag. The search covers the last hour, unless the --since flag is set:
+
+  %s
+`,
+		color.New(color.FgBlue, color.Bold).Sprintf("Help for \"porter logs\":"),
+		color.New(color.FgGreen, color.Bold).Sprintf("porter logs my-app"),
+		color.New(color.FgGreen, color.Bold).Sprintf("porter logs my-app --since 1h --grep \"(?i)error\" --container web"),
+		color.New(color.FgGreen, color.Bold).Sprintf("porter logs my-app --since 2h --query \"connection refused\""),
+	),
 	Run: func(cmd *cobra.Command, args []string) {
 		err := checkLoginAndRun(args, logs)
 
@@ -25,7 +55,15 @@ var logsCmd = &cobra.Command{
 	},
 }
 
-var follow bool
+var (
+	follow        bool
+	logsSince     time.Duration
+	logsTail      int64
+	logsGrep      string
+	logsContainer string
+	logsQuery     string
+	logsRegex     bool
+)
 
 func init() {
 	rootCmd.AddCommand(logsCmd)
@@ -44,71 +82,184 @@ func init() {
 		false,
 		"specify if the logs should be streamed",
 	)
+
+	logsCmd.PersistentFlags().DurationVar(
+		&logsSince,
+		"since",
+		0,
+		"only show logs newer than a relative duration, such as 5m or 2h",
+	)
+
+	logsCmd.PersistentFlags().Int64Var(
+		&logsTail,
+		"tail",
+		0,
+		"the number of lines to show from the end of the logs of each container",
+	)
+
+	logsCmd.PersistentFlags().StringVar(
+		&logsGrep,
+		"grep",
+		"",
+		"only show lines which match a regular expression",
+	)
+
+	logsCmd.PersistentFlags().StringVarP(
+		&logsContainer,
+		"container",
+		"c",
+		"",
+		"only show the logs of containers with this name",
+	)
+
+	logsCmd.PersistentFlags().StringVar(
+		&logsQuery,
+		"query",
+		"",
+		"search the stored logs of the release for lines which contain this text",
+	)
+
+	logsCmd.PersistentFlags().BoolVar(
+		&logsRegex,
+		"regex",
+		false,
+		"treat the --query flag as a regular expression",
+	)
 }
 
 func logs(_ *types.GetAuthenticatedUserResponse, client *api.Client, args []string) error {
-	podsSimple, err := getPods(client, namespace, args[0])
+	if logsGrep != "" {
+		if _, err := regexp.Compile(logsGrep); err != nil {
+			return fmt.Errorf("invalid --grep expression: %w", err)
+		}
+	}
 
-	if err != nil {
-		return fmt.Errorf("Could not retrieve list of pods: %s", err.Error())
+	if logsQuery != "" {
+		return searchLogs(client, args[0])
 	}
 
-	// if length of pods is 0, throw error
-	var selectedPod podSimple
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
 
-	if len(podsSimple) == 0 {
-		return fmt.Errorf("At least one pod must exist in this deployment.")
-	} else if len(podsSimple) == 1 {
-		selectedPod = podsSimple[0]
-	} else {
-		podNames := make([]string, 0)
+	prefixes := newLogPrefixes(logsContainer == "")
 
-		for _, podSimple := range podsSimple {
-			podNames = append(podNames, podSimple.Name)
-		}
+	return client.StreamReleaseLogs(
+		ctx,
+		cliConf.Project,
+		cliConf.Cluster,
+		namespace,
+		args[0],
+		&types.StreamReleaseLogsRequest{
+			Container:    logsContainer,
+			SinceSeconds: int64(logsSince.Seconds()),
+			TailLines:    logsTail,
+			Grep:         logsGrep,
+			Follow:       follow,
+		},
+		func(line *types.ReleaseLogLine) error {
+			fmt.Printf("%s %s\n", prefixes.get(line.PodName, line.ContainerName), line.Line)
+			return nil
+		},
+	)
+}
 
-		selectedPodName, err := utils.PromptSelect("Select the pod:", podNames)
+// searchLogs prints every stored log line of a release which matches the --query flag, from
+// oldest to newest
+func searchLogs(client *api.Client, name string) error {
+	if follow {
+		return fmt.Errorf("the --follow flag cannot be used with --query")
+	}
 
-		if err != nil {
-			return err
-		}
+	var grep *regexp.Regexp
 
-		// find selected pod
-		for _, podSimple := range podsSimple {
-			if selectedPodName == podSimple.Name {
-				selectedPod = podSimple
-			}
-		}
+	if logsGrep != "" {
+		grep = regexp.MustCompile(logsGrep)
 	}
 
-	var selectedContainerName string
+	req := &types.SearchLogsRequest{
+		Query:         logsQuery,
+		Regex:         logsRegex,
+		ContainerName: logsContainer,
+		Limit:         types.MaxLogSearchLimit,
+	}
+
+	if logsSince != 0 {
+		req.StartRange = uint(time.Now().Add(-logsSince).Unix())
+	}
 
-	// if the selected pod has multiple container, spawn selector
-	if len(selectedPod.ContainerNames) == 0 {
-		return fmt.Errorf("At least one pod must exist in this deployment.")
-	} else if len(selectedPod.ContainerNames) == 1 {
-		selectedContainerName = selectedPod.ContainerNames[0]
-	} else {
-		selectedContainer, err := utils.PromptSelect("Select the container:", selectedPod.ContainerNames)
+	prefixes := newLogPrefixes(logsContainer == "")
+
+	for {
+		resp, err := client.SearchReleaseLogs(context.Background(), cliConf.Project, cliConf.Cluster, namespace, name, req)
 
 		if err != nil {
 			return err
 		}
 
-		selectedContainerName = selectedContainer
+		for _, line := range resp.Logs {
+			if grep != nil && !grep.MatchString(line.Line) {
+				continue
+			}
+
+			fmt.Printf(
+				"%s %s %s\n",
+				line.Timestamp.Local().Format(time.RFC3339),
+				prefixes.get(line.PodName, line.ContainerName),
+				line.Line,
+			)
+		}
+
+		if resp.NextCursor == "" {
+			return nil
+		}
+
+		req.Cursor = resp.NextCursor
 	}
+}
+
+// logPrefixColors are the colors which are assigned to the pods of a release in turn
+var logPrefixColors = []*color.Color{
+	color.New(color.FgCyan),
+	color.New(color.FgGreen),
+	color.New(color.FgMagenta),
+	color.New(color.FgYellow),
+	color.New(color.FgBlue),
+	color.New(color.FgHiCyan),
+	color.New(color.FgHiGreen),
+	color.New(color.FgHiMagenta),
+	color.New(color.FgHiYellow),
+	color.New(color.FgHiBlue),
+}
+
+// logPrefixes assigns a color to every pod in the order the pods are first seen, so that the
+// lines of different pods can be told apart
+type logPrefixes struct {
+	mu            sync.Mutex
+	colors        map[string]*color.Color
+	showContainer bool
+}
 
-	config := &PorterRunSharedConfig{
-		Client: client,
+func newLogPrefixes(showContainer bool) *logPrefixes {
+	return &logPrefixes{
+		colors:        make(map[string]*color.Color),
+		showContainer: showContainer,
 	}
+}
+
+func (p *logPrefixes) get(podName, containerName string) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 
-	err = config.setSharedConfig()
+	c, ok := p.colors[podName]
 
-	if err != nil {
-		return fmt.Errorf("Could not retrieve kube credentials: %s", err.Error())
+	if !ok {
+		c = logPrefixColors[len(p.colors)%len(logPrefixColors)]
+		p.colors[podName] = c
 	}
 
-	_, err = pipePodLogsToStdout(config, namespace, selectedPod.Name, selectedContainerName, follow)
+	if p.showContainer {
+		return c.Sprintf("[%s/%s]", podName, containerName)
+	}
 
-	return err
+	return c.Sprintf("[%s]", podName)
 }
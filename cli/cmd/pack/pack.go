@@ -54,6 +54,12 @@ func (a *Agent) Build(opts *docker.BuildOpts, buildConfig *types.BuildConfig, ca
 		buildOpts.Publish = true
 	}
 
+	// a registry cache image requires the app image to be published by pack as well
+	if opts.CacheTo != "" {
+		buildOpts.CacheImage = opts.CacheTo
+		buildOpts.Publish = true
+	}
+
 	if buildConfig != nil {
 		buildOpts.Builder = buildConfig.Builder
 		for i := range buildConfig.Buildpacks {
@@ -10,6 +10,7 @@ import (
 	"github.com/cli/cli/git"
 	"github.com/docker/distribution/reference"
 	"github.com/mitchellh/mapstructure"
+	api "github.com/porter-dev/porter/api/client"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/cli/cmd/config"
 	"github.com/porter-dev/porter/cli/cmd/deploy"
@@ -28,6 +29,19 @@ type BuildDriverConfig struct {
 		Buildpacks   []string
 		Image        string
 		Env          map[string]string
+
+		// RemoteBuilder is the in-cluster builder ("kaniko" or "buildkit") used when the
+		// method is "remote". Remote builds push the image, so no push-image resource is needed.
+		RemoteBuilder string `mapstructure:"remote_builder"`
+
+		// CacheFrom and CacheTo are registry images used as a build cache. For buildpack
+		// builds, CacheTo is the pack cache image and the image is published by pack.
+		CacheFrom []string `mapstructure:"cache_from"`
+		CacheTo   string   `mapstructure:"cache_to"`
+
+		// Attestation is only applied by this driver if the image is pushed by the build.
+		// Otherwise, it should be configured on the push-image resource.
+		Attestation AttestationConfig
 	}
 
 	EnvGroups []types.EnvGroupMeta `mapstructure:"env_groups"`
@@ -145,6 +159,10 @@ func (d *BuildDriver) Apply(resource *models.Resource) (*models.Resource, error)
 				Method:          deploy.DeployBuildType(d.config.Build.Method),
 				EnvGroups:       d.config.EnvGroups,
 				UseCache:        d.config.Build.UsePackCache,
+				RemoteBuilder:   types.RemoteBuilder(d.config.Build.RemoteBuilder),
+				CacheFrom:       d.config.Build.CacheFrom,
+				CacheTo:         d.config.Build.CacheTo,
+				Attest:          d.config.Build.Attestation.ToAttestOpts(),
 			},
 			Kind:        d.source.Name,
 			ReleaseName: d.target.AppName,
@@ -158,7 +176,7 @@ func (d *BuildDriver) Apply(resource *models.Resource) (*models.Resource, error)
 		return nil, err
 	}
 
-	if d.config.Build.UsePackCache {
+	if d.config.Build.UsePackCache || d.config.Build.CacheTo != "" {
 		err := config.SetDockerConfig(client)
 
 		if err != nil {
@@ -166,42 +184,17 @@ func (d *BuildDriver) Apply(resource *models.Resource) (*models.Resource, error)
 		}
 
 		if d.config.Build.Method == "pack" {
-			repoResp, err := client.ListRegistryRepositories(context.Background(), d.target.Project, regID)
+			err = createRepositoryIfNotExists(client, d.target.Project, regID, imageURL)
 
 			if err != nil {
 				return nil, err
 			}
-
-			repos := *repoResp
-
-			found := false
-
-			for _, repo := range repos {
-				if repo.URI == imageURL {
-					found = true
-					break
-				}
-			}
-
-			if !found {
-				err = client.CreateRepository(
-					context.Background(),
-					d.target.Project,
-					regID,
-					&types.CreateRegistryRepositoryRequest{
-						ImageRepoURI: imageURL,
-					},
-				)
-
-				if err != nil {
-					return nil, err
-				}
-			}
 		}
 	}
 
 	if d.config.Build.Method != "" {
-		if d.config.Build.Method == string(deploy.DeployBuildTypeDocker) {
+		if d.config.Build.Method == string(deploy.DeployBuildTypeDocker) ||
+			d.config.Build.Method == string(deploy.DeployBuildTypeRemote) {
 			if d.config.Build.Dockerfile == "" {
 				hasDockerfile := createAgent.HasDefaultDockerfile(d.config.Build.Context)
 
@@ -224,13 +217,24 @@ func (d *BuildDriver) Apply(resource *models.Resource) (*models.Resource, error)
 		}
 	}
 
-	// create docker agent
-	agent, err := docker.NewAgentWithAuthGetter(client, d.target.Project)
+	createAgent.CreateOpts.SharedOpts.Method = deploy.DeployBuildType(d.config.Build.Method)
+	createAgent.CreateOpts.SharedOpts.LocalDockerfile = d.config.Build.Dockerfile
 
-	if err != nil {
+	if err := createAgent.CreateOpts.SharedOpts.Attest.Validate(); err != nil {
 		return nil, err
 	}
 
+	// create docker agent, which is not needed when the image is built in the cluster
+	var agent *docker.Agent
+
+	if d.config.Build.Method != string(deploy.DeployBuildTypeRemote) {
+		agent, err = docker.NewAgentWithAuthGetter(client, d.target.Project)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	_, mergedValues, err := createAgent.GetMergedValues(d.config.Values)
 
 	if err != nil {
@@ -279,7 +283,29 @@ func (d *BuildDriver) Apply(resource *models.Resource) (*models.Resource, error)
 		ImageExists: false,
 	}
 
-	if d.config.Build.Method == string(deploy.DeployBuildTypeDocker) {
+	if d.config.Build.Method == string(deploy.DeployBuildTypeRemote) {
+		var basePath string
+
+		basePath, err = filepath.Abs(".")
+
+		if err != nil {
+			return nil, err
+		}
+
+		// the repository must exist before the remote builder pushes to it
+		err = createRepositoryIfNotExists(client, d.target.Project, regID, imageURL)
+
+		if err != nil {
+			return nil, err
+		}
+
+		err = buildAgent.BuildRemote(
+			basePath,
+			d.config.Build.Context,
+			d.config.Build.Dockerfile,
+			tag,
+		)
+	} else if d.config.Build.Method == string(deploy.DeployBuildTypeDocker) {
 		var basePath string
 
 		basePath, err = filepath.Abs(".")
@@ -319,6 +345,30 @@ func (d *BuildDriver) Apply(resource *models.Resource) (*models.Resource, error)
 		return nil, err
 	}
 
+	if d.config.Build.UsePackCache || createAgent.CreateOpts.SharedOpts.ImagePushedByBuild() {
+		var extraConfig map[string]interface{}
+
+		if d.config.Build.Builder != "" {
+			extraConfig = map[string]interface{}{
+				"builder":    d.config.Build.Builder,
+				"buildpacks": d.config.Build.Buildpacks,
+			}
+		}
+
+		err = attestImage(
+			client,
+			d.target,
+			imageURL,
+			tag,
+			createAgent.CreateOpts.SharedOpts.Attest,
+			deploy.GetAttestBuildConfig(createAgent.CreateOpts.SharedOpts, env, extraConfig),
+		)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	named, _ := reference.ParseNamed(imageURL)
 	domain := reference.Domain(named)
 	imageRepo := reference.Path(named)
@@ -331,6 +381,29 @@ func (d *BuildDriver) Apply(resource *models.Resource) (*models.Resource, error)
 	return resource, nil
 }
 
+func createRepositoryIfNotExists(client *api.Client, projectID, regID uint, imageURL string) error {
+	repoResp, err := client.ListRegistryRepositories(context.Background(), projectID, regID)
+
+	if err != nil {
+		return err
+	}
+
+	for _, repo := range *repoResp {
+		if repo.URI == imageURL {
+			return nil
+		}
+	}
+
+	return client.CreateRepository(
+		context.Background(),
+		projectID,
+		regID,
+		&types.CreateRegistryRepositoryRequest{
+			ImageRepoURI: imageURL,
+		},
+	)
+}
+
 func (d *BuildDriver) Output() (map[string]interface{}, error) {
 	return d.output, nil
 }
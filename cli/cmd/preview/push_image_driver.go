@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/docker/distribution/reference"
 	"github.com/mitchellh/mapstructure"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/cli/cmd/config"
@@ -19,6 +20,7 @@ type PushDriverConfig struct {
 	Push struct {
 		UsePackCache bool `mapstructure:"use_pack_cache"`
 		Image        string
+		Attestation  AttestationConfig
 	}
 }
 
@@ -61,6 +63,12 @@ func (d *PushDriver) Apply(resource *models.Resource) (*models.Resource, error)
 
 	d.config = pushDriverConfig
 
+	attestOpts := d.config.Push.Attestation.ToAttestOpts()
+
+	if err := attestOpts.Validate(); err != nil {
+		return nil, err
+	}
+
 	if d.config.Push.UsePackCache {
 		d.output["image"] = d.config.Push.Image
 
@@ -148,6 +156,26 @@ func (d *PushDriver) Apply(resource *models.Resource) (*models.Resource, error)
 		return nil, err
 	}
 
+	if attestOpts.Enabled() {
+		named, err := reference.ParseNormalizedNamed(d.config.Push.Image)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tagged, ok := named.(reference.Tagged)
+
+		if !ok {
+			return nil, fmt.Errorf("image %s must have a tag to be attested", d.config.Push.Image)
+		}
+
+		err = attestImage(client, d.target, named.Name(), tagged.Tag(), attestOpts, nil)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	d.output["image"] = d.config.Push.Image
 
 	return resource, nil
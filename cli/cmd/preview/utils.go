@@ -6,8 +6,10 @@ import (
 	"os"
 	"strconv"
 
+	api "github.com/porter-dev/porter/api/client"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/cli/cmd/config"
+	"github.com/porter-dev/porter/cli/cmd/deploy"
 )
 
 type Source struct {
@@ -201,3 +203,78 @@ func existsInRepo(name, version, url string) (map[string]interface{}, error) {
 	}
 	return chart.Values, nil
 }
+
+// AttestationConfig configures the SBOM, provenance attestation and signature attached to
+// an image once it has been pushed
+type AttestationConfig struct {
+	SBOM       string
+	Provenance bool
+	SigningKey string `mapstructure:"signing_key"`
+}
+
+func (c *AttestationConfig) ToAttestOpts() *deploy.AttestOpts {
+	return &deploy.AttestOpts{
+		SBOMFormat: types.SBOMFormat(c.SBOM),
+		Provenance: c.Provenance,
+		SigningKey: c.SigningKey,
+	}
+}
+
+// GetSourceURI returns the URI of the repository being deployed, based on the env vars set
+// by the CI provider
+func GetSourceURI() string {
+	// set by GitLab CI
+	if projectURL := os.Getenv("CI_PROJECT_URL"); projectURL != "" {
+		return projectURL
+	}
+
+	if repo := os.Getenv("GITHUB_REPOSITORY"); repo != "" {
+		serverURL := os.Getenv("GITHUB_SERVER_URL")
+
+		if serverURL == "" {
+			serverURL = "https://github.com"
+		}
+
+		return fmt.Sprintf("%s/%s", serverURL, repo)
+	}
+
+	return ""
+}
+
+func attestImage(
+	client *api.Client,
+	target *Target,
+	imageRepo, tag string,
+	attestOpts *deploy.AttestOpts,
+	buildConfig map[string]interface{},
+) error {
+	if !attestOpts.Enabled() {
+		return nil
+	}
+
+	// the docker config is used by syft and cosign to authenticate with the registry
+	err := config.SetDockerConfig(client)
+
+	if err != nil {
+		return err
+	}
+
+	attestAgent := &deploy.AttestAgent{
+		SharedOpts: &deploy.SharedOpts{
+			ProjectID: target.Project,
+			ClusterID: target.Cluster,
+			Namespace: target.Namespace,
+			Attest:    attestOpts,
+		},
+		APIClient:   client,
+		ReleaseName: target.AppName,
+		ImageRepo:   imageRepo,
+		Tag:         tag,
+		SourceURI:   GetSourceURI(),
+		BuildConfig: buildConfig,
+	}
+
+	_, err = attestAgent.Attest()
+
+	return err
+}
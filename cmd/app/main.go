@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"os"
 
 	"github.com/porter-dev/porter/api/server/router"
+	"github.com/porter-dev/porter/api/server/scheduler"
 	"github.com/porter-dev/porter/api/server/shared/config"
 	"github.com/porter-dev/porter/api/server/shared/config/loader"
 	"github.com/porter-dev/porter/internal/models"
@@ -43,6 +45,18 @@ func main() {
 		log.Fatal("Data initialization failed: ", err)
 	}
 
+	if config.ServerConf.SchedulerEnabled {
+		s := scheduler.NewScheduler(
+			config,
+			config.ServerConf.SchedulerInterval,
+			scheduler.NewDriftDetectionTask(config),
+			scheduler.NewNamespacePolicyTask(config),
+			scheduler.NewMetricAlertTask(config),
+		)
+
+		go s.Start(context.Background())
+	}
+
 	appRouter := router.NewAPIRouter(config)
 
 	address := fmt.Sprintf(":%d", config.ServerConf.Port)
@@ -79,21 +79,50 @@ func (c *InviteAcceptHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 
 	kind := invite.Kind
 
-	if kind == "" {
+	// users invited to a team only get the permissions of the team by default
+	if kind == "" && invite.TeamID != 0 {
+		kind = string(types.RoleMember)
+	} else if kind == "" {
 		kind = models.RoleDeveloper
 	}
 
-	role := &models.Role{
-		Role: types.Role{
-			UserID:    user.ID,
-			ProjectID: proj.ID,
-			Kind:      types.RoleKind(kind),
-		},
-	}
+	// users who are already collaborators keep their role when they are invited to a team
+	role, err := c.Repo().Project().ReadProjectRole(proj.ID, user.ID)
 
-	if role, err = c.Repo().Project().CreateProjectRole(proj, role); err != nil {
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
 		return
+	} else if err != nil {
+		role = &models.Role{
+			Role: types.Role{
+				UserID:    user.ID,
+				ProjectID: proj.ID,
+				Kind:      types.RoleKind(kind),
+			},
+		}
+
+		if role, err = c.Repo().Project().CreateProjectRole(proj, role); err != nil {
+			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+	}
+
+	if invite.TeamID != 0 {
+		team, err := c.Repo().Team().ReadTeam(proj.ID, invite.TeamID)
+
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			return
+		}
+
+		// if the team was deleted after the invite was sent, the user is only added to the
+		// project
+		if err == nil {
+			if _, err = c.Repo().Team().AddTeamMember(team, user.ID); err != nil {
+				c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+				return
+			}
+		}
 	}
 
 	// update the invite
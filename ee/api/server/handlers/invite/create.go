@@ -3,6 +3,7 @@
 package invite
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/notifier"
 	"github.com/porter-dev/porter/internal/oauth"
+	"gorm.io/gorm"
 )
 
 type InviteCreateHandler struct {
@@ -41,6 +43,22 @@ func (c *InviteCreateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	// if the invite targets a team, the team must belong to the project
+	if request.TeamID != 0 {
+		if _, err := c.Repo().Team().ReadTeam(project.ID, request.TeamID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				c.HandleAPIError(w, r, apierrors.NewErrPassThroughToClient(
+					fmt.Errorf("team %d not found in project %d", request.TeamID, project.ID),
+					http.StatusBadRequest,
+				))
+			} else {
+				c.HandleAPIError(w, r, apierrors.NewErrInternal(err))
+			}
+
+			return
+		}
+	}
+
 	// create invite model
 	invite, err := CreateInviteWithProject(request, project.ID)
 
@@ -85,6 +103,7 @@ func CreateInviteWithProject(invite *types.CreateInviteRequest, projectID uint)
 	return &models.Invite{
 		Email:     invite.Email,
 		Kind:      invite.Kind,
+		TeamID:    invite.TeamID,
 		Expiry:    &expiry,
 		ProjectID: projectID,
 		Token:     oauth.CreateRandomState(),
@@ -0,0 +1,320 @@
+package alerts
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/helm/grapher"
+	"github.com/porter-dev/porter/internal/kubernetes/prometheus"
+	"github.com/porter-dev/porter/internal/models"
+	"helm.sh/helm/v3/pkg/release"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Transition is a change in the state of an alert which should be notified
+type Transition string
+
+const (
+	TransitionNone     Transition = ""
+	TransitionFiring   Transition = "firing"
+	TransitionResolved Transition = "resolved"
+)
+
+// releaseTargets are the objects of a release which the metrics of an alert are queried for
+type releaseTargets struct {
+	controllers []grapher.Object
+	ingresses   []string
+	hpas        []string
+}
+
+func getReleaseTargets(helmRelease *release.Release) *releaseTargets {
+	res := &releaseTargets{}
+
+	yamlArr := grapher.ImportMultiDocYAML([]byte(helmRelease.Manifest))
+
+	for _, obj := range grapher.ParseObjs(yamlArr, helmRelease.Namespace) {
+		switch obj.Kind {
+		case "Deployment", "StatefulSet", "Job", "CronJob":
+			res.controllers = append(res.controllers, obj)
+		case "Ingress":
+			res.ingresses = append(res.ingresses, obj.Name)
+		case "HorizontalPodAutoscaler":
+			res.hpas = append(res.hpas, obj.Name)
+		}
+	}
+
+	return res
+}
+
+// GetQuery returns the PromQL query for the metric of an alert rule, built from the same
+// queries as the metrics of the release's dashboard. The custom metric is only used if the
+// metric of the rule is custom.
+func GetQuery(
+	clientset kubernetes.Interface,
+	service *v1.Service,
+	rule *models.MetricAlertRule,
+	helmRelease *release.Release,
+	customMetric *models.CustomMetric,
+	now time.Time,
+) (string, error) {
+	targets := getReleaseTargets(helmRelease)
+
+	baseOpts := prometheus.QueryOpts{
+		Namespace: helmRelease.Namespace,
+		// the range is used to determine the names of the kube-state-metrics metrics
+		StartRange: uint(now.Add(-time.Hour).Unix()),
+		EndRange:   uint(now.Unix()),
+	}
+
+	queries := make([]string, 0)
+
+	addQuery := func(opts prometheus.QueryOpts) error {
+		query, err := prometheus.GetQuery(clientset, service, &opts)
+
+		if err != nil {
+			return err
+		}
+
+		queries = append(queries, fmt.Sprintf("(%s)", query))
+
+		return nil
+	}
+
+	switch rule.Metric {
+	case types.MetricAlertMetricLatencyP95, types.MetricAlertMetricErrorRate:
+		if len(targets.ingresses) == 0 {
+			return "", fmt.Errorf("release %s has no ingresses to query request metrics for", helmRelease.Name)
+		}
+
+		opts := baseOpts
+		opts.Kind = "ingress"
+		opts.Name = strings.Join(targets.ingresses, "|")
+
+		if rule.Metric == types.MetricAlertMetricLatencyP95 {
+			opts.Metric = "nginx:latency-histogram"
+			opts.Percentile = 0.95
+		} else {
+			opts.Metric = "nginx:errors"
+		}
+
+		if err := addQuery(opts); err != nil {
+			return "", err
+		}
+	case types.MetricAlertMetricMemoryLimit:
+		for _, controller := range targets.controllers {
+			opts := baseOpts
+			opts.Metric = "memory:limit-pct"
+			opts.Kind = controller.Kind
+			opts.Name = controller.Name
+
+			if err := addQuery(opts); err != nil {
+				return "", err
+			}
+		}
+
+		if len(queries) == 0 {
+			return "", fmt.Errorf("release %s has no controllers to query memory usage for", helmRelease.Name)
+		}
+	case types.MetricAlertMetricHPAMaxReplicas:
+		if len(targets.hpas) == 0 {
+			return "", fmt.Errorf("release %s has no autoscalers", helmRelease.Name)
+		}
+
+		for _, hpa := range targets.hpas {
+			opts := baseOpts
+			opts.Metric = "hpa_replicas:max-pct"
+			opts.Kind = "deployment"
+			opts.Name = hpa
+
+			if err := addQuery(opts); err != nil {
+				return "", err
+			}
+		}
+	case types.MetricAlertMetricCustom:
+		if customMetric == nil {
+			return "", fmt.Errorf("custom metric %s not found", rule.CustomMetricName)
+		}
+
+		controllers := make([]prometheus.Controller, 0)
+
+		for _, controller := range targets.controllers {
+			controllers = append(controllers, prometheus.Controller{
+				Kind: controller.Kind,
+				Name: controller.Name,
+			})
+		}
+
+		data, err := prometheus.GetCustomQueryData(helmRelease.Namespace, helmRelease.Name, controllers)
+
+		if err != nil {
+			return "", err
+		}
+
+		query, err := prometheus.RenderCustomQuery(customMetric.Query, data)
+
+		if err != nil {
+			return "", err
+		}
+
+		queries = append(queries, fmt.Sprintf("(%s)", query))
+	default:
+		return "", fmt.Errorf("unsupported alert metric %s", rule.Metric)
+	}
+
+	return strings.Join(queries, " or "), nil
+}
+
+// GetValue returns the value of a query result which is checked against the threshold of an
+// alert rule: the highest value for a greater than comparison, and the lowest value otherwise.
+// GetValue returns nil if the query returned no data.
+func GetValue(rule *models.MetricAlertRule, samples []*prometheus.VectorSample) *float64 {
+	var res *float64
+
+	for _, sample := range samples {
+		val := sample.Value
+
+		if res == nil || isWorse(rule.Comparator, val, *res) {
+			res = &val
+		}
+	}
+
+	return res
+}
+
+func isWorse(comparator types.MetricAlertComparator, val, curr float64) bool {
+	switch comparator {
+	case types.MetricAlertComparatorLessThan, types.MetricAlertComparatorLessThanOrEqual:
+		return val < curr
+	default:
+		return val > curr
+	}
+}
+
+func isBreached(rule *models.MetricAlertRule, val float64) bool {
+	switch rule.Comparator {
+	case types.MetricAlertComparatorGreaterThan:
+		return val > rule.Threshold
+	case types.MetricAlertComparatorGreaterThanOrEqual:
+		return val >= rule.Threshold
+	case types.MetricAlertComparatorLessThan:
+		return val < rule.Threshold
+	case types.MetricAlertComparatorLessThanOrEqual:
+		return val <= rule.Threshold
+	}
+
+	return false
+}
+
+// Evaluate updates the state of an alert rule with the latest value of its metric, and returns
+// the transition which should be notified. An alert fires once its threshold has been crossed
+// for ForMinutes, and each firing and resolution is only returned once.
+func Evaluate(rule *models.MetricAlertRule, value *float64, now time.Time) Transition {
+	rule.LastEvaluatedAt = &now
+	rule.LastValue = value
+
+	switch {
+	case value == nil:
+		// missing data neither fires nor resolves a firing alert, since the metrics may be
+		// temporarily unavailable
+		rule.PendingSince = nil
+
+		if rule.State != types.MetricAlertStateFiring {
+			rule.State = types.MetricAlertStateNoData
+		}
+	case isBreached(rule, *value):
+		if rule.PendingSince == nil {
+			rule.PendingSince = &now
+		}
+
+		if now.Sub(*rule.PendingSince) >= time.Duration(rule.ForMinutes)*time.Minute {
+			if rule.FiringSince == nil {
+				rule.FiringSince = &now
+			}
+
+			rule.State = types.MetricAlertStateFiring
+		} else if rule.State != types.MetricAlertStateFiring {
+			rule.State = types.MetricAlertStatePending
+		}
+	default:
+		rule.PendingSince = nil
+		rule.FiringSince = nil
+		rule.State = types.MetricAlertStateOK
+	}
+
+	if rule.State == types.MetricAlertStateFiring && rule.LastNotifiedState != types.MetricAlertStateFiring {
+		rule.LastNotifiedState = types.MetricAlertStateFiring
+		return TransitionFiring
+	}
+
+	if rule.State == types.MetricAlertStateOK && rule.LastNotifiedState == types.MetricAlertStateFiring {
+		rule.LastNotifiedState = types.MetricAlertStateOK
+		return TransitionResolved
+	}
+
+	return TransitionNone
+}
+
+// Reset clears the evaluation state of an alert rule, for example after its threshold changes
+func Reset(rule *models.MetricAlertRule) {
+	rule.State = types.MetricAlertStateOK
+	rule.LastValue = nil
+	rule.LastEvaluatedAt = nil
+	rule.PendingSince = nil
+	rule.FiringSince = nil
+	rule.LastNotifiedState = ""
+}
+
+// Describe returns a description of the state of an alert rule for notifications
+func Describe(rule *models.MetricAlertRule) string {
+	value := "no data"
+
+	if rule.LastValue != nil {
+		value = formatValue(rule.Metric, *rule.LastValue)
+	}
+
+	desc := fmt.Sprintf(
+		"%s: %s is %s (threshold %s %s",
+		rule.Name,
+		getMetricName(rule),
+		value,
+		rule.Comparator,
+		formatValue(rule.Metric, rule.Threshold),
+	)
+
+	if rule.ForMinutes > 0 {
+		desc += fmt.Sprintf(" for %dm", rule.ForMinutes)
+	}
+
+	return desc + ")"
+}
+
+func getMetricName(rule *models.MetricAlertRule) string {
+	switch rule.Metric {
+	case types.MetricAlertMetricLatencyP95:
+		return "p95 latency"
+	case types.MetricAlertMetricErrorRate:
+		return "5xx error rate"
+	case types.MetricAlertMetricMemoryLimit:
+		return "memory usage of limit"
+	case types.MetricAlertMetricHPAMaxReplicas:
+		return "replicas of autoscaler maximum"
+	case types.MetricAlertMetricCustom:
+		return rule.CustomMetricName
+	}
+
+	return string(rule.Metric)
+}
+
+func formatValue(metric types.MetricAlertMetric, val float64) string {
+	switch metric {
+	case types.MetricAlertMetricLatencyP95:
+		return fmt.Sprintf("%.3fs", val)
+	case types.MetricAlertMetricErrorRate, types.MetricAlertMetricMemoryLimit, types.MetricAlertMetricHPAMaxReplicas:
+		return fmt.Sprintf("%.1f%%", val)
+	}
+
+	return fmt.Sprintf("%g", val)
+}
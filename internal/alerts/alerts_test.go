@@ -0,0 +1,73 @@
+package alerts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/prometheus"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+func TestEvaluate(t *testing.T) {
+	rule := &models.MetricAlertRule{
+		Metric:     types.MetricAlertMetricErrorRate,
+		Comparator: types.MetricAlertComparatorGreaterThan,
+		Threshold:  5,
+		ForMinutes: 5,
+	}
+
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	high, low := 10.0, 1.0
+
+	steps := []struct {
+		minutes    int
+		value      *float64
+		state      types.MetricAlertState
+		transition Transition
+	}{
+		{0, &high, types.MetricAlertStatePending, TransitionNone},
+		{3, &high, types.MetricAlertStatePending, TransitionNone},
+		{5, &high, types.MetricAlertStateFiring, TransitionFiring},
+		// the firing alert is only notified once
+		{6, &high, types.MetricAlertStateFiring, TransitionNone},
+		// missing data does not resolve the alert
+		{7, nil, types.MetricAlertStateFiring, TransitionNone},
+		{8, &low, types.MetricAlertStateOK, TransitionResolved},
+		{9, &low, types.MetricAlertStateOK, TransitionNone},
+		{10, nil, types.MetricAlertStateNoData, TransitionNone},
+		{11, &high, types.MetricAlertStatePending, TransitionNone},
+	}
+
+	for _, step := range steps {
+		transition := Evaluate(rule, step.value, start.Add(time.Duration(step.minutes)*time.Minute))
+
+		if rule.State != step.state {
+			t.Errorf("minute %d: expected state %s, got %s", step.minutes, step.state, rule.State)
+		}
+
+		if transition != step.transition {
+			t.Errorf("minute %d: expected transition %q, got %q", step.minutes, step.transition, transition)
+		}
+	}
+}
+
+func TestGetValue(t *testing.T) {
+	samples := []*prometheus.VectorSample{{Value: 3}, {Value: 7}, {Value: 5}}
+
+	rule := &models.MetricAlertRule{Comparator: types.MetricAlertComparatorGreaterThan}
+
+	if val := GetValue(rule, samples); val == nil || *val != 7 {
+		t.Errorf("expected the highest value, got %v", val)
+	}
+
+	rule.Comparator = types.MetricAlertComparatorLessThanOrEqual
+
+	if val := GetValue(rule, samples); val == nil || *val != 3 {
+		t.Errorf("expected the lowest value, got %v", val)
+	}
+
+	if val := GetValue(rule, nil); val != nil {
+		t.Errorf("expected no value, got %v", *val)
+	}
+}
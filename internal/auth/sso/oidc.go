@@ -0,0 +1,463 @@
+package sso
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"golang.org/x/oauth2"
+)
+
+// clockSkew is the difference between the clocks of Porter and an identity provider that
+// is tolerated when checking the validity period of a token or an assertion
+const clockSkew = 2 * time.Minute
+
+// OIDCConfig is the configuration of an OpenID Connect identity provider. The endpoints of
+// the identity provider are read from its discovery document with DiscoverOIDC when the
+// configuration is saved.
+type OIDCConfig struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+
+	AuthURL  string
+	TokenURL string
+	JWKSURI  string
+
+	// GroupsClaim is the name of the claim of the id token which contains the groups of
+	// the user, and defaults to "groups"
+	GroupsClaim string
+}
+
+// OIDCProvider logs users in with the authorization code flow of an OpenID Connect
+// identity provider
+type OIDCProvider struct {
+	conf      *OIDCConfig
+	oauthConf *oauth2.Config
+}
+
+// OIDCDiscovery contains the issuer and the endpoints of the discovery document of an
+// identity provider
+type OIDCDiscovery struct {
+	Issuer   string `json:"issuer"`
+	AuthURL  string `json:"authorization_endpoint"`
+	TokenURL string `json:"token_endpoint"`
+	JWKSURI  string `json:"jwks_uri"`
+}
+
+// DiscoverOIDC reads the discovery document of an identity provider. The issuer and the
+// endpoints must use https, and the signing keys must be served by the host of the issuer.
+func DiscoverOIDC(ctx context.Context, issuerURL string) (*OIDCDiscovery, error) {
+	issuer, err := parseHTTPSURL(issuerURL)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid issuer url: %w", err)
+	}
+
+	issuerURL = strings.TrimSuffix(issuerURL, "/")
+	disc := &OIDCDiscovery{}
+
+	if err := getJSON(ctx, issuerURL+"/.well-known/openid-configuration", disc); err != nil {
+		return nil, fmt.Errorf("could not read openid configuration: %w", err)
+	}
+
+	// the issuer of the discovery document must be the configured issuer, otherwise the id
+	// tokens of a different issuer would be accepted
+	if strings.TrimSuffix(disc.Issuer, "/") != issuerURL {
+		return nil, fmt.Errorf("openid configuration issuer %s does not match issuer %s", disc.Issuer, issuerURL)
+	}
+
+	if disc.AuthURL == "" || disc.TokenURL == "" || disc.JWKSURI == "" {
+		return nil, fmt.Errorf("openid configuration of %s is missing endpoints", issuerURL)
+	}
+
+	for _, endpoint := range []string{disc.AuthURL, disc.TokenURL} {
+		if _, err := parseHTTPSURL(endpoint); err != nil {
+			return nil, fmt.Errorf("invalid endpoint %s: %w", endpoint, err)
+		}
+	}
+
+	jwksURI, err := parseHTTPSURL(disc.JWKSURI)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid jwks_uri: %w", err)
+	}
+
+	if !strings.EqualFold(jwksURI.Host, issuer.Host) {
+		return nil, fmt.Errorf("jwks_uri %s is not on the host of issuer %s", disc.JWKSURI, issuerURL)
+	}
+
+	return disc, nil
+}
+
+func parseHTTPSURL(rawURL string) (*url.URL, error) {
+	res, err := url.Parse(rawURL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Scheme != "https" || res.Host == "" {
+		return nil, fmt.Errorf("%s is not an https url", rawURL)
+	}
+
+	return res, nil
+}
+
+// NewOIDCProvider returns a provider for an identity provider whose endpoints were discovered
+func NewOIDCProvider(conf *OIDCConfig) (*OIDCProvider, error) {
+	if conf.AuthURL == "" || conf.TokenURL == "" || conf.JWKSURI == "" {
+		return nil, fmt.Errorf("openid configuration of %s was not discovered", conf.IssuerURL)
+	}
+
+	return &OIDCProvider{
+		conf: conf,
+		oauthConf: &oauth2.Config{
+			ClientID:     conf.ClientID,
+			ClientSecret: conf.ClientSecret,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  conf.AuthURL,
+				TokenURL: conf.TokenURL,
+			},
+			RedirectURL: conf.RedirectURL,
+			Scopes:      []string{"openid", "profile", "email"},
+		},
+	}, nil
+}
+
+// AuthCodeURL returns the url of the identity provider to redirect the user to. The nonce
+// is returned in the id token, and must be passed to Exchange.
+func (p *OIDCProvider) AuthCodeURL(state, nonce string) string {
+	return p.oauthConf.AuthCodeURL(state, oauth2.SetAuthURLParam("nonce", nonce))
+}
+
+// Exchange exchanges an authorization code for tokens, and returns the identity of the
+// user from the verified id token
+func (p *OIDCProvider) Exchange(ctx context.Context, code, nonce string) (*Identity, error) {
+	token, err := p.oauthConf.Exchange(context.WithValue(ctx, oauth2.HTTPClient, httpClient), code)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not exchange authorization code: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+
+	if !ok || rawIDToken == "" {
+		return nil, fmt.Errorf("token response does not contain an id token")
+	}
+
+	return p.VerifyIDToken(ctx, rawIDToken, nonce, time.Now())
+}
+
+// VerifyIDToken verifies the signature and the claims of an id token, and returns the
+// identity of the user
+func (p *OIDCProvider) VerifyIDToken(ctx context.Context, rawIDToken, nonce string, now time.Time) (*Identity, error) {
+	// the time based claims are checked below with a tolerance for clock skew
+	parser := &jwt.Parser{SkipClaimsValidation: true}
+
+	token, err := parser.Parse(rawIDToken, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		switch token.Method.(type) {
+		case *jwt.SigningMethodRSA:
+			return p.findKey(ctx, kid, "RSA", now)
+		case *jwt.SigningMethodECDSA:
+			return p.findKey(ctx, kid, "EC", now)
+		}
+
+		return nil, fmt.Errorf("unsupported signing method %s", token.Method.Alg())
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid id token: %w", err)
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+
+	if !ok || !token.Valid {
+		return nil, fmt.Errorf("invalid id token")
+	}
+
+	if iss, _ := claims["iss"].(string); iss != p.conf.IssuerURL {
+		return nil, fmt.Errorf("id token issuer %s does not match issuer %s", iss, p.conf.IssuerURL)
+	}
+
+	if !hasAudience(claims, p.conf.ClientID) {
+		return nil, fmt.Errorf("id token was not issued for client %s", p.conf.ClientID)
+	}
+
+	exp, ok := claims["exp"].(float64)
+
+	if !ok || now.After(time.Unix(int64(exp), 0).Add(clockSkew)) {
+		return nil, fmt.Errorf("id token is expired")
+	}
+
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(clockSkew).Before(time.Unix(int64(nbf), 0)) {
+		return nil, fmt.Errorf("id token is not valid yet")
+	}
+
+	if tokenNonce, _ := claims["nonce"].(string); tokenNonce != nonce {
+		return nil, fmt.Errorf("id token nonce does not match")
+	}
+
+	// identity providers which do not verify emails may omit the claim, but an email which
+	// is explicitly not verified is rejected
+	if verified, ok := claims["email_verified"].(bool); ok && !verified {
+		return nil, fmt.Errorf("email of the user is not verified")
+	}
+
+	res := &Identity{}
+	res.Subject, _ = claims["sub"].(string)
+	res.Email, _ = claims["email"].(string)
+
+	if res.Subject == "" || res.Email == "" {
+		return nil, fmt.Errorf("id token does not contain the subject and the email of the user")
+	}
+
+	groupsClaim := p.conf.GroupsClaim
+
+	if groupsClaim == "" {
+		groupsClaim = "groups"
+	}
+
+	res.Groups = getStringList(claims[groupsClaim])
+
+	return res, nil
+}
+
+func hasAudience(claims jwt.MapClaims, clientID string) bool {
+	auds := getStringList(claims["aud"])
+
+	for _, aud := range auds {
+		if aud == clientID {
+			// when a token has several audiences, the authorized party must be the client
+			if azp, ok := claims["azp"].(string); ok && len(auds) > 1 {
+				return azp == clientID
+			}
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// getStringList returns the strings of a claim which is either a string or a list of
+// strings
+func getStringList(claim interface{}) []string {
+	res := make([]string, 0)
+
+	switch val := claim.(type) {
+	case string:
+		res = append(res, val)
+	case []interface{}:
+		for _, elem := range val {
+			if str, ok := elem.(string); ok {
+				res = append(res, str)
+			}
+		}
+	}
+
+	return res
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+
+	// RSA keys
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// EC keys
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []*jsonWebKey `json:"keys"`
+}
+
+const (
+	// jwksCacheTTL is how long the signing keys of an identity provider are cached
+	jwksCacheTTL = time.Hour
+
+	// jwksMinRefreshInterval is how often the signing keys can be read again when a token is
+	// signed with an unknown key, since identity providers rotate their keys
+	jwksMinRefreshInterval = time.Minute
+)
+
+type cachedKeySet struct {
+	keys      *jsonWebKeySet
+	fetchedAt time.Time
+}
+
+// jwksCache caches the signing keys of identity providers by the url of their key sets
+var jwksCache = struct {
+	sync.Mutex
+	sets map[string]*cachedKeySet
+}{sets: make(map[string]*cachedKeySet)}
+
+// findKey returns a signing key of the identity provider. The key set is read again if the
+// key is not in the cached key set.
+func (p *OIDCProvider) findKey(ctx context.Context, kid, kty string, now time.Time) (interface{}, error) {
+	keys, err := p.getKeys(ctx, false, now)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if key, err := keys.find(kid, kty); err == nil {
+		return key, nil
+	}
+
+	if keys, err = p.getKeys(ctx, true, now); err != nil {
+		return nil, err
+	}
+
+	return keys.find(kid, kty)
+}
+
+// getKeys returns the cached key set of the identity provider, and reads the key set if it
+// is not cached, if the cache is expired, or if refresh is set and the key set was not read
+// within the minimum refresh interval
+func (p *OIDCProvider) getKeys(ctx context.Context, refresh bool, now time.Time) (*jsonWebKeySet, error) {
+	jwksCache.Lock()
+	defer jwksCache.Unlock()
+
+	if cached, ok := jwksCache.sets[p.conf.JWKSURI]; ok {
+		age := now.Sub(cached.fetchedAt)
+
+		if age < jwksCacheTTL && (!refresh || age < jwksMinRefreshInterval) {
+			return cached.keys, nil
+		}
+	}
+
+	res := &jsonWebKeySet{}
+
+	if err := getJSON(ctx, p.conf.JWKSURI, res); err != nil {
+		return nil, fmt.Errorf("could not read signing keys: %w", err)
+	}
+
+	jwksCache.sets[p.conf.JWKSURI] = &cachedKeySet{
+		keys:      res,
+		fetchedAt: now,
+	}
+
+	return res, nil
+}
+
+// find returns the signing key with a key id and a key type. Tokens without a key id are
+// only accepted if the key set has a single key of the type.
+func (s *jsonWebKeySet) find(kid, kty string) (interface{}, error) {
+	candidates := make([]*jsonWebKey, 0)
+
+	for _, key := range s.Keys {
+		if key.Kty != kty || (key.Use != "" && key.Use != "sig") {
+			continue
+		}
+
+		if kid == "" || key.Kid == kid {
+			candidates = append(candidates, key)
+		}
+	}
+
+	if len(candidates) != 1 {
+		return nil, fmt.Errorf("signing key %s not found", kid)
+	}
+
+	return candidates[0].publicKey()
+}
+
+func (k *jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus of key %s", k.Kid)
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("invalid exponent of key %s", k.Kid)
+		}
+
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "EC":
+		var curve elliptic.Curve
+
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s of key %s", k.Crv, k.Kid)
+		}
+
+		x, errX := base64.RawURLEncoding.DecodeString(k.X)
+		y, errY := base64.RawURLEncoding.DecodeString(k.Y)
+
+		if errX != nil || errY != nil {
+			return nil, fmt.Errorf("invalid coordinates of key %s", k.Kid)
+		}
+
+		key := &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, fmt.Errorf("invalid coordinates of key %s", k.Kid)
+		}
+
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+}
+
+func getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request to %s failed with status %d", url, resp.StatusCode)
+	}
+
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(v)
+}
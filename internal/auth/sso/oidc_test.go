@@ -0,0 +1,288 @@
+package sso
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+type testOIDCIssuer struct {
+	*httptest.Server
+
+	// keys are the signing keys served by the issuer by their key id
+	keys map[string]*rsa.PrivateKey
+
+	// jwksURI overrides the jwks_uri of the discovery document
+	jwksURI string
+}
+
+// newTestOIDCIssuer starts an identity provider, and replaces the http client for requests
+// to identity providers with a client which trusts it
+func newTestOIDCIssuer(t *testing.T, key *rsa.PrivateKey) *testOIDCIssuer {
+	mux := http.NewServeMux()
+	issuer := &testOIDCIssuer{
+		Server: httptest.NewTLSServer(mux),
+		keys:   map[string]*rsa.PrivateKey{"key-1": key},
+	}
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		jwksURI := issuer.jwksURI
+
+		if jwksURI == "" {
+			jwksURI = issuer.URL + "/keys"
+		}
+
+		json.NewEncoder(w).Encode(&OIDCDiscovery{
+			Issuer:   issuer.URL,
+			AuthURL:  issuer.URL + "/authorize",
+			TokenURL: issuer.URL + "/token",
+			JWKSURI:  jwksURI,
+		})
+	})
+
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		keySet := &jsonWebKeySet{}
+
+		for kid, key := range issuer.keys {
+			keySet.Keys = append(keySet.Keys, &jsonWebKey{
+				Kty: "RSA",
+				Kid: kid,
+				Use: "sig",
+				N:   base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.PublicKey.E)).Bytes()),
+			})
+		}
+
+		json.NewEncoder(w).Encode(keySet)
+	})
+
+	client := httpClient
+	httpClient = issuer.Client()
+
+	t.Cleanup(func() {
+		httpClient = client
+		issuer.Close()
+	})
+
+	return issuer
+}
+
+func newTestOIDCProvider(t *testing.T, issuerURL string) *OIDCProvider {
+	disc, err := DiscoverOIDC(context.Background(), issuerURL)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	provider, err := NewOIDCProvider(&OIDCConfig{
+		IssuerURL:   disc.Issuer,
+		ClientID:    "porter",
+		AuthURL:     disc.AuthURL,
+		TokenURL:    disc.TokenURL,
+		JWKSURI:     disc.JWKSURI,
+		GroupsClaim: "roles",
+	})
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	return provider
+}
+
+func newTestIDToken(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+
+	signed, err := token.SignedString(key)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	return signed
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	server := newTestOIDCIssuer(t, key)
+	provider := newTestOIDCProvider(t, server.URL)
+
+	now := time.Now()
+
+	newToken := func(claims jwt.MapClaims) string {
+		base := jwt.MapClaims{
+			"iss":            server.URL,
+			"aud":            "porter",
+			"sub":            "1234",
+			"email":          "user@example.com",
+			"email_verified": true,
+			"roles":          []string{"engineering"},
+			"nonce":          "nonce",
+			"exp":            now.Add(time.Hour).Unix(),
+		}
+
+		for k, v := range claims {
+			base[k] = v
+		}
+
+		return newTestIDToken(t, key, "key-1", base)
+	}
+
+	identity, err := provider.VerifyIDToken(context.Background(), newToken(nil), "nonce", now)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if identity.Email != "user@example.com" || len(identity.Groups) != 1 || identity.Groups[0] != "engineering" {
+		t.Errorf("unexpected identity %v", identity)
+	}
+
+	tests := map[string]jwt.MapClaims{
+		"wrong issuer":       {"iss": "https://evil.example.com"},
+		"wrong audience":     {"aud": []string{"other", "client"}},
+		"wrong nonce":        {"nonce": "other"},
+		"expired":            {"exp": now.Add(-time.Hour).Unix()},
+		"unverified email":   {"email_verified": false},
+		"wrong party":        {"aud": []string{"porter", "other"}, "azp": "other"},
+		"missing expiration": {"exp": nil},
+	}
+
+	for name, claims := range tests {
+		if _, err := provider.VerifyIDToken(context.Background(), newToken(claims), "nonce", now); err == nil {
+			t.Errorf("%s: expected id token to be rejected", name)
+		}
+	}
+
+	// tokens signed by a different key are rejected
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	signed := newTestIDToken(t, otherKey, "key-1", jwt.MapClaims{
+		"iss":   server.URL,
+		"aud":   "porter",
+		"sub":   "1234",
+		"email": "user@example.com",
+		"nonce": "nonce",
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+
+	if _, err := provider.VerifyIDToken(context.Background(), signed, "nonce", now); err == nil {
+		t.Errorf("expected id token signed by another key to be rejected")
+	}
+}
+
+func TestVerifyIDTokenKeyRotation(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	server := newTestOIDCIssuer(t, key)
+	provider := newTestOIDCProvider(t, server.URL)
+	now := time.Now()
+
+	claims := jwt.MapClaims{
+		"iss":   server.URL,
+		"aud":   "porter",
+		"sub":   "1234",
+		"email": "user@example.com",
+		"nonce": "nonce",
+		"exp":   now.Add(time.Hour).Unix(),
+	}
+
+	if _, err := provider.VerifyIDToken(context.Background(), newTestIDToken(t, key, "key-1", claims), "nonce", now); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// the identity provider rotates its keys, which is only noticed once the cached keys
+	// can be refreshed
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	server.keys = map[string]*rsa.PrivateKey{"key-2": newKey}
+	token := newTestIDToken(t, newKey, "key-2", claims)
+
+	if _, err := provider.VerifyIDToken(context.Background(), token, "nonce", now.Add(time.Second)); err == nil {
+		t.Errorf("expected keys not to be refreshed within the minimum refresh interval")
+	}
+
+	if _, err := provider.VerifyIDToken(context.Background(), token, "nonce", now.Add(2*jwksMinRefreshInterval)); err != nil {
+		t.Errorf("expected keys to be refreshed for an unknown key id: %v", err)
+	}
+}
+
+func TestDiscoverOIDC(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	server := newTestOIDCIssuer(t, key)
+
+	if _, err := DiscoverOIDC(context.Background(), strings.Replace(server.URL, "https://", "http://", 1)); err == nil {
+		t.Errorf("expected issuer without https to be rejected")
+	}
+
+	server.jwksURI = "https://169.254.169.254/keys"
+
+	if _, err := DiscoverOIDC(context.Background(), server.URL); err == nil {
+		t.Errorf("expected jwks_uri on another host to be rejected")
+	}
+}
+
+func TestCheckDialAddress(t *testing.T) {
+	tests := map[string]bool{
+		"93.184.216.34:443":        true,
+		"[2606:2800:220:1::1]:443": true,
+		"127.0.0.1:443":            false,
+		"10.0.0.1:443":             false,
+		"172.16.0.1:443":           false,
+		"192.168.1.1:443":          false,
+		"169.254.169.254:80":       false,
+		"100.100.100.200:80":       false,
+		"0.0.0.0:443":              false,
+		"[::1]:443":                false,
+		"[fd00:ec2::254]:80":       false,
+		"[fe80::1]:443":            false,
+		"[::ffff:127.0.0.1]:443":   false,
+	}
+
+	for address, allowed := range tests {
+		if err := checkDialAddress("tcp", address, nil); (err == nil) != allowed {
+			t.Errorf("%s: expected allowed to be %t, got error %v", address, allowed, err)
+		}
+	}
+
+	// identity providers on the local network cannot be reached
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+
+	if _, err := DiscoverOIDC(context.Background(), server.URL); err == nil || !strings.Contains(err.Error(), "not allowed") {
+		t.Errorf("expected connection to a loopback address to be refused, got %v", err)
+	}
+}
@@ -0,0 +1,735 @@
+package sso
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+)
+
+const (
+	nsSAMLProtocol  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	nsSAMLAssertion = "urn:oasis:names:tc:SAML:2.0:assertion"
+
+	samlStatusSuccess       = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	samlNameIDFormatEmail   = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	samlBindingHTTPPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	samlConfirmationBearer  = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	samlRequestNonceLength  = 16
+	samlRequestPayloadBytes = samlRequestNonceLength + 8
+)
+
+// SAMLRequestValidity is how long the identity provider can respond to an authentication
+// request
+const SAMLRequestValidity = 10 * time.Minute
+
+// ErrIDUsed is returned by a UsedIDStore when an id was already used
+var ErrIDUsed = errors.New("id was already used")
+
+// UsedIDStore records the ids of the requests and the assertions which users logged in with
+// until they expire, so that responses of the identity provider cannot be replayed
+type UsedIDStore interface {
+	// UseID records an id, and returns ErrIDUsed if the id was already used
+	UseID(id string, expiry time.Time) error
+}
+
+// samlSignatureMethods and samlDigestMethods are the supported algorithms of signatures.
+// SHA-1 based algorithms are not supported.
+var samlSignatureMethods = map[string]bool{
+	dsig.RSASHA256SignatureMethod: true,
+	dsig.RSASHA512SignatureMethod: true,
+}
+
+var samlDigestMethods = map[string]bool{
+	"http://www.w3.org/2001/04/xmlenc#sha256": true,
+	"http://www.w3.org/2001/04/xmlenc#sha512": true,
+}
+
+// samlEmailAttributes are the names of the attributes identity providers commonly assert
+// the email of a user with, when the name id of the subject is not an email
+var samlEmailAttributes = []string{
+	"email",
+	"mail",
+	"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress",
+	"urn:oid:0.9.2342.19200300.100.1.3",
+}
+
+// SAMLConfig is the configuration of Porter as a SAML 2.0 service provider of an identity
+// provider
+type SAMLConfig struct {
+	// EntityID is the entity id of the service provider, and ACSURL is the url of the
+	// assertion consumer service the identity provider posts responses to
+	EntityID string
+	ACSURL   string
+
+	IdPSSOURL      string
+	IdPEntityID    string
+	IdPCertificate string
+
+	// GroupsAttribute is the name of the attribute which contains the groups of the user,
+	// and defaults to "groups"
+	GroupsAttribute string
+
+	// RequestSecret signs the ids of authentication requests, so that responses can be
+	// matched to the requests Porter sent without storing the requests
+	RequestSecret []byte
+
+	// UsedIDs records the ids of requests and assertions once users log in with them
+	UsedIDs UsedIDStore
+}
+
+// SAMLServiceProvider logs users in with the HTTP-Redirect and HTTP-POST bindings of a
+// SAML 2.0 identity provider. Only signed responses or signed assertions are accepted, and
+// encrypted assertions are not supported.
+type SAMLServiceProvider struct {
+	conf *SAMLConfig
+	cert *x509.Certificate
+}
+
+// NewSAMLServiceProvider returns a service provider for the identity provider of a config
+func NewSAMLServiceProvider(conf *SAMLConfig) (*SAMLServiceProvider, error) {
+	cert, err := ParseCertificate(conf.IdPCertificate)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(conf.RequestSecret) == 0 {
+		return nil, fmt.Errorf("request secret cannot be empty")
+	}
+
+	if conf.UsedIDs == nil {
+		return nil, fmt.Errorf("used id store cannot be empty")
+	}
+
+	return &SAMLServiceProvider{conf, cert}, nil
+}
+
+// ParseCertificate parses a PEM encoded certificate, or the base64 encoded certificate of
+// the metadata of an identity provider
+func ParseCertificate(data string) (*x509.Certificate, error) {
+	der := []byte{}
+
+	if block, _ := pem.Decode([]byte(data)); block != nil {
+		der = block.Bytes
+	} else {
+		var err error
+
+		if der, err = decodeBase64(data); err != nil {
+			return nil, fmt.Errorf("invalid certificate: %w", err)
+		}
+	}
+
+	cert, err := x509.ParseCertificate(der)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate: %w", err)
+	}
+
+	return cert, nil
+}
+
+type samlAuthnRequest struct {
+	XMLName                     xml.Name `xml:"samlp:AuthnRequest"`
+	SAMLP                       string   `xml:"xmlns:samlp,attr"`
+	SAML                        string   `xml:"xmlns:saml,attr"`
+	ID                          string   `xml:"ID,attr"`
+	Version                     string   `xml:"Version,attr"`
+	IssueInstant                string   `xml:"IssueInstant,attr"`
+	Destination                 string   `xml:"Destination,attr"`
+	AssertionConsumerServiceURL string   `xml:"AssertionConsumerServiceURL,attr"`
+	ProtocolBinding             string   `xml:"ProtocolBinding,attr"`
+	Issuer                      string   `xml:"saml:Issuer"`
+	NameIDPolicy                struct {
+		Format      string `xml:"Format,attr"`
+		AllowCreate bool   `xml:"AllowCreate,attr"`
+	} `xml:"samlp:NameIDPolicy"`
+}
+
+// AuthnRequestURL returns the url of the identity provider to redirect the user to, with an
+// authentication request encoded for the HTTP-Redirect binding. The id of the request is
+// returned along with the url, and must be passed to ParseResponse from the same browser.
+func (sp *SAMLServiceProvider) AuthnRequestURL(relayState string, now time.Time) (string, string, error) {
+	id, err := sp.newRequestID(now)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	req := &samlAuthnRequest{
+		SAMLP:                       nsSAMLProtocol,
+		SAML:                        nsSAMLAssertion,
+		ID:                          id,
+		Version:                     "2.0",
+		IssueInstant:                now.UTC().Format(time.RFC3339),
+		Destination:                 sp.conf.IdPSSOURL,
+		AssertionConsumerServiceURL: sp.conf.ACSURL,
+		ProtocolBinding:             samlBindingHTTPPost,
+		Issuer:                      sp.conf.EntityID,
+	}
+
+	req.NameIDPolicy.Format = samlNameIDFormatEmail
+	req.NameIDPolicy.AllowCreate = true
+
+	data, err := xml.Marshal(req)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	var buf bytes.Buffer
+
+	fw, err := flate.NewWriter(&buf, flate.DefaultCompression)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	if _, err := fw.Write(data); err != nil {
+		return "", "", err
+	}
+
+	if err := fw.Close(); err != nil {
+		return "", "", err
+	}
+
+	idpURL, err := url.Parse(sp.conf.IdPSSOURL)
+
+	if err != nil {
+		return "", "", fmt.Errorf("invalid identity provider url: %w", err)
+	}
+
+	query := idpURL.Query()
+	query.Set("SAMLRequest", base64.StdEncoding.EncodeToString(buf.Bytes()))
+
+	if relayState != "" {
+		query.Set("RelayState", relayState)
+	}
+
+	idpURL.RawQuery = query.Encode()
+
+	return idpURL.String(), id, nil
+}
+
+// newRequestID returns the id of an authentication request, which contains a nonce and the
+// expiry of the request signed with the request secret
+func (sp *SAMLServiceProvider) newRequestID(now time.Time) (string, error) {
+	payload := make([]byte, samlRequestPayloadBytes)
+
+	if _, err := rand.Read(payload[:samlRequestNonceLength]); err != nil {
+		return "", err
+	}
+
+	binary.BigEndian.PutUint64(payload[samlRequestNonceLength:], uint64(now.Add(SAMLRequestValidity).Unix()))
+
+	// ids must not start with a digit
+	return "_" + hex.EncodeToString(payload) + hex.EncodeToString(sp.signRequestPayload(payload)), nil
+}
+
+func (sp *SAMLServiceProvider) signRequestPayload(payload []byte) []byte {
+	mac := hmac.New(sha256.New, sp.conf.RequestSecret)
+	mac.Write([]byte(sp.conf.EntityID))
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
+
+// verifyRequestID checks that a response is in response to an unexpired request of the
+// service provider, and returns the expiry of the request
+func (sp *SAMLServiceProvider) verifyRequestID(id string, now time.Time) (time.Time, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(id, "_"))
+
+	if err != nil || !strings.HasPrefix(id, "_") || len(data) != samlRequestPayloadBytes+sha256.Size {
+		return time.Time{}, fmt.Errorf("response is not in response to a request of porter")
+	}
+
+	payload := data[:samlRequestPayloadBytes]
+
+	if !hmac.Equal(data[samlRequestPayloadBytes:], sp.signRequestPayload(payload)) {
+		return time.Time{}, fmt.Errorf("response is not in response to a request of porter")
+	}
+
+	expiry := time.Unix(int64(binary.BigEndian.Uint64(payload[samlRequestNonceLength:])), 0)
+
+	if now.After(expiry) {
+		return time.Time{}, fmt.Errorf("authentication request has expired")
+	}
+
+	return expiry, nil
+}
+
+type samlMetadata struct {
+	XMLName         xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID        string   `xml:"entityID,attr"`
+	SPSSODescriptor struct {
+		AuthnRequestsSigned        bool   `xml:"AuthnRequestsSigned,attr"`
+		WantAssertionsSigned       bool   `xml:"WantAssertionsSigned,attr"`
+		ProtocolSupportEnumeration string `xml:"protocolSupportEnumeration,attr"`
+		NameIDFormat               string `xml:"NameIDFormat"`
+		AssertionConsumerService   struct {
+			Binding  string `xml:"Binding,attr"`
+			Location string `xml:"Location,attr"`
+			Index    int    `xml:"index,attr"`
+		} `xml:"AssertionConsumerService"`
+	} `xml:"SPSSODescriptor"`
+}
+
+// Metadata returns the metadata of the service provider, which can be imported by identity
+// providers
+func (sp *SAMLServiceProvider) Metadata() ([]byte, error) {
+	md := &samlMetadata{
+		EntityID: sp.conf.EntityID,
+	}
+
+	md.SPSSODescriptor.WantAssertionsSigned = true
+	md.SPSSODescriptor.ProtocolSupportEnumeration = nsSAMLProtocol
+	md.SPSSODescriptor.NameIDFormat = samlNameIDFormatEmail
+	md.SPSSODescriptor.AssertionConsumerService.Binding = samlBindingHTTPPost
+	md.SPSSODescriptor.AssertionConsumerService.Location = sp.conf.ACSURL
+
+	data, err := xml.MarshalIndent(md, "", "  ")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), data...), nil
+}
+
+// ParseResponse verifies a base64 encoded response posted by the identity provider, and
+// returns the identity of the user from the assertion of the response. Either the response
+// or the assertion must be signed by the certificate of the identity provider. The response
+// must be in response to the request with the id returned by AuthnRequestURL in the browser
+// which posted the response, and the request and the assertion can only be used once.
+func (sp *SAMLServiceProvider) ParseResponse(encoded, requestID string, now time.Time) (*Identity, error) {
+	data, err := decodeBase64(encoded)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid saml response: %w", err)
+	}
+
+	doc := etree.NewDocument()
+
+	if err := doc.ReadFromBytes(data); err != nil {
+		return nil, fmt.Errorf("invalid saml response: %w", err)
+	}
+
+	for _, tok := range doc.Child {
+		if _, ok := tok.(*etree.Directive); ok {
+			return nil, fmt.Errorf("saml responses with a DTD are not supported")
+		}
+	}
+
+	resp := doc.Root()
+
+	if !isElement(resp, nsSAMLProtocol, "Response") {
+		return nil, fmt.Errorf("invalid saml response")
+	}
+
+	// signatures reference the signed element by id, so ids must be unique in the document
+	// for the verified element to be the one which is read below
+	if err := checkUniqueIDs(resp, make(map[string]bool)); err != nil {
+		return nil, err
+	}
+
+	if len(childElements(resp, nsSAMLAssertion, "EncryptedAssertion")) > 0 {
+		return nil, fmt.Errorf("encrypted assertions are not supported")
+	}
+
+	if len(childElements(resp, nsSAMLAssertion, "Assertion")) != 1 {
+		return nil, fmt.Errorf("expected a single assertion in saml response")
+	}
+
+	// the assertion is trusted if it is signed, or if it is part of a signed response
+	if !hasSignature(resp) && !hasSignature(childElement(resp, nsSAMLAssertion, "Assertion")) {
+		return nil, fmt.Errorf("saml response is not signed")
+	}
+
+	// only the copies of the elements returned by the validation of their signatures are
+	// read, so that nothing outside of the signed content is trusted
+	if hasSignature(resp) {
+		if resp, err = sp.validateSignature(resp, now); err != nil {
+			return nil, err
+		}
+	}
+
+	assertions := childElements(resp, nsSAMLAssertion, "Assertion")
+
+	if len(assertions) != 1 {
+		return nil, fmt.Errorf("expected a single assertion in saml response")
+	}
+
+	assertion := assertions[0]
+
+	if hasSignature(assertion) {
+		if assertion, err = sp.validateSignature(assertion, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if dest := resp.SelectAttrValue("Destination", ""); dest != "" && dest != sp.conf.ACSURL {
+		return nil, fmt.Errorf("saml response was sent to %s", dest)
+	}
+
+	inResponseTo := resp.SelectAttrValue("InResponseTo", "")
+
+	// the request must have been started by the browser which posted the response, otherwise
+	// users could be logged in to the account of an attacker
+	if requestID == "" || subtle.ConstantTimeCompare([]byte(inResponseTo), []byte(requestID)) != 1 {
+		return nil, fmt.Errorf("response is not in response to the request of this browser")
+	}
+
+	requestExpiry, err := sp.verifyRequestID(inResponseTo, now)
+
+	if err != nil {
+		return nil, err
+	}
+
+	status := childElement(resp, nsSAMLProtocol, "Status")
+	statusCode := childElement(status, nsSAMLProtocol, "StatusCode")
+
+	if statusCode == nil || statusCode.SelectAttrValue("Value", "") != samlStatusSuccess {
+		msg := elementText(childElement(status, nsSAMLProtocol, "StatusMessage"))
+		return nil, fmt.Errorf("identity provider did not authenticate the user: %s", msg)
+	}
+
+	if issuer := elementText(childElement(assertion, nsSAMLAssertion, "Issuer")); sp.conf.IdPEntityID != "" && issuer != sp.conf.IdPEntityID {
+		return nil, fmt.Errorf("assertion was issued by %s", issuer)
+	}
+
+	assertionID := assertion.SelectAttrValue("ID", "")
+
+	if assertionID == "" {
+		return nil, fmt.Errorf("assertion has no id")
+	}
+
+	conditionsExpiry, err := sp.checkConditions(childElement(assertion, nsSAMLAssertion, "Conditions"), now)
+
+	if err != nil {
+		return nil, err
+	}
+
+	subject := childElement(assertion, nsSAMLAssertion, "Subject")
+
+	confirmationExpiry, err := sp.checkSubjectConfirmation(subject, inResponseTo, now)
+
+	if err != nil {
+		return nil, err
+	}
+
+	nameID := childElement(subject, nsSAMLAssertion, "NameID")
+
+	res := &Identity{
+		Subject: elementText(nameID),
+		Groups:  make([]string, 0),
+	}
+
+	if res.Subject == "" {
+		return nil, fmt.Errorf("assertion does not contain the name id of the user")
+	}
+
+	if nameID.SelectAttrValue("Format", "") == samlNameIDFormatEmail {
+		res.Email = res.Subject
+	}
+
+	groupsAttr := sp.conf.GroupsAttribute
+
+	if groupsAttr == "" {
+		groupsAttr = "groups"
+	}
+
+	attrs := getAssertionAttributes(assertion)
+
+	for _, name := range samlEmailAttributes {
+		if vals := attrs[name]; res.Email == "" && len(vals) > 0 {
+			res.Email = vals[0]
+		}
+	}
+
+	if res.Email == "" {
+		return nil, fmt.Errorf("assertion does not contain the email of the user")
+	}
+
+	res.Groups = append(res.Groups, attrs[groupsAttr]...)
+
+	// the assertion is recorded until it can no longer be accepted, since a signed assertion
+	// can be posted in response to a different request
+	assertionExpiry := confirmationExpiry
+
+	if conditionsExpiry.After(assertionExpiry) {
+		assertionExpiry = conditionsExpiry
+	}
+
+	if err := sp.useID("saml_request:"+inResponseTo, requestExpiry); err != nil {
+		return nil, err
+	}
+
+	if err := sp.useID("saml_assertion:"+assertionID, assertionExpiry.Add(clockSkew)); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (sp *SAMLServiceProvider) useID(id string, expiry time.Time) error {
+	if err := sp.conf.UsedIDs.UseID(id, expiry); errors.Is(err, ErrIDUsed) {
+		return fmt.Errorf("saml response was already used")
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSignature verifies the enveloped signature of an element with the certificate of
+// the identity provider, and returns the signed content of the element
+func (sp *SAMLServiceProvider) validateSignature(el *etree.Element, now time.Time) (*etree.Element, error) {
+	signedInfo := childElement(childElement(el, dsig.Namespace, "Signature"), dsig.Namespace, "SignedInfo")
+
+	if method := childElement(signedInfo, dsig.Namespace, "SignatureMethod"); method == nil ||
+		!samlSignatureMethods[method.SelectAttrValue("Algorithm", "")] {
+		return nil, fmt.Errorf("unsupported signature method of %s", el.Tag)
+	}
+
+	for _, ref := range childElements(signedInfo, dsig.Namespace, "Reference") {
+		if method := childElement(ref, dsig.Namespace, "DigestMethod"); method == nil ||
+			!samlDigestMethods[method.SelectAttrValue("Algorithm", "")] {
+			return nil, fmt.Errorf("unsupported digest method of %s", el.Tag)
+		}
+	}
+
+	// the element is detached from the document along with the namespaces it inherits,
+	// since they are part of its canonical form
+	nsCtx, err := etreeutils.NSBuildParentContext(el)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid saml response: %w", err)
+	}
+
+	if nsCtx, err = nsCtx.SubContext(el); err != nil {
+		return nil, fmt.Errorf("invalid saml response: %w", err)
+	}
+
+	detached, err := etreeutils.NSDetatch(nsCtx, el)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid saml response: %w", err)
+	}
+
+	validationCtx := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{
+		Roots: []*x509.Certificate{sp.cert},
+	})
+
+	validationCtx.Clock = dsig.NewFakeClockAt(now)
+
+	res, err := validationCtx.Validate(detached)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature of %s: %w", el.Tag, err)
+	}
+
+	return res, nil
+}
+
+// checkConditions checks the conditions of an assertion, and returns the end of the validity
+// period of the conditions, which is zero if they do not have one
+func (sp *SAMLServiceProvider) checkConditions(conditions *etree.Element, now time.Time) (time.Time, error) {
+	if conditions == nil {
+		return time.Time{}, fmt.Errorf("assertion has no conditions")
+	}
+
+	notOnOrAfter := conditions.SelectAttrValue("NotOnOrAfter", "")
+
+	if err := checkValidityPeriod(conditions.SelectAttrValue("NotBefore", ""), notOnOrAfter, now); err != nil {
+		return time.Time{}, fmt.Errorf("assertion %w", err)
+	}
+
+	// every audience restriction must contain the service provider
+	for _, restriction := range childElements(conditions, nsSAMLAssertion, "AudienceRestriction") {
+		var found bool
+
+		for _, audience := range childElements(restriction, nsSAMLAssertion, "Audience") {
+			found = found || elementText(audience) == sp.conf.EntityID
+		}
+
+		if !found {
+			return time.Time{}, fmt.Errorf("assertion is not intended for %s", sp.conf.EntityID)
+		}
+	}
+
+	// the validity period was checked above
+	expiry, _ := time.Parse(time.RFC3339, notOnOrAfter)
+
+	return expiry, nil
+}
+
+// checkSubjectConfirmation checks that the subject of an assertion can be confirmed as the
+// bearer of the assertion, and returns the end of the validity period of the confirmation
+func (sp *SAMLServiceProvider) checkSubjectConfirmation(subject *etree.Element, inResponseTo string, now time.Time) (time.Time, error) {
+	for _, confirmation := range childElements(subject, nsSAMLAssertion, "SubjectConfirmation") {
+		data := childElement(confirmation, nsSAMLAssertion, "SubjectConfirmationData")
+
+		if confirmation.SelectAttrValue("Method", "") != samlConfirmationBearer || data == nil {
+			continue
+		}
+
+		notBefore, notOnOrAfter := data.SelectAttrValue("NotBefore", ""), data.SelectAttrValue("NotOnOrAfter", "")
+
+		if notOnOrAfter == "" || checkValidityPeriod(notBefore, notOnOrAfter, now) != nil {
+			continue
+		}
+
+		if recipient := data.SelectAttrValue("Recipient", ""); recipient != "" && recipient != sp.conf.ACSURL {
+			continue
+		}
+
+		if reqID := data.SelectAttrValue("InResponseTo", ""); reqID != "" && reqID != inResponseTo {
+			continue
+		}
+
+		expiry, _ := time.Parse(time.RFC3339, notOnOrAfter)
+
+		return expiry, nil
+	}
+
+	return time.Time{}, fmt.Errorf("subject of the assertion could not be confirmed")
+}
+
+func checkValidityPeriod(notBefore, notOnOrAfter string, now time.Time) error {
+	if notBefore != "" {
+		t, err := time.Parse(time.RFC3339, notBefore)
+
+		if err != nil {
+			return fmt.Errorf("has an invalid validity period")
+		} else if now.Add(clockSkew).Before(t) {
+			return fmt.Errorf("is not valid yet")
+		}
+	}
+
+	if notOnOrAfter != "" {
+		t, err := time.Parse(time.RFC3339, notOnOrAfter)
+
+		if err != nil {
+			return fmt.Errorf("has an invalid validity period")
+		} else if !now.Add(-clockSkew).Before(t) {
+			return fmt.Errorf("is expired")
+		}
+	}
+
+	return nil
+}
+
+// getAssertionAttributes returns the values of the attributes of an assertion by their name
+func getAssertionAttributes(assertion *etree.Element) map[string][]string {
+	res := make(map[string][]string)
+
+	for _, statement := range childElements(assertion, nsSAMLAssertion, "AttributeStatement") {
+		for _, attr := range childElements(statement, nsSAMLAssertion, "Attribute") {
+			name := attr.SelectAttrValue("Name", "")
+
+			for _, val := range childElements(attr, nsSAMLAssertion, "AttributeValue") {
+				if text := elementText(val); text != "" {
+					res[name] = append(res[name], text)
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+// isElement returns true if an element has a namespace and a local name
+func isElement(el *etree.Element, ns, tag string) bool {
+	return el != nil && el.Tag == tag && el.NamespaceURI() == ns
+}
+
+// childElements returns the child elements of an element with a namespace and a local name
+func childElements(el *etree.Element, ns, tag string) []*etree.Element {
+	res := make([]*etree.Element, 0)
+
+	if el == nil {
+		return res
+	}
+
+	for _, child := range el.ChildElements() {
+		if isElement(child, ns, tag) {
+			res = append(res, child)
+		}
+	}
+
+	return res
+}
+
+// childElement returns the first child element of an element with a namespace and a local
+// name, or nil if there is none
+func childElement(el *etree.Element, ns, tag string) *etree.Element {
+	if children := childElements(el, ns, tag); len(children) > 0 {
+		return children[0]
+	}
+
+	return nil
+}
+
+// elementText returns all the character data directly inside an element. Comments do not
+// split the text, since they are not part of the signed content.
+func elementText(el *etree.Element) string {
+	if el == nil {
+		return ""
+	}
+
+	var res strings.Builder
+
+	for _, tok := range el.Child {
+		if data, ok := tok.(*etree.CharData); ok {
+			res.WriteString(data.Data)
+		}
+	}
+
+	return strings.TrimSpace(res.String())
+}
+
+// hasSignature returns true if an element has an enveloped signature
+func hasSignature(el *etree.Element) bool {
+	return childElement(el, dsig.Namespace, "Signature") != nil
+}
+
+// checkUniqueIDs returns an error if several elements have the same value of the ID attribute
+func checkUniqueIDs(el *etree.Element, ids map[string]bool) error {
+	if id := el.SelectAttrValue("ID", ""); id != "" {
+		if ids[id] {
+			return fmt.Errorf("saml response contains the id %s more than once", id)
+		}
+
+		ids[id] = true
+	}
+
+	for _, child := range el.ChildElements() {
+		if err := checkUniqueIDs(child, ids); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeBase64 decodes base64 content which may be wrapped over several lines
+func decodeBase64(s string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+}
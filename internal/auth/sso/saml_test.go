@@ -0,0 +1,314 @@
+package sso
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+)
+
+const testSPEntityID = "https://porter.example.com/api/sso/saml/1/metadata"
+const testACSURL = "https://porter.example.com/api/sso/saml/1/acs"
+
+func newTestIdP(t *testing.T) (*rsa.PrivateKey, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+type testUsedIDStore map[string]time.Time
+
+func (s testUsedIDStore) UseID(id string, expiry time.Time) error {
+	if _, ok := s[id]; ok {
+		return ErrIDUsed
+	}
+
+	s[id] = expiry
+
+	return nil
+}
+
+func newTestServiceProvider(t *testing.T, cert string) *SAMLServiceProvider {
+	sp, err := NewSAMLServiceProvider(&SAMLConfig{
+		EntityID:       testSPEntityID,
+		ACSURL:         testACSURL,
+		IdPSSOURL:      "https://idp.example.com/sso",
+		IdPEntityID:    "https://idp.example.com",
+		IdPCertificate: cert,
+		RequestSecret:  []byte("secret"),
+		UsedIDs:        make(testUsedIDStore),
+	})
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	return sp
+}
+
+func testAssertion(id, requestID, email string, now time.Time) string {
+	return fmt.Sprintf(`<saml:Assertion ID="%s" Version="2.0" IssueInstant="%s">`+
+		`<saml:Issuer>https://idp.example.com</saml:Issuer>`+
+		`<saml:Subject><saml:NameID Format="urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress">%s</saml:NameID>`+
+		`<saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer">`+
+		`<saml:SubjectConfirmationData InResponseTo="%s" NotOnOrAfter="%s" Recipient="%s"/>`+
+		`</saml:SubjectConfirmation></saml:Subject>`+
+		`<saml:Conditions NotBefore="%s" NotOnOrAfter="%s">`+
+		`<saml:AudienceRestriction><saml:Audience>%s</saml:Audience></saml:AudienceRestriction></saml:Conditions>`+
+		`<saml:AttributeStatement><saml:Attribute Name="groups">`+
+		`<saml:AttributeValue xsi:type="xs:string">engineering</saml:AttributeValue>`+
+		`<saml:AttributeValue xsi:type="xs:string">admins</saml:AttributeValue>`+
+		`</saml:Attribute></saml:AttributeStatement>`+
+		`</saml:Assertion>`,
+		id, now.Format(time.RFC3339), email, requestID, now.Add(5*time.Minute).Format(time.RFC3339), testACSURL,
+		now.Add(-time.Minute).Format(time.RFC3339), now.Add(5*time.Minute).Format(time.RFC3339), testSPEntityID,
+	)
+}
+
+func testResponse(requestID, assertion string) string {
+	return fmt.Sprintf(`<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" `+
+		`xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" xmlns:xs="http://www.w3.org/2001/XMLSchema" `+
+		`xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" `+
+		`ID="_response" Version="2.0" Destination="%s" InResponseTo="%s">`+
+		`<saml:Issuer>https://idp.example.com</saml:Issuer>`+
+		`<samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>`+
+		"\n  %s\n"+
+		`</samlp:Response>`,
+		testACSURL, requestID, assertion,
+	)
+}
+
+type testKeyStore struct {
+	key  *rsa.PrivateKey
+	cert []byte
+}
+
+func (ks *testKeyStore) GetKeyPair() (*rsa.PrivateKey, []byte, error) {
+	return ks.key, ks.cert, nil
+}
+
+// signAssertion signs the assertion with an id in a response, and returns the response with
+// the signature inserted after the issuer of the assertion
+func signAssertion(t *testing.T, key *rsa.PrivateKey, cert, response, id string) string {
+	doc := etree.NewDocument()
+
+	if err := doc.ReadFromString(response); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	var assertion *etree.Element
+
+	for _, elem := range childElements(doc.Root(), nsSAMLAssertion, "Assertion") {
+		if elem.SelectAttrValue("ID", "") == id {
+			assertion = elem
+		}
+	}
+
+	nsCtx, err := etreeutils.NSBuildParentContext(assertion)
+
+	if err == nil {
+		nsCtx, err = nsCtx.SubContext(assertion)
+	}
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	detached, err := etreeutils.NSDetatch(nsCtx, assertion)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	block, _ := pem.Decode([]byte(cert))
+
+	signingCtx := dsig.NewDefaultSigningContext(&testKeyStore{key, block.Bytes})
+	signingCtx.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+
+	signed, err := signingCtx.SignEnveloped(detached)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	sig := signed.Child[len(signed.Child)-1]
+	signed.RemoveChildAt(len(signed.Child) - 1)
+	signed.InsertChildAt(childElement(signed, nsSAMLAssertion, "Issuer").Index()+1, sig)
+
+	doc.Root().InsertChild(assertion, signed)
+	doc.Root().RemoveChild(assertion)
+
+	res, err := doc.WriteToString()
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	return res
+}
+
+func TestParseSAMLResponse(t *testing.T) {
+	key, cert := newTestIdP(t)
+	sp := newTestServiceProvider(t, cert)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	requestID, err := sp.newRequestID(now)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	response := signAssertion(t, key, cert, testResponse(requestID, testAssertion("_assertion", requestID, "user@example.com", now)), "_assertion")
+
+	identity, err := sp.ParseResponse(base64.StdEncoding.EncodeToString([]byte(response)), requestID, now)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if identity.Email != "user@example.com" {
+		t.Errorf("expected email user@example.com, got %s", identity.Email)
+	}
+
+	if strings.Join(identity.Groups, ",") != "engineering,admins" {
+		t.Errorf("expected groups engineering,admins, got %v", identity.Groups)
+	}
+
+	tests := map[string]string{
+		"tampered assertion": strings.Replace(response, "user@example.com", "admin@example.com", 1),
+		"unsigned assertion": testResponse(requestID, testAssertion("_assertion", requestID, "user@example.com", now)),
+		"other request":      strings.Replace(response, fmt.Sprintf(`InResponseTo="%s"`, requestID), `InResponseTo="_unknown"`, 1),
+		"wrapped assertion": strings.Replace(
+			response,
+			"\n  <saml:Assertion",
+			"\n  "+testAssertion("_evil", requestID, "admin@example.com", now)+"<saml:Assertion",
+			1,
+		),
+		"whitespace in signed element": strings.Replace(response, "user@example.com<", "user@example.com <", 1),
+		"duplicate id": strings.Replace(
+			response,
+			"<samlp:Status>",
+			`<samlp:Extensions ID="_assertion"/><samlp:Status>`,
+			1,
+		),
+		"reference to another element": strings.Replace(response, `ID="_assertion"`, `ID="_other"`, 1),
+		"namespace redeclaration": strings.Replace(
+			response,
+			"<saml:NameID ",
+			`<saml:NameID xmlns:saml="urn:example:evil" `,
+			1,
+		),
+		"dtd": `<!DOCTYPE samlp:Response [<!ENTITY email "admin@example.com">]>` + response,
+	}
+
+	for name, resp := range tests {
+		// every response is parsed by a new service provider, so that it is not rejected
+		// because its ids were already used
+		sp := newTestServiceProvider(t, cert)
+
+		if _, err := sp.ParseResponse(base64.StdEncoding.EncodeToString([]byte(resp)), requestID, now); err == nil {
+			t.Errorf("%s: expected response to be rejected", name)
+		}
+	}
+
+	forged := strings.Replace(response, fmt.Sprintf(`InResponseTo="%s"`, requestID), `InResponseTo="_unknown"`, 1)
+
+	if _, err := newTestServiceProvider(t, cert).ParseResponse(base64.StdEncoding.EncodeToString([]byte(forged)), "_unknown", now); err == nil {
+		t.Errorf("expected response to a request which porter did not send to be rejected")
+	}
+
+	if _, err := newTestServiceProvider(t, cert).ParseResponse(base64.StdEncoding.EncodeToString([]byte(response)), requestID, now.Add(time.Hour)); err == nil {
+		t.Errorf("expected expired response to be rejected")
+	}
+}
+
+func TestParseSAMLResponseReplay(t *testing.T) {
+	key, cert := newTestIdP(t)
+	sp := newTestServiceProvider(t, cert)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	requestID, err := sp.newRequestID(now)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	otherRequestID, err := sp.newRequestID(now)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	response := base64.StdEncoding.EncodeToString([]byte(signAssertion(
+		t, key, cert, testResponse(requestID, testAssertion("_assertion", requestID, "user@example.com", now)), "_assertion",
+	)))
+
+	// a response can only be posted by the browser which started the login
+	if _, err := sp.ParseResponse(response, otherRequestID, now); err == nil {
+		t.Errorf("expected response to the request of another browser to be rejected")
+	}
+
+	if _, err := sp.ParseResponse(response, requestID, now); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if _, err := sp.ParseResponse(response, requestID, now.Add(time.Minute)); err == nil {
+		t.Errorf("expected replayed response to be rejected")
+	}
+}
+
+func TestParseSAMLResponseWithComment(t *testing.T) {
+	key, cert := newTestIdP(t)
+	sp := newTestServiceProvider(t, cert)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	requestID, err := sp.newRequestID(now)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	email := "admin@example.com.evil.com"
+	response := signAssertion(t, key, cert, testResponse(requestID, testAssertion("_assertion", requestID, email, now)), "_assertion")
+
+	// comments are not part of the signed content, so they must not truncate the name id
+	response = strings.Replace(response, "admin@example.com", "admin@example.com<!---->", 1)
+
+	identity, err := sp.ParseResponse(base64.StdEncoding.EncodeToString([]byte(response)), requestID, now)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if identity.Email != email {
+		t.Errorf("expected email %s, got %s", email, identity.Email)
+	}
+}
@@ -0,0 +1,60 @@
+package sso
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// Identity is the identity of a user asserted by an identity provider
+type Identity struct {
+	// Subject is the id of the user at the identity provider
+	Subject string
+
+	Email  string
+	Groups []string
+}
+
+// httpClient is used for requests to identity providers. Identity providers are configured
+// by the admins of projects, so connections to addresses which are not public are refused.
+// The addresses are checked when connecting, after hostnames are resolved.
+var httpClient = &http.Client{
+	Timeout: 10 * time.Second,
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: 5 * time.Second,
+			Control: checkDialAddress,
+		}).DialContext,
+		TLSHandshakeTimeout: 5 * time.Second,
+	},
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, which some clouds serve metadata from
+var sharedAddressSpace = &net.IPNet{
+	IP:   net.IPv4(100, 64, 0, 0),
+	Mask: net.CIDRMask(10, 32),
+}
+
+func checkDialAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+
+	if err != nil {
+		return err
+	}
+
+	if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+		return fmt.Errorf("connections to %s are not allowed", host)
+	}
+
+	return nil
+}
+
+// isPublicIP returns false for private, loopback, link-local and other addresses which are
+// not routable on the internet
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsPrivate() && !ip.IsLoopback() && !ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() && !ip.IsInterfaceLocalMulticast() && !ip.IsMulticast() &&
+		!ip.IsUnspecified() && !sharedAddressSpace.Contains(ip)
+}
@@ -0,0 +1,270 @@
+package autorollback
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/helm"
+	"github.com/porter-dev/porter/internal/integrations/slack"
+	"github.com/porter-dev/porter/internal/kubernetes"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/releasesteps"
+	"github.com/porter-dev/porter/internal/repository"
+	"helm.sh/helm/v3/pkg/release"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DefaultWindow is the time an upgraded release is watched for if the release does not
+	// set a window
+	DefaultWindow = 5 * time.Minute
+
+	// DefaultCrashThreshold is the number of container restarts which triggers a rollback if
+	// the release does not set a threshold
+	DefaultCrashThreshold = 3
+
+	// rollbackEventID and rollbackEventIndex identify the release step which records an
+	// automatic rollback
+	rollbackEventID    = "auto_rollback"
+	rollbackEventIndex = 500
+)
+
+// Watcher watches the rollout of an upgraded release, and rolls the release back to its
+// previous revision if the rollout fails its health checks
+type Watcher struct {
+	Repo      repository.Repository
+	HelmAgent *helm.Agent
+	K8sAgent  *kubernetes.Agent
+	Cluster   *models.Cluster
+	Release   *models.Release
+
+	// Notifier and NotifyOpts are used to send a notification when the release is rolled back,
+	// unless notifications are disabled for the cluster
+	Notifier   slack.Notifier
+	NotifyOpts *slack.NotifyOpts
+
+	// PollInterval is the interval between health checks, which defaults to 5 seconds
+	PollInterval time.Duration
+}
+
+// Watch watches the workloads of the new revision of a release until the window of the
+// release expires. The release is rolled back if a container of the new pods restarts more
+// often than the crash threshold, or if the rollout has not completed once the window
+// expires. It returns true if the release was rolled back.
+func (w *Watcher) Watch(newRelease *release.Release) (bool, error) {
+	conf := w.Release.GetAutoRollbackConfig()
+
+	if !conf.Enabled {
+		return false, nil
+	}
+
+	window := DefaultWindow
+
+	if conf.WindowSeconds != 0 {
+		window = time.Duration(conf.WindowSeconds) * time.Second
+	}
+
+	crashThreshold := int32(DefaultCrashThreshold)
+
+	if conf.CrashThreshold != 0 {
+		crashThreshold = int32(conf.CrashThreshold)
+	}
+
+	pollInterval := w.PollInterval
+
+	if pollInterval == 0 {
+		pollInterval = 5 * time.Second
+	}
+
+	deployments, err := releasesteps.GetManifestDeployments(newRelease.Manifest)
+
+	if err != nil {
+		return false, err
+	}
+
+	if len(deployments) == 0 {
+		return false, nil
+	}
+
+	// only pods which were created by this upgrade are checked for crashes
+	since := time.Now().Add(-pollInterval)
+	deadline := time.Now().Add(window)
+
+	var reason string
+
+	for {
+		rolledOut, crashReason, err := w.check(newRelease.Namespace, deployments, since, crashThreshold)
+
+		if err != nil {
+			return false, err
+		}
+
+		if crashReason != "" {
+			reason = crashReason
+			break
+		}
+
+		if time.Now().After(deadline) {
+			if !rolledOut {
+				reason = fmt.Sprintf("the rollout did not complete within %s", window)
+			}
+
+			break
+		}
+
+		time.Sleep(pollInterval)
+	}
+
+	if reason == "" {
+		return false, nil
+	}
+
+	return true, w.rollback(newRelease, reason)
+}
+
+// check returns true if every deployment has rolled out, and the reason for a rollback if a
+// container of the new pods has restarted at least crashThreshold times
+func (w *Watcher) check(
+	namespace string,
+	deployments []string,
+	since time.Time,
+	crashThreshold int32,
+) (bool, string, error) {
+	rolledOut := true
+
+	for _, name := range deployments {
+		depl, err := w.K8sAgent.Clientset.AppsV1().Deployments(namespace).Get(
+			context.Background(),
+			name,
+			metav1.GetOptions{},
+		)
+
+		if err != nil {
+			return false, "", err
+		}
+
+		if !kubernetes.IsDeploymentRolledOut(depl) {
+			rolledOut = false
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(depl.Spec.Selector)
+
+		if err != nil {
+			return false, "", err
+		}
+
+		pods, err := w.K8sAgent.Clientset.CoreV1().Pods(namespace).List(
+			context.Background(),
+			metav1.ListOptions{
+				LabelSelector: selector.String(),
+			},
+		)
+
+		if err != nil {
+			return false, "", err
+		}
+
+		for _, pod := range pods.Items {
+			if pod.CreationTimestamp.Time.Before(since) {
+				continue
+			}
+
+			if reason := getCrashReason(&pod, crashThreshold); reason != "" {
+				return rolledOut, reason, nil
+			}
+		}
+	}
+
+	return rolledOut, "", nil
+}
+
+func getCrashReason(pod *v1.Pod, crashThreshold int32) string {
+	statuses := make([]v1.ContainerStatus, 0)
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+
+	for _, status := range statuses {
+		if status.RestartCount < crashThreshold {
+			continue
+		}
+
+		reason := fmt.Sprintf(
+			"container %s of pod %s restarted %d times",
+			status.Name,
+			pod.Name,
+			status.RestartCount,
+		)
+
+		if term := status.LastTerminationState.Terminated; term != nil {
+			reason = fmt.Sprintf("%s (last exit code %d: %s)", reason, term.ExitCode, term.Reason)
+		}
+
+		return reason
+	}
+
+	return ""
+}
+
+// rollback rolls the release back to the last revision which was deployed successfully before
+// the new revision, records the rollback in the release steps and sends a notification
+func (w *Watcher) rollback(newRelease *release.Release, reason string) error {
+	history, err := w.HelmAgent.GetReleaseHistory(newRelease.Name)
+
+	if err != nil {
+		return err
+	}
+
+	revision := 0
+
+	for _, rel := range history {
+		if rel.Version >= newRelease.Version || rel.Version <= revision || rel.Info == nil {
+			continue
+		}
+
+		if status := rel.Info.Status; status == release.StatusSuperseded || status == release.StatusDeployed {
+			revision = rel.Version
+		}
+	}
+
+	if revision == 0 {
+		err := fmt.Errorf("%s, but there is no previous revision to roll back to", reason)
+		w.recordEvent(types.EventStatusFailed, err.Error())
+
+		return err
+	}
+
+	if err := w.HelmAgent.RollbackRelease(newRelease.Name, revision); err != nil {
+		err = fmt.Errorf("%s, but the rollback to revision %d failed: %w", reason, revision, err)
+		w.recordEvent(types.EventStatusFailed, err.Error())
+
+		return err
+	}
+
+	info := fmt.Sprintf("Rolled back revision %d to revision %d: %s", newRelease.Version, revision, reason)
+
+	w.recordEvent(types.EventStatusSuccess, info)
+
+	if w.Notifier != nil && w.NotifyOpts != nil && (w.Cluster == nil || !w.Cluster.NotificationsDisabled) {
+		opts := *w.NotifyOpts
+		opts.Status = slack.StatusRolledBack
+		opts.Version = revision
+		opts.Info = info
+
+		w.Notifier.Notify(&opts)
+	}
+
+	return nil
+}
+
+func (w *Watcher) recordEvent(status types.EventStatus, info string) {
+	releasesteps.AppendEvent(w.Repo, w.Release, &models.SubEvent{
+		EventID: rollbackEventID,
+		Name:    "Auto-rollback",
+		Index:   rollbackEventIndex,
+		Status:  status,
+		Info:    info,
+	})
+}
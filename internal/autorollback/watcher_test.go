@@ -0,0 +1,82 @@
+package autorollback
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/porter-dev/porter/internal/kubernetes"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCheck(t *testing.T) {
+	replicas := int32(1)
+	labels := map[string]string{"app.kubernetes.io/instance": "web"}
+	now := time.Now()
+
+	newPod := func(name string, created time.Time, restarts int32) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				Labels:            labels,
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Status: v1.PodStatus{
+				ContainerStatuses: []v1.ContainerStatus{{
+					Name:         "web",
+					RestartCount: restarts,
+					LastTerminationState: v1.ContainerState{
+						Terminated: &v1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"},
+					},
+				}},
+			},
+		}
+	}
+
+	depl := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", Generation: 2},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+		},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 2,
+			Replicas:           2,
+			UpdatedReplicas:    1,
+			AvailableReplicas:  1,
+		},
+	}
+
+	// the old pod has crashed before the upgrade, which must not trigger a rollback
+	w := &Watcher{
+		K8sAgent: &kubernetes.Agent{
+			Clientset: fake.NewSimpleClientset(depl, newPod("web-old", now.Add(-time.Hour), 10), newPod("web-new", now, 1)),
+		},
+	}
+
+	rolledOut, reason, err := w.check("default", []string{"web"}, now.Add(-time.Minute), 3)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rolledOut || reason != "" {
+		t.Errorf("expected rollout in progress without a crash, got rolled out %t, reason %q", rolledOut, reason)
+	}
+
+	w.K8sAgent.Clientset = fake.NewSimpleClientset(depl, newPod("web-new", now, 3))
+
+	_, reason, err = w.check("default", []string{"web"}, now.Add(-time.Minute), 3)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(reason, "container web of pod web-new restarted 3 times") {
+		t.Errorf("expected crash reason, got %q", reason)
+	}
+}
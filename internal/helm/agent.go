@@ -21,6 +21,7 @@ import (
 
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/kubernetes"
+	"github.com/porter-dev/porter/internal/kubernetes/quota"
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
 )
@@ -154,6 +155,13 @@ type UpgradeReleaseConfig struct {
 
 	// Optional, if chart should be overriden
 	Chart *chart.Chart
+
+	// Optional, if the images of the release should be checked against an image policy
+	ImagePolicy *types.ImagePolicy
+
+	// Optional, a hook which is called with the rendered manifest of the new revision before
+	// the release is upgraded. The upgrade is aborted if the hook returns an error.
+	PreUpgrade func(manifest string) error
 }
 
 // UpgradeRelease upgrades a specific release with new values.yaml
@@ -191,6 +199,34 @@ func (a *Agent) UpgradeReleaseByValues(
 		ch = conf.Chart
 	}
 
+	hasQuota, err := a.hasResourceQuota(rel.Namespace)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the upgrade is rendered before any objects are changed if the rendered manifest has to
+	// be checked against the resource quota or passed to the pre-upgrade hook
+	if hasQuota || conf.PreUpgrade != nil {
+		manifest, err := a.dryRunUpgrade(conf, rel.Namespace, ch, doAuth)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if hasQuota {
+			if err := quota.ValidateUpgrade(a.K8sAgent.Clientset, rel.Namespace, rel.Manifest, manifest); err != nil {
+				return nil, err
+			}
+		}
+
+		if conf.PreUpgrade != nil {
+			if err := conf.PreUpgrade(manifest); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	cmd := action.NewUpgrade(a.ActionConfig)
 	cmd.Namespace = rel.Namespace
 
@@ -201,6 +237,7 @@ func (a *Agent) UpgradeReleaseByValues(
 		rel.Namespace,
 		conf.Registries,
 		doAuth,
+		conf.ImagePolicy,
 	)
 
 	if err != nil {
@@ -340,6 +377,88 @@ func (a *Agent) UpgradeReleaseByValues(
 	return res, nil
 }
 
+// ValidateUpgradeRelease renders the upgraded release with the post-renderer of the upgrade,
+// which checks the images against the image policy, and checks that the release fits in the
+// resource quota of its namespace, without upgrading the release
+func (a *Agent) ValidateUpgradeRelease(
+	conf *UpgradeReleaseConfig,
+	values string,
+	doAuth *oauth2.Config,
+) error {
+	valuesYaml, err := chartutil.ReadValues([]byte(values))
+
+	if err != nil {
+		return fmt.Errorf("Values could not be parsed: %v", err)
+	}
+
+	conf.Values = valuesYaml
+
+	return a.ValidateUpgradeReleaseByValues(conf, doAuth)
+}
+
+// ValidateUpgradeReleaseByValues validates an upgrade of a release by unmarshaled yaml values
+func (a *Agent) ValidateUpgradeReleaseByValues(
+	conf *UpgradeReleaseConfig,
+	doAuth *oauth2.Config,
+) error {
+	rel, err := a.GetRelease(conf.Name, 0, true)
+
+	if err != nil {
+		return fmt.Errorf("Could not get release to be upgraded: %v", err)
+	}
+
+	ch := rel.Chart
+
+	if conf.Chart != nil {
+		ch = conf.Chart
+	}
+
+	manifest, err := a.dryRunUpgrade(conf, rel.Namespace, ch, doAuth)
+
+	if err != nil {
+		return err
+	}
+
+	return a.validateUpgradeQuota(rel, manifest)
+}
+
+// dryRunUpgrade renders the new revision of a release with a dry-run upgrade, using the same
+// post-renderer as the upgrade, and returns the rendered manifest
+func (a *Agent) dryRunUpgrade(
+	conf *UpgradeReleaseConfig,
+	namespace string,
+	ch *chart.Chart,
+	doAuth *oauth2.Config,
+) (string, error) {
+	cmd := action.NewUpgrade(a.ActionConfig)
+	cmd.Namespace = namespace
+	cmd.DryRun = true
+
+	var err error
+
+	cmd.PostRenderer, err = NewPorterPostrenderer(
+		conf.Cluster,
+		conf.Repo,
+		a.K8sAgent,
+		namespace,
+		conf.Registries,
+		doAuth,
+		conf.ImagePolicy,
+	)
+
+	if err != nil {
+		return "", err
+	}
+
+	dryRun, err := cmd.Run(conf.Name, ch, conf.Values)
+
+	if err != nil {
+		return "", fmt.Errorf("Upgrade failed: %w", err)
+	}
+
+	return dryRun.Manifest, nil
+}
+
 // InstallChartConfig is the config required to install a chart
 type InstallChartConfig struct {
 	Chart      *chart.Chart
@@ -349,6 +468,9 @@ type InstallChartConfig struct {
 	Cluster    *models.Cluster
 	Repo       repository.Repository
 	Registries []*models.Registry
+
+	// Optional, if the images of the release should be checked against an image policy
+	ImagePolicy *types.ImagePolicy
 }
 
 // InstallChartFromValuesBytes reads the raw values and calls Agent.InstallChart
@@ -396,6 +518,7 @@ func (a *Agent) InstallChart(
 		conf.Namespace,
 		conf.Registries,
 		doAuth,
+		conf.ImagePolicy,
 	)
 
 	if err != nil {
@@ -0,0 +1,77 @@
+package helm
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/helm/drift"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// GetReleaseDrift compares the manifest stored in the latest revision of a release with
+// the live objects in the cluster, and returns the resources which have drifted
+func (a *Agent) GetReleaseDrift(name string) (*types.ReleaseDrift, error) {
+	rel, err := a.GetRelease(name, 0, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := a.ActionConfig.KubeClient.Build(bytes.NewBufferString(rel.Manifest), false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.ReleaseDrift{
+		Name:      rel.Name,
+		Namespace: rel.Namespace,
+		Revision:  rel.Version,
+		CheckedAt: time.Now(),
+		Resources: make([]*types.ResourceDrift, 0),
+	}
+
+	for _, info := range resources {
+		desired, err := runtime.DefaultUnstructuredConverter.ToUnstructured(info.Object)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resDrift := &types.ResourceDrift{
+			Kind:      info.Object.GetObjectKind().GroupVersionKind().Kind,
+			Name:      info.Name,
+			Namespace: info.Namespace,
+		}
+
+		// Get overwrites the object with the live object from the cluster
+		if err := info.Get(); err != nil {
+			if !errors.IsNotFound(err) {
+				return nil, err
+			}
+
+			resDrift.Missing = true
+			res.Resources = append(res.Resources, resDrift)
+
+			continue
+		}
+
+		live, err := runtime.DefaultUnstructuredConverter.ToUnstructured(info.Object)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resDrift.Diffs = drift.Compare(desired, live)
+
+		if len(resDrift.Diffs) > 0 {
+			res.Resources = append(res.Resources, resDrift)
+		}
+	}
+
+	res.Drifted = len(res.Resources) > 0
+
+	return res, nil
+}
@@ -0,0 +1,240 @@
+package drift
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/porter-dev/porter/api/types"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Compare returns the field-level differences between the desired state of an object (as
+// rendered in a Helm release manifest) and the live object in the cluster.
+//
+// Only fields which are set in the desired object are compared, so fields which are
+// defaulted by the API server or set by controllers are ignored. The status block and
+// server-managed metadata are ignored as well. Lists of named objects (containers, env vars,
+// ports, volumes) are matched by name, and named elements which only exist on the live
+// object are reported, since these are typically added by hand.
+func Compare(desired, live map[string]interface{}) []types.DriftFieldDiff {
+	diffs := make([]types.DriftFieldDiff, 0)
+
+	for _, key := range sortedKeys(desired) {
+		switch key {
+		case "status":
+			continue
+		case "metadata":
+			desiredMeta, _ := desired[key].(map[string]interface{})
+			liveMeta, _ := live[key].(map[string]interface{})
+
+			for _, metaKey := range []string{"labels", "annotations"} {
+				diffs = compareValue(diffs, "metadata."+metaKey, desiredMeta[metaKey], liveMeta[metaKey])
+			}
+		default:
+			diffs = compareValue(diffs, key, desired[key], live[key])
+		}
+	}
+
+	return diffs
+}
+
+func compareValue(diffs []types.DriftFieldDiff, path string, desired, live interface{}) []types.DriftFieldDiff {
+	if live == nil {
+		if isEmpty(desired) {
+			return diffs
+		}
+
+		return append(diffs, types.DriftFieldDiff{
+			Path:     path,
+			Expected: desired,
+		})
+	}
+
+	switch desiredVal := desired.(type) {
+	case map[string]interface{}:
+		liveVal, ok := live.(map[string]interface{})
+
+		if !ok {
+			return append(diffs, types.DriftFieldDiff{Path: path, Expected: desired, Actual: live})
+		}
+
+		for _, key := range sortedKeys(desiredVal) {
+			diffs = compareValue(diffs, path+"."+key, desiredVal[key], liveVal[key])
+		}
+
+		return diffs
+	case []interface{}:
+		liveVal, ok := live.([]interface{})
+
+		if !ok {
+			return append(diffs, types.DriftFieldDiff{Path: path, Expected: desired, Actual: live})
+		}
+
+		if isNamedList(desiredVal) && isNamedList(liveVal) {
+			return compareNamedList(diffs, path, desiredVal, liveVal)
+		}
+
+		if len(desiredVal) != len(liveVal) {
+			return append(diffs, types.DriftFieldDiff{Path: path, Expected: desired, Actual: live})
+		}
+
+		for i := range desiredVal {
+			diffs = compareValue(diffs, fmt.Sprintf("%s[%d]", path, i), desiredVal[i], liveVal[i])
+		}
+
+		return diffs
+	case nil:
+		return diffs
+	}
+
+	if !scalarEqual(desired, live) {
+		return append(diffs, types.DriftFieldDiff{Path: path, Expected: desired, Actual: live})
+	}
+
+	return diffs
+}
+
+func compareNamedList(diffs []types.DriftFieldDiff, path string, desired, live []interface{}) []types.DriftFieldDiff {
+	liveByName := make(map[string]interface{})
+	desiredNames := make(map[string]bool)
+
+	for _, elem := range live {
+		liveByName[elemName(elem)] = elem
+	}
+
+	for _, elem := range desired {
+		name := elemName(elem)
+		desiredNames[name] = true
+
+		diffs = compareValue(diffs, fmt.Sprintf("%s[name=%s]", path, name), elem, liveByName[name])
+	}
+
+	for _, elem := range live {
+		if name := elemName(elem); !desiredNames[name] {
+			diffs = append(diffs, types.DriftFieldDiff{
+				Path:   fmt.Sprintf("%s[name=%s]", path, name),
+				Actual: elem,
+			})
+		}
+	}
+
+	return diffs
+}
+
+func isNamedList(list []interface{}) bool {
+	if len(list) == 0 {
+		return false
+	}
+
+	for _, elem := range list {
+		if elemName(elem) == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func elemName(elem interface{}) string {
+	elemMap, ok := elem.(map[string]interface{})
+
+	if !ok {
+		return ""
+	}
+
+	name, _ := elemMap["name"].(string)
+
+	return name
+}
+
+func isEmpty(val interface{}) bool {
+	if val == nil {
+		return true
+	}
+
+	switch v := val.(type) {
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	}
+
+	if f, ok := toFloat(val); ok {
+		return f == 0
+	}
+
+	return false
+}
+
+// scalarEqual compares two scalar values, treating numbers of different types (for example
+// int from YAML and int64 from the API server) and equivalent resource quantities ("0.5" and
+// "500m") as equal
+func scalarEqual(desired, live interface{}) bool {
+	if reflect.DeepEqual(desired, live) {
+		return true
+	}
+
+	desiredFloat, desiredIsNum := toFloat(desired)
+	liveFloat, liveIsNum := toFloat(live)
+
+	if desiredIsNum && liveIsNum {
+		return desiredFloat == liveFloat
+	}
+
+	desiredStr := fmt.Sprint(desired)
+	liveStr := fmt.Sprint(live)
+
+	if desiredStr == liveStr {
+		return true
+	}
+
+	desiredQty, err := resource.ParseQuantity(desiredStr)
+
+	if err != nil {
+		return false
+	}
+
+	liveQty, err := resource.ParseQuantity(liveStr)
+
+	if err != nil {
+		return false
+	}
+
+	return desiredQty.Cmp(liveQty) == 0
+}
+
+func toFloat(val interface{}) (float64, bool) {
+	switch v := val.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+
+	return 0, false
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
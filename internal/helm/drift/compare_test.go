@@ -0,0 +1,100 @@
+package drift_test
+
+import (
+	"testing"
+
+	"github.com/porter-dev/porter/internal/helm/drift"
+)
+
+func deployment(image string, replicas interface{}, env []interface{}, extra map[string]interface{}) map[string]interface{} {
+	container := map[string]interface{}{
+		"name":  "web",
+		"image": image,
+		"env":   env,
+		"resources": map[string]interface{}{
+			"requests": map[string]interface{}{
+				"cpu": "0.5",
+			},
+		},
+	}
+
+	for key, val := range extra {
+		container[key] = val
+	}
+
+	return map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":   "web",
+			"labels": map[string]interface{}{"app": "web"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{container},
+				},
+			},
+		},
+	}
+}
+
+func envVar(name, value string) map[string]interface{} {
+	return map[string]interface{}{"name": name, "value": value}
+}
+
+func TestCompareIgnoresDefaultedAndStatusFields(t *testing.T) {
+	desired := deployment("nginx:1.21", 2, []interface{}{envVar("PORT", "80")}, nil)
+
+	live := deployment("nginx:1.21", int64(2), []interface{}{envVar("PORT", "80")}, map[string]interface{}{
+		"imagePullPolicy":          "IfNotPresent",
+		"terminationMessagePolicy": "File",
+	})
+
+	live["status"] = map[string]interface{}{"readyReplicas": int64(2)}
+	live["metadata"].(map[string]interface{})["resourceVersion"] = "12345"
+	live["metadata"].(map[string]interface{})["annotations"] = map[string]interface{}{
+		"deployment.kubernetes.io/revision": "3",
+	}
+
+	// the API server normalizes resource quantities
+	live["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"].(map[string]interface{})["containers"].([]interface{})[0].(map[string]interface{})["resources"] = map[string]interface{}{
+		"requests": map[string]interface{}{
+			"cpu": "500m",
+		},
+	}
+
+	if diffs := drift.Compare(desired, live); len(diffs) != 0 {
+		t.Errorf("expected no diffs, got %v", diffs)
+	}
+}
+
+func TestCompareDetectsChangedFields(t *testing.T) {
+	desired := deployment("nginx:1.21", 2, []interface{}{envVar("PORT", "80")}, nil)
+	live := deployment("nginx:latest", int64(3), []interface{}{envVar("PORT", "80"), envVar("DEBUG", "true")}, nil)
+
+	diffs := drift.Compare(desired, live)
+
+	expPaths := []string{
+		"spec.replicas",
+		"spec.template.spec.containers[name=web].env[name=DEBUG]",
+		"spec.template.spec.containers[name=web].image",
+	}
+
+	if len(diffs) != len(expPaths) {
+		t.Fatalf("expected %d diffs, got %d: %v", len(expPaths), len(diffs), diffs)
+	}
+
+	found := make(map[string]bool)
+
+	for _, diff := range diffs {
+		found[diff.Path] = true
+	}
+
+	for _, path := range expPaths {
+		if !found[path] {
+			t.Errorf("expected diff at path %s", path)
+		}
+	}
+}
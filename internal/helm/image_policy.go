@@ -0,0 +1,89 @@
+package helm
+
+import (
+	"bytes"
+
+	"github.com/porter-dev/porter/internal/imagepolicy"
+)
+
+// ImagePolicyPostRenderer is a Helm post-renderer that rejects the release if any pod spec
+// contains an image which violates the image policy of the cluster. It does not modify the
+// manifests.
+type ImagePolicyPostRenderer struct {
+	Checker *imagepolicy.Checker
+}
+
+func (p *ImagePolicyPostRenderer) Run(
+	renderedManifests *bytes.Buffer,
+) (modifiedManifests *bytes.Buffer, err error) {
+	images, err := getManifestImages(bytes.NewBuffer(renderedManifests.Bytes()))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.Checker.Check(images); err != nil {
+		return nil, err
+	}
+
+	return renderedManifests, nil
+}
+
+// getManifestImages returns the images of every container and init container in the rendered
+// manifests, including the manifests stored in Porter manifest config maps
+func getManifestImages(renderedManifests *bytes.Buffer) ([]string, error) {
+	resources, err := decodeRenderedManifests(renderedManifests)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the docker secrets post-renderer already collects the pod specs of each resource
+	d := &DockerSecretsPostRenderer{
+		podSpecs: make([]resource, 0),
+	}
+
+	d.getPodSpecs(resources)
+
+	images := make([]string, 0)
+
+	for _, podSpec := range d.podSpecs {
+		images = append(images, d.getImageList(podSpec)...)
+
+		if initContainers, ok := podSpec["initContainers"]; ok {
+			images = append(images, d.getImageList(resource{"containers": initContainers})...)
+		}
+	}
+
+	for _, res := range resources {
+		if kind, _ := res["kind"].(string); kind != "ConfigMap" {
+			continue
+		}
+
+		labels := getNestedResource(res, "metadata", "labels")
+
+		if labels == nil {
+			continue
+		}
+
+		if isManifest, _ := labels["getporter.dev/manifest"].(string); isManifest != "true" {
+			continue
+		}
+
+		manifest, ok := getNestedResource(res, "data")["manifest"].(string)
+
+		if !ok {
+			continue
+		}
+
+		manifestImages, err := getManifestImages(bytes.NewBufferString(manifest))
+
+		if err != nil {
+			return nil, err
+		}
+
+		images = append(images, manifestImages...)
+	}
+
+	return images, nil
+}
@@ -10,6 +10,8 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/imagepolicy"
 	"github.com/porter-dev/porter/internal/kubernetes"
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/internal/repository"
@@ -21,6 +23,7 @@ import (
 )
 
 type PorterPostrenderer struct {
+	ImagePolicyPostRenderer         *ImagePolicyPostRenderer
 	DockerSecretsPostRenderer       *DockerSecretsPostRenderer
 	EnvironmentVariablePostrenderer *EnvironmentVariablePostrenderer
 }
@@ -32,10 +35,22 @@ func NewPorterPostrenderer(
 	namespace string,
 	regs []*models.Registry,
 	doAuth *oauth2.Config,
+	imagePolicy *types.ImagePolicy,
 ) (postrender.PostRenderer, error) {
+	var imagePolicyPostrenderer *ImagePolicyPostRenderer
 	var dockerSecretsPostrenderer *DockerSecretsPostRenderer
 	var err error
 
+	if imagePolicy != nil {
+		checker, err := imagepolicy.NewChecker(imagePolicy, regs, repo, doAuth)
+
+		if err != nil {
+			return nil, err
+		}
+
+		imagePolicyPostrenderer = &ImagePolicyPostRenderer{checker}
+	}
+
 	if cluster != nil && agent != nil && regs != nil && len(regs) > 0 {
 		dockerSecretsPostrenderer, err = NewDockerSecretsPostRenderer(cluster, repo, agent, namespace, regs, doAuth)
 
@@ -51,6 +66,7 @@ func NewPorterPostrenderer(
 	}
 
 	return &PorterPostrenderer{
+		ImagePolicyPostRenderer:         imagePolicyPostrenderer,
 		DockerSecretsPostRenderer:       dockerSecretsPostrenderer,
 		EnvironmentVariablePostrenderer: envVarPostrenderer,
 	}, nil
@@ -59,6 +75,15 @@ func NewPorterPostrenderer(
 func (p *PorterPostrenderer) Run(
 	renderedManifests *bytes.Buffer,
 ) (modifiedManifests *bytes.Buffer, err error) {
+	// the image policy is checked first, so that no pull secrets are created for a rejected release
+	if p.ImagePolicyPostRenderer != nil {
+		renderedManifests, err = p.ImagePolicyPostRenderer.Run(renderedManifests)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if p.DockerSecretsPostRenderer != nil {
 		renderedManifests, err = p.DockerSecretsPostRenderer.Run(renderedManifests)
 
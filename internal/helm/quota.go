@@ -0,0 +1,41 @@
+package helm
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/internal/kubernetes/quota"
+	"helm.sh/helm/v3/pkg/release"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// hasResourceQuota returns true if the namespace has the resource quota of its project
+func (a *Agent) hasResourceQuota(namespace string) (bool, error) {
+	_, err := a.K8sAgent.Clientset.CoreV1().ResourceQuotas(namespace).Get(
+		context.Background(),
+		quota.QuotaName,
+		metav1.GetOptions{},
+	)
+
+	if errors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// validateUpgradeQuota checks that the rendered manifest of the upgraded release fits in the
+// resource quota of the release namespace, so that upgrades which would exceed the quota are
+// rejected before any objects are changed
+func (a *Agent) validateUpgradeQuota(rel *release.Release, manifest string) error {
+	hasQuota, err := a.hasResourceQuota(rel.Namespace)
+
+	if err != nil || !hasQuota {
+		return err
+	}
+
+	return quota.ValidateUpgrade(a.K8sAgent.Clientset, rel.Namespace, rel.Manifest, manifest)
+}
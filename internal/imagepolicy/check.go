@@ -0,0 +1,313 @@
+package imagepolicy
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/registry"
+	"github.com/porter-dev/porter/internal/repository"
+	"golang.org/x/oauth2"
+)
+
+// cosignSignatureAnnotation is the annotation on each layer of a cosign signature image which
+// contains the base64-encoded signature of the layer
+const cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+
+// Checker checks the images of a release against an image policy
+type Checker struct {
+	policy      *types.ImagePolicy
+	registries  []*models.Registry
+	mutableTags map[string]bool
+	publicKey   crypto.PublicKey
+
+	// repo and doAuth are used to get the credentials of connected registries when reading
+	// signatures. If repo is nil, signatures are read anonymously.
+	repo   repository.Repository
+	doAuth *oauth2.Config
+}
+
+// NewChecker returns a Checker for the policy, where regs are the registries connected to
+// the project
+func NewChecker(
+	policy *types.ImagePolicy,
+	regs []*models.Registry,
+	repo repository.Repository,
+	doAuth *oauth2.Config,
+) (*Checker, error) {
+	if err := ValidatePolicy(policy); err != nil {
+		return nil, err
+	}
+
+	c := &Checker{
+		policy:      policy,
+		registries:  regs,
+		mutableTags: make(map[string]bool),
+		repo:        repo,
+		doAuth:      doAuth,
+	}
+
+	mutableTags := policy.MutableTags
+
+	if len(mutableTags) == 0 {
+		mutableTags = DefaultMutableTags
+	}
+
+	for _, tag := range mutableTags {
+		c.mutableTags[tag] = true
+	}
+
+	if policy.RequireSignature {
+		var err error
+
+		c.publicKey, err = ParsePublicKey(policy.PublicKey)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// Check checks each image against the policy, and returns a *ViolationError listing every
+// image which was rejected
+func (c *Checker) Check(images []string) error {
+	violations := make([]*types.ImagePolicyViolation, 0)
+	checked := make(map[string]bool)
+
+	for _, image := range images {
+		if checked[image] {
+			continue
+		}
+
+		checked[image] = true
+
+		violations = append(violations, c.checkImage(image)...)
+	}
+
+	if len(violations) > 0 {
+		return &ViolationError{violations}
+	}
+
+	return nil
+}
+
+func (c *Checker) checkImage(image string) []*types.ImagePolicyViolation {
+	res := make([]*types.ImagePolicyViolation, 0)
+
+	ref, err := name.ParseReference(image)
+
+	if err != nil {
+		// an image which cannot be parsed cannot be verified, so it is rejected by any policy
+		return append(res, &types.ImagePolicyViolation{
+			Image:   image,
+			Reason:  types.ImagePolicyViolationInvalidImage,
+			Message: fmt.Sprintf("could not parse image: %s", err.Error()),
+		})
+	}
+
+	reg := registry.FindRegistryForImage(c.registries, ref.Context().Name())
+
+	if c.policy.RequireConnectedRegistry && reg == nil {
+		res = append(res, &types.ImagePolicyViolation{
+			Image:   image,
+			Reason:  types.ImagePolicyViolationUnconnectedRegistry,
+			Message: fmt.Sprintf("registry %s is not connected to the project", ref.Context().RegistryStr()),
+		})
+	}
+
+	// images which are referenced by digest are immutable, so only tags are checked
+	if tag, ok := ref.(name.Tag); ok && c.policy.DisallowMutableTags && c.mutableTags[tag.TagStr()] {
+		res = append(res, &types.ImagePolicyViolation{
+			Image:   image,
+			Reason:  types.ImagePolicyViolationMutableTag,
+			Message: fmt.Sprintf("tag %q is mutable, use an immutable tag or a digest instead", tag.TagStr()),
+		})
+	}
+
+	if c.policy.RequireSignature {
+		if err := c.verifySignature(ref, reg); err != nil {
+			res = append(res, &types.ImagePolicyViolation{
+				Image:   image,
+				Reason:  types.ImagePolicyViolationInvalidSignature,
+				Message: err.Error(),
+			})
+		}
+	}
+
+	return res
+}
+
+// verifySignature checks that the image has a cosign signature which was created with the
+// private key of the policy, and which was created for the digest of the image
+func (c *Checker) verifySignature(ref name.Reference, reg *models.Registry) error {
+	auth, err := c.getAuth(reg, ref.Context().RegistryStr())
+
+	if err != nil {
+		return fmt.Errorf("could not get registry credentials: %w", err)
+	}
+
+	var digest string
+
+	if digestRef, ok := ref.(name.Digest); ok {
+		digest = digestRef.DigestStr()
+	} else {
+		desc, err := remote.Head(ref, remote.WithAuth(auth))
+
+		if err != nil {
+			return fmt.Errorf("could not resolve image digest: %w", err)
+		}
+
+		digest = desc.Digest.String()
+	}
+
+	sigRef := ref.Context().Tag(strings.Replace(digest, ":", "-", 1) + ".sig")
+
+	sigImage, err := remote.Image(sigRef, remote.WithAuth(auth))
+
+	if err != nil {
+		return fmt.Errorf("image is not signed")
+	}
+
+	manifest, err := sigImage.Manifest()
+
+	if err != nil {
+		return fmt.Errorf("could not read image signature: %w", err)
+	}
+
+	for _, desc := range manifest.Layers {
+		sig, ok := desc.Annotations[cosignSignatureAnnotation]
+
+		if !ok {
+			continue
+		}
+
+		layer, err := sigImage.LayerByDigest(desc.Digest)
+
+		if err != nil {
+			continue
+		}
+
+		rc, err := layer.Compressed()
+
+		if err != nil {
+			continue
+		}
+
+		payload, err := ioutil.ReadAll(rc)
+		rc.Close()
+
+		if err != nil {
+			continue
+		}
+
+		if err := verifyPayload(c.publicKey, payload, sig, digest); err == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("image does not have a signature which can be verified with the public key")
+}
+
+// simpleSigningPayload is the payload which cosign signs, which refers to the signed image
+// by digest
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+func verifyPayload(publicKey crypto.PublicKey, payload []byte, sig, digest string) error {
+	sigBytes, err := base64.StdEncoding.DecodeString(sig)
+
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.Sum256(payload)
+
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, hash[:], sigBytes) {
+			return fmt.Errorf("invalid signature")
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], sigBytes); err != nil {
+			return err
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, payload, sigBytes) {
+			return fmt.Errorf("invalid signature")
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+
+	// the signature is only valid for the image it was created for, which prevents a signature
+	// from being copied to a different image
+	simpleSigning := &simpleSigningPayload{}
+
+	if err := json.Unmarshal(payload, simpleSigning); err != nil {
+		return err
+	}
+
+	if simpleSigning.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Errorf("signature was created for a different image")
+	}
+
+	return nil
+}
+
+// getAuth returns the credentials of a connected registry from its docker config, or
+// anonymous credentials if the registry is not connected
+func (c *Checker) getAuth(reg *models.Registry, host string) (authn.Authenticator, error) {
+	if reg == nil || c.repo == nil {
+		return authn.Anonymous, nil
+	}
+
+	_reg := registry.Registry(*reg)
+
+	configBytes, err := _reg.GetDockerConfigJSON(c.repo, c.doAuth)
+
+	if err != nil {
+		return nil, err
+	}
+
+	config := &struct {
+		Auths map[string]authn.AuthConfig `json:"auths"`
+	}{}
+
+	if err := json.Unmarshal(configBytes, config); err != nil {
+		return nil, err
+	}
+
+	for server, auth := range config.Auths {
+		serverHost := server
+
+		if parsed, err := url.Parse(server); err == nil && parsed.Host != "" {
+			serverHost = parsed.Host
+		}
+
+		if serverHost == host || len(config.Auths) == 1 {
+			return authn.FromConfig(auth), nil
+		}
+	}
+
+	return authn.Anonymous, nil
+}
@@ -0,0 +1,187 @@
+package imagepolicy_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/imagepolicy"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+func TestCheckRegistryAndTags(t *testing.T) {
+	checker, err := imagepolicy.NewChecker(&types.ImagePolicy{
+		RequireConnectedRegistry: true,
+		DisallowMutableTags:      true,
+		MutableTags:              []string{"latest", "main"},
+	}, []*models.Registry{{URL: "https://registry.example.com/porter"}}, nil, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := checker.Check([]string{
+		"registry.example.com/porter/web:abc1234",
+		"registry.example.com/porter/web@sha256:" + strings.Repeat("a", 64),
+	}); err != nil {
+		t.Errorf("expected images to be allowed, got %v", err)
+	}
+
+	err = checker.Check([]string{
+		"nginx",
+		"registry.example.com/porter/web:main",
+		"registry.example.com/other/web:abc1234",
+	})
+
+	violationErr := &imagepolicy.ViolationError{}
+
+	if !errors.As(err, &violationErr) {
+		t.Fatalf("expected violation error, got %v", err)
+	}
+
+	expected := []types.ImagePolicyViolation{
+		{Image: "nginx", Reason: types.ImagePolicyViolationUnconnectedRegistry},
+		{Image: "nginx", Reason: types.ImagePolicyViolationMutableTag},
+		{Image: "registry.example.com/porter/web:main", Reason: types.ImagePolicyViolationMutableTag},
+		{Image: "registry.example.com/other/web:abc1234", Reason: types.ImagePolicyViolationUnconnectedRegistry},
+	}
+
+	if len(violationErr.Violations) != len(expected) {
+		t.Fatalf("expected %d violations, got %d: %v", len(expected), len(violationErr.Violations), err)
+	}
+
+	for i, violation := range violationErr.Violations {
+		if violation.Image != expected[i].Image || violation.Reason != expected[i].Reason {
+			t.Errorf("expected violation %v, got %v", expected[i], *violation)
+		}
+	}
+}
+
+func TestCheckSignature(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	pubKeyBytes, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	signedImage := pushImage(t, host+"/porter/web:v1")
+	unsignedImage := pushImage(t, host+"/porter/api:v1")
+
+	// sign the image, and copy its signature to the unsigned image, which must not be accepted
+	sig := signImage(t, key, signedImage)
+	attachSignature(t, signedImage, sig)
+	attachSignature(t, unsignedImage, sig)
+
+	checker, err := imagepolicy.NewChecker(&types.ImagePolicy{
+		RequireSignature: true,
+		PublicKey:        string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubKeyBytes})),
+	}, nil, nil, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := checker.Check([]string{host + "/porter/web:v1"}); err != nil {
+		t.Errorf("expected signed image to be allowed, got %v", err)
+	}
+
+	err = checker.Check([]string{host + "/porter/api:v1"})
+
+	violationErr := &imagepolicy.ViolationError{}
+
+	if !errors.As(err, &violationErr) || violationErr.Violations[0].Reason != types.ImagePolicyViolationInvalidSignature {
+		t.Errorf("expected invalid signature violation, got %v", err)
+	}
+}
+
+func pushImage(t *testing.T, image string) name.Digest {
+	ref, err := name.ParseReference(image)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	img, err := random.Image(256, 1)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := remote.Write(ref, img); err != nil {
+		t.Fatalf("could not push image: %v", err)
+	}
+
+	digest, err := img.Digest()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return ref.Context().Digest(digest.String())
+}
+
+// signImage returns a cosign simple signing payload for the image and its signature
+func signImage(t *testing.T, key *ecdsa.PrivateKey, image name.Digest) [2]string {
+	payload := fmt.Sprintf(
+		`{"critical":{"identity":{"docker-reference":"%s"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"},"optional":null}`,
+		image.Context().Name(),
+		image.DigestStr(),
+	)
+
+	hash := sha256.Sum256([]byte(payload))
+
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return [2]string{payload, base64.StdEncoding.EncodeToString(sig)}
+}
+
+func attachSignature(t *testing.T, image name.Digest, sig [2]string) {
+	sigImage, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer: static.NewLayer([]byte(sig[0]), "application/vnd.dev.cosign.simplesigning.v1+json"),
+		Annotations: map[string]string{
+			"dev.cosignproject.cosign/signature": sig[1],
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sigRef := image.Context().Tag(strings.Replace(image.DigestStr(), ":", "-", 1) + ".sig")
+
+	if err := remote.Write(sigRef, sigImage); err != nil {
+		t.Fatalf("could not push signature: %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package imagepolicy
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// DefaultMutableTags are the tags which are considered mutable if a policy does not set
+// its own list
+var DefaultMutableTags = []string{"latest"}
+
+// ViolationError is returned when a release contains images which are rejected by an
+// image policy
+type ViolationError struct {
+	Violations []*types.ImagePolicyViolation
+}
+
+func (e *ViolationError) Error() string {
+	msgs := make([]string, 0)
+
+	for _, violation := range e.Violations {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", violation.Image, violation.Message))
+	}
+
+	return fmt.Sprintf("release violates the image policy (%s)", strings.Join(msgs, ", "))
+}
+
+// ValidatePolicy checks that a policy which requires signatures has a valid public key
+func ValidatePolicy(policy *types.ImagePolicy) error {
+	if !policy.RequireSignature {
+		return nil
+	}
+
+	if policy.PublicKey == "" {
+		return fmt.Errorf("a public key is required to verify image signatures")
+	}
+
+	if _, err := ParsePublicKey(policy.PublicKey); err != nil {
+		return fmt.Errorf("invalid public key: %w", err)
+	}
+
+	return nil
+}
+
+// ParsePublicKey parses a PEM-encoded ECDSA, RSA or Ed25519 public key, such as a key
+// generated with "cosign generate-key-pair"
+func ParsePublicKey(publicKey string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(publicKey))
+
+	if block == nil {
+		return nil, fmt.Errorf("public key is not PEM-encoded")
+	}
+
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// GetPolicy returns the image policy which applies to a cluster, which is the policy of the
+// cluster if one is set, or otherwise the policy of the project. If the cluster id is 0, only
+// the project policy is read. The policy is nil if neither is set.
+func GetPolicy(repo repository.Repository, projectID, clusterID uint) (policy *types.ImagePolicy, inherited bool, err error) {
+	if clusterID != 0 {
+		model, err := repo.ImagePolicy().ReadImagePolicy(projectID, clusterID)
+
+		if err == nil {
+			return model.ToImagePolicyType(), false, nil
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, err
+		}
+	}
+
+	model, err := repo.ImagePolicy().ReadImagePolicy(projectID, 0)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+
+	return model.ToImagePolicyType(), clusterID != 0, nil
+}
+
+// SavePolicy creates or updates the image policy of a cluster, or of the project if the
+// cluster id is 0
+func SavePolicy(
+	repo repository.Repository,
+	projectID, clusterID uint,
+	policy *types.ImagePolicy,
+) (*models.ImagePolicy, error) {
+	model, err := repo.ImagePolicy().ReadImagePolicy(projectID, clusterID)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		model = &models.ImagePolicy{
+			ProjectID: projectID,
+			ClusterID: clusterID,
+		}
+
+		model.SetPolicy(policy)
+
+		return repo.ImagePolicy().CreateImagePolicy(model)
+	} else if err != nil {
+		return nil, err
+	}
+
+	model.SetPolicy(policy)
+
+	return repo.ImagePolicy().UpdateImagePolicy(model)
+}
@@ -0,0 +1,234 @@
+package incidents
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/helm"
+	porter_agent "github.com/porter-dev/porter/internal/kubernetes/porter_agent/v2"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// DeployEventWindow is the time before an incident started in which deploys of the release
+// are linked to the incident
+const DeployEventWindow = time.Hour
+
+// ErrIncidentResolved is returned when a resolved incident is acknowledged or resolved again
+var ErrIncidentResolved = errors.New("the incident is already resolved")
+
+// Open creates the record of an incident reported by the porter-agent. If the incident
+// already has a record, the latest message of the incident is added to its timeline, and the
+// incident is reopened if it was resolved.
+func Open(
+	repo repository.Repository,
+	cluster *models.Cluster,
+	namespace string,
+	agentIncident *porter_agent.Incident,
+) (*models.Incident, error) {
+	incident, err := repo.Incident().ReadIncidentByAgentID(cluster.ID, agentIncident.ID)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		incident, err = repo.Incident().CreateIncident(&models.Incident{
+			ProjectID:       cluster.ProjectID,
+			ClusterID:       cluster.ID,
+			AgentIncidentID: agentIncident.ID,
+			ReleaseName:     agentIncident.ReleaseName,
+			Namespace:       namespace,
+			Status:          types.IncidentStatusOpen,
+			Summary:         agentIncident.LatestMessage,
+			StartedAt:       time.Unix(agentIncident.CreatedAt, 0).UTC(),
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = appendNote(repo, incident, nil, types.IncidentNoteKindStatus, "Incident opened")
+
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	} else if incident.Status == types.IncidentStatusResolved {
+		incident.Status = types.IncidentStatusOpen
+		incident.ResolvedAt = nil
+
+		if _, err := repo.Incident().UpdateIncident(incident); err != nil {
+			return nil, err
+		}
+
+		if _, err := appendNote(repo, incident, nil, types.IncidentNoteKindStatus, "Incident reopened"); err != nil {
+			return nil, err
+		}
+	}
+
+	if agentIncident.LatestMessage != "" {
+		text := agentIncident.LatestMessage
+
+		if agentIncident.LatestReason != "" {
+			text = fmt.Sprintf("%s: %s", agentIncident.LatestReason, text)
+		}
+
+		if _, err := appendNote(repo, incident, nil, types.IncidentNoteKindAgent, text); err != nil {
+			return nil, err
+		}
+	}
+
+	return incident, nil
+}
+
+// Acknowledge marks an incident as acknowledged by a user, and assigns it to the assignee
+func Acknowledge(repo repository.Repository, incident *models.Incident, user, assignee *models.User) error {
+	if incident.Status == types.IncidentStatusResolved {
+		return ErrIncidentResolved
+	}
+
+	if incident.Status == types.IncidentStatusOpen {
+		now := time.Now().UTC()
+
+		incident.Status = types.IncidentStatusAcknowledged
+		incident.AcknowledgedAt = &now
+
+		if _, err := repo.Incident().UpdateIncident(incident); err != nil {
+			return err
+		}
+
+		if _, err := appendNote(repo, incident, user, types.IncidentNoteKindStatus, "Incident acknowledged"); err != nil {
+			return err
+		}
+	}
+
+	return Assign(repo, incident, user, assignee)
+}
+
+// Assign assigns an incident to a user
+func Assign(repo repository.Repository, incident *models.Incident, user, assignee *models.User) error {
+	if incident.AssigneeID == assignee.ID {
+		return nil
+	}
+
+	incident.AssigneeID = assignee.ID
+	incident.AssigneeEmail = assignee.Email
+
+	if _, err := repo.Incident().UpdateIncident(incident); err != nil {
+		return err
+	}
+
+	_, err := appendNote(
+		repo, incident, user,
+		types.IncidentNoteKindAssignment,
+		fmt.Sprintf("Incident assigned to %s", assignee.Email),
+	)
+
+	return err
+}
+
+// Resolve marks an incident as resolved. The user is nil if the porter-agent reported that the
+// incident was resolved.
+func Resolve(repo repository.Repository, incident *models.Incident, user *models.User, note string) error {
+	if incident.Status == types.IncidentStatusResolved {
+		return ErrIncidentResolved
+	}
+
+	now := time.Now().UTC()
+
+	incident.Status = types.IncidentStatusResolved
+	incident.ResolvedAt = &now
+
+	if _, err := repo.Incident().UpdateIncident(incident); err != nil {
+		return err
+	}
+
+	if _, err := appendNote(repo, incident, user, types.IncidentNoteKindStatus, "Incident resolved"); err != nil {
+		return err
+	}
+
+	if note != "" {
+		if _, err := AddNote(repo, incident, user, note); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddNote adds a note written by a user to the timeline of an incident
+func AddNote(repo repository.Repository, incident *models.Incident, user *models.User, text string) (*models.IncidentNote, error) {
+	return appendNote(repo, incident, user, types.IncidentNoteKindNote, text)
+}
+
+func appendNote(
+	repo repository.Repository,
+	incident *models.Incident,
+	user *models.User,
+	kind types.IncidentNoteKind,
+	text string,
+) (*models.IncidentNote, error) {
+	note := &models.IncidentNote{
+		Kind: kind,
+		Text: text,
+	}
+
+	if user != nil {
+		note.UserID = user.ID
+		note.UserEmail = user.Email
+	}
+
+	return repo.Incident().AppendIncidentNote(incident, note)
+}
+
+// GetDeployEvents returns the revisions of the release of an incident which were deployed
+// between DeployEventWindow before the incident started and the time it was resolved
+func GetDeployEvents(helmAgent *helm.Agent, incident *models.Incident) ([]*types.IncidentDeployEvent, error) {
+	history, err := helmAgent.GetReleaseHistory(incident.ReleaseName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	start := incident.StartedAt.Add(-DeployEventWindow)
+	end := time.Now()
+
+	if incident.ResolvedAt != nil {
+		end = *incident.ResolvedAt
+	}
+
+	res := make([]*types.IncidentDeployEvent, 0)
+
+	for _, rel := range history {
+		if rel.Info == nil {
+			continue
+		}
+
+		deployedAt := rel.Info.LastDeployed.Time
+
+		if deployedAt.Before(start) || deployedAt.After(end) {
+			continue
+		}
+
+		event := &types.IncidentDeployEvent{
+			Revision:    rel.Version,
+			Status:      rel.Info.Status.String(),
+			Description: rel.Info.Description,
+			DeployedAt:  deployedAt.UTC(),
+		}
+
+		if rel.Chart != nil && rel.Chart.Metadata != nil {
+			event.ChartVersion = rel.Chart.Metadata.Version
+		}
+
+		res = append(res, event)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].DeployedAt.Before(res[j].DeployedAt)
+	})
+
+	return res, nil
+}
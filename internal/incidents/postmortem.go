@@ -0,0 +1,99 @@
+package incidents
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+const postmortemTimeFormat = "2006-01-02 15:04:05 UTC"
+
+type timelineEntry struct {
+	time time.Time
+	text string
+}
+
+// GetPostmortem returns a markdown document with the details and the timeline of an incident,
+// including the deploys linked to the incident, to be used as the start of a postmortem
+func GetPostmortem(incident *models.Incident, deployEvents []*types.IncidentDeployEvent) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# Incident: %s in %s\n\n", incident.ReleaseName, incident.Namespace)
+
+	sb.WriteString("| | |\n|---|---|\n")
+	fmt.Fprintf(&sb, "| Status | %s |\n", incident.Status)
+	fmt.Fprintf(&sb, "| Started | %s |\n", formatTime(incident.StartedAt))
+
+	if incident.AcknowledgedAt != nil {
+		fmt.Fprintf(&sb, "| Acknowledged | %s |\n", formatTime(*incident.AcknowledgedAt))
+	}
+
+	if incident.ResolvedAt != nil {
+		fmt.Fprintf(&sb, "| Resolved | %s |\n", formatTime(*incident.ResolvedAt))
+		fmt.Fprintf(&sb, "| Duration | %s |\n", incident.ResolvedAt.Sub(incident.StartedAt).Round(time.Second))
+	}
+
+	if incident.AssigneeEmail != "" {
+		fmt.Fprintf(&sb, "| Assignee | %s |\n", incident.AssigneeEmail)
+	}
+
+	if incident.Summary != "" {
+		fmt.Fprintf(&sb, "\n## Summary\n\n```\n%s\n```\n", incident.Summary)
+	}
+
+	entries := make([]*timelineEntry, 0)
+
+	for _, note := range incident.Notes {
+		text := note.Text
+
+		switch note.Kind {
+		case types.IncidentNoteKindNote:
+			text = fmt.Sprintf("Note: %s", text)
+		case types.IncidentNoteKindAgent:
+			text = fmt.Sprintf("Reported by the porter-agent: %s", text)
+		}
+
+		if note.UserEmail != "" {
+			text = fmt.Sprintf("%s (%s)", text, note.UserEmail)
+		}
+
+		entries = append(entries, &timelineEntry{note.CreatedAt, text})
+	}
+
+	for _, event := range deployEvents {
+		text := fmt.Sprintf("Revision %d deployed (%s)", event.Revision, event.Status)
+
+		if event.Description != "" {
+			text = fmt.Sprintf("%s: %s", text, event.Description)
+		}
+
+		entries = append(entries, &timelineEntry{event.DeployedAt, text})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].time.Before(entries[j].time)
+	})
+
+	sb.WriteString("\n## Timeline\n\n")
+
+	for _, entry := range entries {
+		// indent multi-line entries so that they stay in the list item
+		text := strings.ReplaceAll(strings.TrimSpace(entry.text), "\n", "\n  ")
+
+		fmt.Fprintf(&sb, "- **%s** %s\n", formatTime(entry.time), text)
+	}
+
+	if len(deployEvents) == 0 {
+		fmt.Fprintf(&sb, "\nNo revisions of %s were deployed around the time of the incident.\n", incident.ReleaseName)
+	}
+
+	return sb.String()
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(postmortemTimeFormat)
+}
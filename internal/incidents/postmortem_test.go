@@ -0,0 +1,51 @@
+package incidents
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+func TestGetPostmortem(t *testing.T) {
+	started := time.Date(2022, 5, 1, 10, 0, 0, 0, time.UTC)
+	resolved := started.Add(90 * time.Minute)
+
+	incident := &models.Incident{
+		ReleaseName:   "web",
+		Namespace:     "default",
+		Status:        types.IncidentStatusResolved,
+		Summary:       "container web exited with code 1",
+		AssigneeEmail: "oncall@example.com",
+		StartedAt:     started,
+		ResolvedAt:    &resolved,
+		Notes: []models.IncidentNote{
+			{Kind: types.IncidentNoteKindStatus, Text: "Incident opened"},
+			{Kind: types.IncidentNoteKindNote, Text: "rolling back\nto revision 4", UserEmail: "oncall@example.com"},
+		},
+	}
+
+	incident.Notes[0].CreatedAt = started
+	incident.Notes[1].CreatedAt = started.Add(30 * time.Minute)
+
+	res := GetPostmortem(incident, []*types.IncidentDeployEvent{
+		{Revision: 5, Status: "superseded", DeployedAt: started.Add(-10 * time.Minute)},
+	})
+
+	expected := []string{
+		"# Incident: web in default",
+		"| Duration | 1h30m0s |",
+		"| Assignee | oncall@example.com |",
+		"- **2022-05-01 09:50:00 UTC** Revision 5 deployed (superseded)\n" +
+			"- **2022-05-01 10:00:00 UTC** Incident opened\n" +
+			"- **2022-05-01 10:30:00 UTC** Note: rolling back\n  to revision 4 (oncall@example.com)\n",
+	}
+
+	for _, exp := range expected {
+		if !strings.Contains(res, exp) {
+			t.Errorf("expected postmortem to contain %q, got:\n%s", exp, res)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package buildpacks
+
+import (
+	"bufio"
+	"bytes"
+	"path"
+	"regexp"
+	"sync"
+)
+
+var procfileRegex = regexp.MustCompile("^([A-Za-z0-9_]+):\\s*(.+)$")
+
+// NewBuilderInfoMap returns the builders which runtimes are detected for, keyed by
+// PaketoBuilder and HerokuBuilder
+func NewBuilderInfoMap() map[string]*BuilderInfo {
+	builders := make(map[string]*BuilderInfo)
+	builders[PaketoBuilder] = &BuilderInfo{
+		Name: "Paketo",
+		Builders: []string{
+			"paketobuildpacks/builder:full",
+		},
+	}
+	builders[HerokuBuilder] = &BuilderInfo{
+		Name: "Heroku",
+		Builders: []string{
+			"heroku/buildpacks:20",
+			"heroku/buildpacks:18",
+		},
+	}
+	return builders
+}
+
+// Detect runs every runtime in Runtimes against a directory of the file system, and returns
+// the detected and other buildpacks for each builder
+func Detect(fileSystem FileSystem, dir string) (map[string]*BuilderInfo, error) {
+	directoryContents, err := fileSystem.ReadDir(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	builderInfoMap := NewBuilderInfoMap()
+	paketo := builderInfoMap[PaketoBuilder]
+	heroku := builderInfoMap[HerokuBuilder]
+
+	// runtimes append to the same builders, so results are collected per runtime and merged
+	// once all runtimes have finished
+	paketoResults := make([]*BuilderInfo, len(Runtimes))
+	herokuResults := make([]*BuilderInfo, len(Runtimes))
+
+	var wg sync.WaitGroup
+	wg.Add(len(Runtimes))
+
+	for i := range Runtimes {
+		paketoResults[i] = &BuilderInfo{}
+		herokuResults[i] = &BuilderInfo{}
+
+		go func(idx int) {
+			defer wg.Done()
+
+			// a panic in one runtime should not prevent the others from being detected
+			defer func() {
+				recover()
+			}()
+
+			Runtimes[idx].Detect(fileSystem, directoryContents, dir, paketoResults[idx], herokuResults[idx])
+		}(i)
+	}
+
+	wg.Wait()
+
+	for i := range Runtimes {
+		paketo.Detected = append(paketo.Detected, paketoResults[i].Detected...)
+		paketo.Others = append(paketo.Others, paketoResults[i].Others...)
+		heroku.Detected = append(heroku.Detected, herokuResults[i].Detected...)
+		heroku.Others = append(heroku.Others, herokuResults[i].Others...)
+	}
+
+	return builderInfoMap, nil
+}
+
+// GetStartCommand returns the command which the detected buildpacks start the application
+// with: the web process in a Procfile, or the start script in a package.json. It returns an
+// empty string if the start command is left to the buildpacks.
+func GetStartCommand(fileSystem FileSystem, dir string, detected []BuildpackInfo) string {
+	if procfile, err := fileSystem.ReadFile(path.Join(dir, "Procfile")); err == nil {
+		scanner := bufio.NewScanner(bytes.NewReader(procfile))
+
+		for scanner.Scan() {
+			if matches := procfileRegex.FindStringSubmatch(scanner.Text()); matches != nil && matches[1] == "web" {
+				return matches[2]
+			}
+		}
+	}
+
+	for _, info := range detected {
+		if scripts, ok := info.Config["scripts"].(map[string]string); ok && scripts["start"] != "" {
+			return "npm start"
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,130 @@
+package buildpacks
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"path"
+	"strings"
+)
+
+type dotnetRuntime struct{}
+
+func NewDotnetRuntime() Runtime {
+	return &dotnetRuntime{}
+}
+
+type dotnetProjectFile struct {
+	PropertyGroups []struct {
+		TargetFramework  string `xml:"TargetFramework"`
+		TargetFrameworks string `xml:"TargetFrameworks"`
+		AssemblyName     string `xml:"AssemblyName"`
+	} `xml:"PropertyGroup"`
+}
+
+func isDotnetProjectFile(name string) bool {
+	return strings.HasSuffix(name, ".csproj") || strings.HasSuffix(name, ".fsproj") || strings.HasSuffix(name, ".vbproj")
+}
+
+func (runtime *dotnetRuntime) detectTargetFramework(fileSystem FileSystem, dir, projectFile string) string {
+	data, err := fileSystem.ReadFile(path.Join(dir, projectFile))
+	if err != nil {
+		return ""
+	}
+
+	project := &dotnetProjectFile{}
+	if err := xml.Unmarshal(data, project); err != nil {
+		return ""
+	}
+
+	for _, group := range project.PropertyGroups {
+		if group.TargetFramework != "" {
+			return strings.TrimSpace(group.TargetFramework)
+		} else if group.TargetFrameworks != "" {
+			// multi-targeted projects are run with the first framework
+			return strings.TrimSpace(strings.Split(group.TargetFrameworks, ";")[0])
+		}
+	}
+
+	return ""
+}
+
+func (runtime *dotnetRuntime) detectSDKVersion(fileSystem FileSystem, dir string) string {
+	data, err := fileSystem.ReadFile(path.Join(dir, "global.json"))
+	if err != nil {
+		return ""
+	}
+
+	var globalJSON struct {
+		SDK struct {
+			Version string `json:"version"`
+		} `json:"sdk"`
+	}
+
+	if err := json.Unmarshal(data, &globalJSON); err != nil {
+		return ""
+	}
+
+	return globalJSON.SDK.Version
+}
+
+func (runtime *dotnetRuntime) Detect(
+	fileSystem FileSystem,
+	directoryContent []DirEntry,
+	dir string,
+	paketo, heroku *BuilderInfo,
+) error {
+	projectFile := ""
+	solutionFound := false
+	globalJSONFound := false
+	for i := range directoryContent {
+		name := directoryContent[i].Name
+		if isDotnetProjectFile(name) && projectFile == "" {
+			projectFile = name
+		} else if strings.HasSuffix(name, ".sln") {
+			solutionFound = true
+		} else if name == "global.json" {
+			globalJSONFound = true
+		}
+	}
+
+	paketoBuildpackInfo := BuildpackInfo{
+		Name:      ".NET",
+		Buildpack: "gcr.io/paketo-buildpacks/dotnet-core",
+	}
+	// there is no official .NET buildpack for heroku, so use the most widely used
+	// community buildpack from the buildpack registry
+	herokuBuildpackInfo := BuildpackInfo{
+		Name:      ".NET",
+		Buildpack: "jincod/dotnetcore",
+	}
+
+	if projectFile == "" && !solutionFound {
+		paketo.Others = append(paketo.Others, paketoBuildpackInfo)
+		heroku.Others = append(heroku.Others, herokuBuildpackInfo)
+		return nil
+	}
+
+	config := map[string]interface{}{}
+
+	if projectFile != "" {
+		config["project_file"] = projectFile
+
+		if targetFramework := runtime.detectTargetFramework(fileSystem, dir, projectFile); targetFramework != "" {
+			config["target_framework"] = targetFramework
+		}
+	}
+
+	if globalJSONFound {
+		if sdkVersion := runtime.detectSDKVersion(fileSystem, dir); sdkVersion != "" {
+			config["sdk_version"] = sdkVersion
+		}
+	}
+
+	paketoBuildpackInfo.Config = config
+	paketo.Detected = append(paketo.Detected, paketoBuildpackInfo)
+
+	herokuBuildpackInfo.Config = config
+	heroku.Detected = append(heroku.Detected, herokuBuildpackInfo)
+
+	return nil
+}
@@ -0,0 +1,289 @@
+package buildpacks
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v41/github"
+)
+
+// FileSystem is a source tree which runtimes are detected on. Paths are slash-separated
+// and relative to the root of the source tree.
+type FileSystem interface {
+	ReadDir(dir string) ([]DirEntry, error)
+	ReadFile(name string) ([]byte, error)
+}
+
+// DirEntry is a single entry of a directory listing
+type DirEntry struct {
+	Name  string
+	IsDir bool
+}
+
+type githubFileSystem struct {
+	client      *github.Client
+	owner, name string
+	opts        github.RepositoryContentGetOptions
+}
+
+// NewGithubFileSystem returns a file system which reads the contents of a GitHub repository
+// at the given SHA, branch or tag through the GitHub API
+func NewGithubFileSystem(client *github.Client, owner, name, ref string) FileSystem {
+	return &githubFileSystem{
+		client: client,
+		owner:  owner,
+		name:   name,
+		opts: github.RepositoryContentGetOptions{
+			Ref: ref,
+		},
+	}
+}
+
+func (g *githubFileSystem) ReadDir(dir string) ([]DirEntry, error) {
+	_, directoryContents, _, err := g.client.Repositories.GetContents(
+		context.Background(), g.owner, g.name, dir, &g.opts,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]DirEntry, 0, len(directoryContents))
+
+	for _, content := range directoryContents {
+		res = append(res, DirEntry{
+			Name:  content.GetName(),
+			IsDir: content.GetType() == "dir",
+		})
+	}
+
+	return res, nil
+}
+
+func (g *githubFileSystem) ReadFile(name string) ([]byte, error) {
+	fileContent, _, _, err := g.client.Repositories.GetContents(
+		context.Background(), g.owner, g.name, name, &g.opts,
+	)
+
+	if err != nil {
+		return nil, err
+	} else if fileContent == nil {
+		return nil, fmt.Errorf("%s is a directory", name)
+	}
+
+	data, err := fileContent.GetContent()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(data), nil
+}
+
+type localFileSystem struct {
+	root string
+}
+
+// NewLocalFileSystem returns a file system which reads from a local directory
+func NewLocalFileSystem(root string) FileSystem {
+	return &localFileSystem{root}
+}
+
+func (l *localFileSystem) ReadDir(dir string) ([]DirEntry, error) {
+	entries, err := os.ReadDir(l.abs(dir))
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]DirEntry, 0, len(entries))
+
+	for _, entry := range entries {
+		res = append(res, DirEntry{
+			Name:  entry.Name(),
+			IsDir: entry.IsDir(),
+		})
+	}
+
+	return res, nil
+}
+
+func (l *localFileSystem) ReadFile(name string) ([]byte, error) {
+	return ioutil.ReadFile(l.abs(name))
+}
+
+func (l *localFileSystem) abs(name string) string {
+	return filepath.Join(l.root, filepath.FromSlash(cleanPath(name)))
+}
+
+type tarballFileSystem struct {
+	files map[string][]byte
+	dirs  map[string][]DirEntry
+}
+
+// NewTarballFileSystem reads a tar archive, which may be gzip-compressed, into memory. If every
+// file in the archive is nested under a single top-level directory, as in the archives that
+// GitHub and GitLab generate for a commit, that directory is treated as the root.
+func NewTarballFileSystem(r io.Reader) (FileSystem, error) {
+	br := bufio.NewReader(r)
+
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gzr, err := gzip.NewReader(br)
+
+		if err != nil {
+			return nil, err
+		}
+
+		defer gzr.Close()
+
+		r = gzr
+	} else {
+		r = br
+	}
+
+	files := make(map[string][]byte)
+	dirs := make(map[string]bool)
+
+	tr := tar.NewReader(r)
+
+	for {
+		header, err := tr.Next()
+
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("error reading tarball: %w", err)
+		}
+
+		name := cleanPath(header.Name)
+
+		if name == "" {
+			continue
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			dirs[name] = true
+		case tar.TypeReg:
+			data, err := ioutil.ReadAll(tr)
+
+			if err != nil {
+				return nil, fmt.Errorf("error reading %s from tarball: %w", header.Name, err)
+			}
+
+			files[name] = data
+		}
+	}
+
+	prefix := commonRootDir(files)
+
+	t := &tarballFileSystem{
+		files: make(map[string][]byte),
+		dirs:  map[string][]DirEntry{"": {}},
+	}
+
+	for name, data := range files {
+		t.files[strings.TrimPrefix(name, prefix)] = data
+	}
+
+	for name := range dirs {
+		if name = strings.TrimPrefix(name+"/", prefix); name != "" {
+			t.addEntry(strings.TrimSuffix(name, "/"), true)
+		}
+	}
+
+	for name := range t.files {
+		t.addEntry(name, false)
+	}
+
+	for dir := range t.dirs {
+		sort.Slice(t.dirs[dir], func(i, j int) bool {
+			return t.dirs[dir][i].Name < t.dirs[dir][j].Name
+		})
+	}
+
+	return t, nil
+}
+
+func (t *tarballFileSystem) ReadDir(dir string) ([]DirEntry, error) {
+	entries, ok := t.dirs[cleanPath(dir)]
+
+	if !ok {
+		return nil, &fs.PathError{Op: "readdir", Path: dir, Err: fs.ErrNotExist}
+	}
+
+	return entries, nil
+}
+
+func (t *tarballFileSystem) ReadFile(name string) ([]byte, error) {
+	data, ok := t.files[cleanPath(name)]
+
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+
+	return data, nil
+}
+
+// addEntry adds an entry to the listing of its parent directory, creating any parent
+// directories which are missing from the archive
+func (t *tarballFileSystem) addEntry(name string, isDir bool) {
+	if isDir {
+		if _, exists := t.dirs[name]; exists {
+			return
+		}
+
+		t.dirs[name] = []DirEntry{}
+	}
+
+	parent, base := path.Split(name)
+	parent = strings.TrimSuffix(parent, "/")
+
+	if parent != "" {
+		t.addEntry(parent, true)
+	}
+
+	t.dirs[parent] = append(t.dirs[parent], DirEntry{
+		Name:  base,
+		IsDir: isDir,
+	})
+}
+
+// commonRootDir returns the top-level directory, including a trailing slash, which every
+// file is nested under, or an empty string if there is no such directory
+func commonRootDir(files map[string][]byte) string {
+	prefix := ""
+
+	for name := range files {
+		i := strings.Index(name, "/")
+
+		if i == -1 {
+			return ""
+		}
+
+		if prefix == "" {
+			prefix = name[:i+1]
+		} else if prefix != name[:i+1] {
+			return ""
+		}
+	}
+
+	return prefix
+}
+
+// cleanPath normalizes a slash-separated path relative to the root of a file system, so
+// that "", "." and "/" all refer to the root
+func cleanPath(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+name), "/")
+}
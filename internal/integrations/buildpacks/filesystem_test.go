@@ -0,0 +1,75 @@
+package buildpacks
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"reflect"
+	"testing"
+)
+
+func TestTarballFileSystem(t *testing.T) {
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+
+	files := map[string]string{
+		"owner-repo-abc123/package.json":     `{"scripts":{"start":"node index.js"}}`,
+		"owner-repo-abc123/web/Procfile":     "web: node server.js\n",
+		"owner-repo-abc123/web/src/index.js": "",
+	}
+
+	for _, name := range []string{"owner-repo-abc123/package.json", "owner-repo-abc123/web/Procfile", "owner-repo-abc123/web/src/index.js"} {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Typeflag: tar.TypeReg,
+			Mode:     0644,
+			Size:     int64(len(files[name])),
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		tw.Write([]byte(files[name]))
+	}
+
+	tw.Close()
+	gzw.Close()
+
+	fileSystem, err := NewTarballFileSystem(buf)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	root, err := fileSystem.ReadDir("/")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expRoot := []DirEntry{{Name: "package.json"}, {Name: "web", IsDir: true}}
+
+	if !reflect.DeepEqual(root, expRoot) {
+		t.Errorf("expected root %v, got %v", expRoot, root)
+	}
+
+	web, err := fileSystem.ReadDir("web")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expWeb := []DirEntry{{Name: "Procfile"}, {Name: "src", IsDir: true}}
+
+	if !reflect.DeepEqual(web, expWeb) {
+		t.Errorf("expected web %v, got %v", expWeb, web)
+	}
+
+	if cmd := GetStartCommand(fileSystem, "web", nil); cmd != "node server.js" {
+		t.Errorf("expected start command from Procfile, got %q", cmd)
+	}
+
+	if _, err := fileSystem.ReadFile("missing.txt"); err == nil {
+		t.Errorf("expected error reading missing file")
+	}
+}
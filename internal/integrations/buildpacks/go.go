@@ -2,8 +2,6 @@ package buildpacks
 
 import (
 	"sync"
-
-	"github.com/google/go-github/v41/github"
 )
 
 type goRuntime struct {
@@ -17,10 +15,10 @@ func NewGoRuntime() Runtime {
 func (runtime *goRuntime) detectMod(results chan struct {
 	string
 	bool
-}, directoryContent []*github.RepositoryContent) {
+}, directoryContent []DirEntry) {
 	goModFound := false
 	for i := 0; i < len(directoryContent); i++ {
-		name := directoryContent[i].GetName()
+		name := directoryContent[i].Name
 		if name == "go.mod" {
 			goModFound = true
 			break
@@ -38,14 +36,14 @@ func (runtime *goRuntime) detectMod(results chan struct {
 func (runtime *goRuntime) detectDep(results chan struct {
 	string
 	bool
-}, directoryContent []*github.RepositoryContent) {
+}, directoryContent []DirEntry) {
 	gopkgFound := false
 	vendorFound := false
 	for i := 0; i < len(directoryContent); i++ {
-		name := directoryContent[i].GetName()
+		name := directoryContent[i].Name
 		if name == "Gopkg.toml" {
 			gopkgFound = true
-		} else if name == "vendor" && directoryContent[i].GetType() == "dir" {
+		} else if name == "vendor" && directoryContent[i].IsDir {
 			vendorFound = true
 		}
 		if gopkgFound && vendorFound {
@@ -62,10 +60,9 @@ func (runtime *goRuntime) detectDep(results chan struct {
 }
 
 func (runtime *goRuntime) Detect(
-	client *github.Client,
-	directoryContent []*github.RepositoryContent,
-	owner, name, path string,
-	repoContentOptions github.RepositoryContentGetOptions,
+	fileSystem FileSystem,
+	directoryContent []DirEntry,
+	dir string,
 	paketo, heroku *BuilderInfo,
 ) error {
 	results := make(chan struct {
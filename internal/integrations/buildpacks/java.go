@@ -0,0 +1,178 @@
+package buildpacks
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// the default JVM version of https://github.com/paketo-buildpacks/bellsoft-liberica
+const defaultJVMVersion = "11"
+
+var (
+	gradleSourceCompatibilityRe = regexp.MustCompile(`sourceCompatibility\s*=?\s*(?:JavaVersion\.VERSION_)?['"]?(1\.)?(\d+)`)
+	gradleToolchainRe           = regexp.MustCompile(`JavaLanguageVersion\.of\(\s*(\d+)\s*\)`)
+)
+
+type javaRuntime struct{}
+
+func NewJavaRuntime() Runtime {
+	return &javaRuntime{}
+}
+
+type pomXML struct {
+	Properties struct {
+		JavaVersion          string `xml:"java.version"`
+		MavenCompilerRelease string `xml:"maven.compiler.release"`
+		MavenCompilerSource  string `xml:"maven.compiler.source"`
+		MavenCompilerTarget  string `xml:"maven.compiler.target"`
+	} `xml:"properties"`
+}
+
+// parseJVMVersion normalizes Java versions such as "1.8" and "17.0.2" to the major version
+func parseJVMVersion(version string) string {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "1.")
+
+	if i := strings.Index(version, "."); i != -1 {
+		version = version[:i]
+	}
+
+	return version
+}
+
+func (runtime *javaRuntime) detectMavenJVMVersion(fileSystem FileSystem, dir string) string {
+	data, err := fileSystem.ReadFile(path.Join(dir, "pom.xml"))
+	if err != nil {
+		return ""
+	}
+
+	pom := &pomXML{}
+	if err := xml.Unmarshal(data, pom); err != nil {
+		return ""
+	}
+
+	for _, version := range []string{
+		pom.Properties.MavenCompilerRelease,
+		pom.Properties.JavaVersion,
+		pom.Properties.MavenCompilerSource,
+		pom.Properties.MavenCompilerTarget,
+	} {
+		// versions which reference other properties can't be resolved without maven
+		if version != "" && !strings.Contains(version, "${") {
+			return parseJVMVersion(version)
+		}
+	}
+
+	return ""
+}
+
+func (runtime *javaRuntime) detectGradleJVMVersion(fileSystem FileSystem, dir, buildFile string) string {
+	data, err := fileSystem.ReadFile(path.Join(dir, buildFile))
+	if err != nil {
+		return ""
+	}
+
+	if matches := gradleToolchainRe.FindSubmatch(data); matches != nil {
+		return string(matches[1])
+	}
+
+	if matches := gradleSourceCompatibilityRe.FindSubmatch(data); matches != nil {
+		return string(matches[2])
+	}
+
+	return ""
+}
+
+// detectSystemPropertiesJVMVersion reads the JVM version from the system.properties file
+// used by https://github.com/heroku/heroku-buildpack-jvm-common
+func (runtime *javaRuntime) detectSystemPropertiesJVMVersion(fileSystem FileSystem, dir string) string {
+	data, err := fileSystem.ReadFile(path.Join(dir, "system.properties"))
+	if err != nil {
+		return ""
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		keyVal := strings.SplitN(scanner.Text(), "=", 2)
+
+		if len(keyVal) == 2 && strings.TrimSpace(keyVal[0]) == "java.runtime.version" {
+			return parseJVMVersion(keyVal[1])
+		}
+	}
+
+	return ""
+}
+
+func (runtime *javaRuntime) Detect(
+	fileSystem FileSystem,
+	directoryContent []DirEntry,
+	dir string,
+	paketo, heroku *BuilderInfo,
+) error {
+	pomFound := false
+	gradleBuildFile := ""
+	systemPropertiesFound := false
+	for i := range directoryContent {
+		name := directoryContent[i].Name
+		if name == "pom.xml" {
+			pomFound = true
+		} else if name == "build.gradle" || name == "build.gradle.kts" {
+			gradleBuildFile = name
+		} else if name == "system.properties" {
+			systemPropertiesFound = true
+		}
+	}
+
+	paketoBuildpackInfo := BuildpackInfo{
+		Name:      "Java",
+		Buildpack: "gcr.io/paketo-buildpacks/java",
+	}
+	herokuBuildpackInfo := BuildpackInfo{
+		Name:      "Java",
+		Buildpack: "heroku/java",
+	}
+
+	if !pomFound && gradleBuildFile == "" {
+		paketo.Others = append(paketo.Others, paketoBuildpackInfo)
+		heroku.Others = append(heroku.Others, herokuBuildpackInfo)
+		return nil
+	}
+
+	buildTool := maven
+	jvmVersion := ""
+
+	if pomFound {
+		jvmVersion = runtime.detectMavenJVMVersion(fileSystem, dir)
+	} else {
+		buildTool = gradle
+		jvmVersion = runtime.detectGradleJVMVersion(fileSystem, dir, gradleBuildFile)
+
+		// gradle projects are built by a separate buildpack on heroku
+		herokuBuildpackInfo.Buildpack = "heroku/gradle"
+	}
+
+	if jvmVersion == "" && systemPropertiesFound {
+		jvmVersion = runtime.detectSystemPropertiesJVMVersion(fileSystem, dir)
+	}
+
+	if jvmVersion == "" {
+		jvmVersion = defaultJVMVersion
+	}
+
+	paketoBuildpackInfo.Config = map[string]interface{}{
+		"build_tool":  buildTool,
+		"jvm_version": jvmVersion,
+	}
+	paketo.Detected = append(paketo.Detected, paketoBuildpackInfo)
+
+	herokuBuildpackInfo.Config = map[string]interface{}{
+		"build_tool":  buildTool,
+		"jvm_version": jvmVersion,
+	}
+	heroku.Detected = append(heroku.Detected, herokuBuildpackInfo)
+
+	return nil
+}
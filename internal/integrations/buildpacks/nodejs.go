@@ -1,14 +1,13 @@
 package buildpacks
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"strings"
 	"sync"
 
 	"github.com/Masterminds/semver/v3"
-	"github.com/google/go-github/v41/github"
 )
 
 var (
@@ -31,11 +30,11 @@ func NewNodeRuntime() Runtime {
 func (runtime *nodejsRuntime) detectYarn(results chan struct {
 	string
 	bool
-}, directoryContent []*github.RepositoryContent) {
+}, directoryContent []DirEntry) {
 	yarnLockFound := false
 	packageJSONFound := false
 	for i := 0; i < len(directoryContent); i++ {
-		name := directoryContent[i].GetName()
+		name := directoryContent[i].Name
 		if name == "yarn.lock" {
 			yarnLockFound = true
 		} else if name == "package.json" {
@@ -57,10 +56,10 @@ func (runtime *nodejsRuntime) detectYarn(results chan struct {
 func (runtime *nodejsRuntime) detectNPM(results chan struct {
 	string
 	bool
-}, directoryContent []*github.RepositoryContent) {
+}, directoryContent []DirEntry) {
 	packageJSONFound := false
 	for i := 0; i < len(directoryContent); i++ {
-		name := directoryContent[i].GetName()
+		name := directoryContent[i].Name
 		if name == "package.json" {
 			packageJSONFound = true
 			break
@@ -78,10 +77,10 @@ func (runtime *nodejsRuntime) detectNPM(results chan struct {
 func (runtime *nodejsRuntime) detectStandalone(results chan struct {
 	string
 	bool
-}, directoryContent []*github.RepositoryContent) {
+}, directoryContent []DirEntry) {
 	jsFileFound := false
 	for i := 0; i < len(directoryContent); i++ {
-		name := directoryContent[i].GetName()
+		name := directoryContent[i].Name
 		if name == "server.js" || name == "app.js" || name == "main.js" || name == "index.js" {
 			jsFileFound = true
 			break
@@ -158,10 +157,9 @@ func validateNodeVersion(content string) (string, error) {
 }
 
 func (runtime *nodejsRuntime) Detect(
-	client *github.Client,
-	directoryContent []*github.RepositoryContent,
-	owner, name, path string,
-	repoContentOptions github.RepositoryContentGetOptions,
+	fileSystem FileSystem,
+	directoryContent []DirEntry,
+	dir string,
 	paketo, heroku *BuilderInfo,
 ) error {
 	results := make(chan struct {
@@ -206,13 +204,7 @@ func (runtime *nodejsRuntime) Detect(
 
 	if foundYarn || foundNPM {
 		// it is safe to assume that the project contains a package.json
-		fileContent, _, _, err := client.Repositories.GetContents(
-			context.Background(),
-			owner,
-			name,
-			fmt.Sprintf("%s/package.json", path),
-			&repoContentOptions,
-		)
+		fileContent, err := fileSystem.ReadFile(path.Join(dir, "package.json"))
 		if err != nil {
 			paketo.Others = append(paketo.Others, paketoBuildpackInfo)
 			heroku.Others = append(heroku.Others, herokuBuildpackInfo)
@@ -225,13 +217,7 @@ func (runtime *nodejsRuntime) Detect(
 			} `json:"engines"`
 		}
 
-		data, err := fileContent.GetContent()
-		if err != nil {
-			paketo.Others = append(paketo.Others, paketoBuildpackInfo)
-			heroku.Others = append(heroku.Others, herokuBuildpackInfo)
-			return fmt.Errorf("error calling GetContent() on package.json: %v", err)
-		}
-		err = json.NewDecoder(strings.NewReader(data)).Decode(&packageJSON)
+		err = json.Unmarshal(fileContent, &packageJSON)
 		if err != nil {
 			paketo.Others = append(paketo.Others, paketoBuildpackInfo)
 			heroku.Others = append(heroku.Others, herokuBuildpackInfo)
@@ -243,7 +229,7 @@ func (runtime *nodejsRuntime) Detect(
 			nvmrcFound := false
 			nodeVersionFound := false
 			for i := 0; i < len(directoryContent); i++ {
-				name := directoryContent[i].GetName()
+				name := directoryContent[i].Name
 				if name == ".nvmrc" {
 					nvmrcFound = true
 				} else if name == ".node-version" {
@@ -253,25 +239,13 @@ func (runtime *nodejsRuntime) Detect(
 
 			if nvmrcFound {
 				// copy exact behavior of https://github.com/paketo-buildpacks/node-engine/blob/main/nvmrc_parser.go
-				fileContent, _, _, err = client.Repositories.GetContents(
-					context.Background(),
-					owner,
-					name,
-					fmt.Sprintf("%s/.nvmrc", path),
-					&repoContentOptions,
-				)
+				fileContent, err = fileSystem.ReadFile(path.Join(dir, ".nvmrc"))
 				if err != nil {
 					paketo.Others = append(paketo.Others, paketoBuildpackInfo)
 					heroku.Others = append(heroku.Others, herokuBuildpackInfo)
 					return fmt.Errorf("error fetching contents of .nvmrc: %v", err)
 				}
-				data, err = fileContent.GetContent()
-				if err != nil {
-					paketo.Others = append(paketo.Others, paketoBuildpackInfo)
-					heroku.Others = append(heroku.Others, herokuBuildpackInfo)
-					return fmt.Errorf("error calling GetContent() on .nvmrc: %v", err)
-				}
-				nvmrcVersion, err := validateNvmrc(data)
+				nvmrcVersion, err := validateNvmrc(string(fileContent))
 				if err != nil {
 					paketo.Others = append(paketo.Others, paketoBuildpackInfo)
 					heroku.Others = append(heroku.Others, herokuBuildpackInfo)
@@ -280,31 +254,19 @@ func (runtime *nodejsRuntime) Detect(
 				nvmrcVersion = formatNvmrcContent(nvmrcVersion)
 
 				if nvmrcVersion != "*" {
-					packageJSON.Engines.Node = data
+					packageJSON.Engines.Node = string(fileContent)
 				}
 			}
 
 			if packageJSON.Engines.Node == "" && nodeVersionFound {
 				// copy exact behavior of https://github.com/paketo-buildpacks/node-engine/blob/main/node_version_parser.go
-				fileContent, _, _, err = client.Repositories.GetContents(
-					context.Background(),
-					owner,
-					name,
-					fmt.Sprintf("%s/.node-version", path),
-					&repoContentOptions,
-				)
+				fileContent, err = fileSystem.ReadFile(path.Join(dir, ".node-version"))
 				if err != nil {
 					paketo.Others = append(paketo.Others, paketoBuildpackInfo)
 					heroku.Others = append(heroku.Others, herokuBuildpackInfo)
 					return fmt.Errorf("error fetching contents of .node-version: %v", err)
 				}
-				data, err = fileContent.GetContent()
-				if err != nil {
-					paketo.Others = append(paketo.Others, paketoBuildpackInfo)
-					heroku.Others = append(heroku.Others, herokuBuildpackInfo)
-					return fmt.Errorf("error calling GetContent() on .node-version: %v", err)
-				}
-				nodeVersion, err := validateNodeVersion(data)
+				nodeVersion, err := validateNodeVersion(string(fileContent))
 				if err != nil {
 					paketo.Others = append(paketo.Others, paketoBuildpackInfo)
 					heroku.Others = append(heroku.Others, herokuBuildpackInfo)
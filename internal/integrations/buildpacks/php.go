@@ -0,0 +1,101 @@
+package buildpacks
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// the default document root of https://github.com/paketo-buildpacks/php-dist
+const defaultPHPWebDir = "htdocs"
+
+type phpRuntime struct{}
+
+func NewPHPRuntime() Runtime {
+	return &phpRuntime{}
+}
+
+func (runtime *phpRuntime) Detect(
+	fileSystem FileSystem,
+	directoryContent []DirEntry,
+	dir string,
+	paketo, heroku *BuilderInfo,
+) error {
+	composerJSONFound := false
+	phpFileFound := false
+	webDir := ""
+	for i := range directoryContent {
+		name := directoryContent[i].Name
+		if name == "composer.json" {
+			composerJSONFound = true
+		} else if strings.HasSuffix(name, ".php") {
+			phpFileFound = true
+		} else if directoryContent[i].IsDir && (name == "public" || name == "htdocs" || name == "web") && webDir == "" {
+			webDir = name
+		}
+	}
+
+	paketoBuildpackInfo := BuildpackInfo{
+		Name:      "PHP",
+		Buildpack: "gcr.io/paketo-buildpacks/php",
+	}
+	herokuBuildpackInfo := BuildpackInfo{
+		Name:      "PHP",
+		Buildpack: "heroku/php",
+	}
+
+	if !composerJSONFound && !phpFileFound {
+		paketo.Others = append(paketo.Others, paketoBuildpackInfo)
+		heroku.Others = append(heroku.Others, herokuBuildpackInfo)
+		return nil
+	}
+
+	config := map[string]interface{}{}
+
+	if composerJSONFound {
+		fileContent, err := fileSystem.ReadFile(path.Join(dir, "composer.json"))
+		if err != nil {
+			paketo.Others = append(paketo.Others, paketoBuildpackInfo)
+			heroku.Others = append(heroku.Others, herokuBuildpackInfo)
+			return fmt.Errorf("error fetching contents of composer.json: %v", err)
+		}
+
+		var composerJSON struct {
+			Require map[string]string `json:"require"`
+		}
+
+		err = json.Unmarshal(fileContent, &composerJSON)
+		if err != nil {
+			paketo.Others = append(paketo.Others, paketoBuildpackInfo)
+			heroku.Others = append(heroku.Others, herokuBuildpackInfo)
+			return fmt.Errorf("error decoding composer.json contents to struct: %v", err)
+		}
+
+		config["dependency_manager"] = composer
+
+		if phpVersion := composerJSON.Require["php"]; phpVersion != "" {
+			config["php_version"] = phpVersion
+		}
+	}
+
+	if webDir == "" {
+		// files are served from the root of the application if there is no document root
+		if phpFileFound {
+			webDir = "."
+		} else {
+			webDir = defaultPHPWebDir
+		}
+	}
+
+	config["web_dir"] = webDir
+	config["web_server"] = "php-server"
+
+	paketoBuildpackInfo.Config = config
+	paketo.Detected = append(paketo.Detected, paketoBuildpackInfo)
+
+	herokuBuildpackInfo.Config = config
+	heroku.Detected = append(heroku.Detected, herokuBuildpackInfo)
+
+	return nil
+}
@@ -3,8 +3,6 @@ package buildpacks
 import (
 	"strings"
 	"sync"
-
-	"github.com/google/go-github/v41/github"
 )
 
 type pythonRuntime struct {
@@ -18,11 +16,11 @@ func NewPythonRuntime() Runtime {
 func (runtime *pythonRuntime) detectPipenv(results chan struct {
 	string
 	bool
-}, directoryContent []*github.RepositoryContent) {
+}, directoryContent []DirEntry) {
 	pipfileFound := false
 	pipfileLockFound := false
 	for i := 0; i < len(directoryContent); i++ {
-		name := directoryContent[i].GetName()
+		name := directoryContent[i].Name
 		if name == "Pipfile" {
 			pipfileFound = true
 		} else if name == "Pipfile.lock" {
@@ -44,10 +42,10 @@ func (runtime *pythonRuntime) detectPipenv(results chan struct {
 func (runtime *pythonRuntime) detectPip(results chan struct {
 	string
 	bool
-}, directoryContent []*github.RepositoryContent) {
+}, directoryContent []DirEntry) {
 	requirementsTxtFound := false
 	for i := 0; i < len(directoryContent); i++ {
-		name := directoryContent[i].GetName()
+		name := directoryContent[i].Name
 		if name == "requirements.txt" {
 			requirementsTxtFound = true
 		}
@@ -64,11 +62,11 @@ func (runtime *pythonRuntime) detectPip(results chan struct {
 func (runtime *pythonRuntime) detectConda(results chan struct {
 	string
 	bool
-}, directoryContent []*github.RepositoryContent) {
+}, directoryContent []DirEntry) {
 	environmentFound := false
 	packageListFound := false
 	for i := 0; i < len(directoryContent); i++ {
-		name := directoryContent[i].GetName()
+		name := directoryContent[i].Name
 		if name == "environment.yml" {
 			environmentFound = true
 			break
@@ -89,10 +87,10 @@ func (runtime *pythonRuntime) detectConda(results chan struct {
 func (runtime *pythonRuntime) detectStandalone(results chan struct {
 	string
 	bool
-}, directoryContent []*github.RepositoryContent) {
+}, directoryContent []DirEntry) {
 	pyFound := false
 	for i := 0; i < len(directoryContent); i++ {
-		name := directoryContent[i].GetName()
+		name := directoryContent[i].Name
 		if strings.HasSuffix(name, ".py") {
 			pyFound = true
 			break
@@ -108,10 +106,9 @@ func (runtime *pythonRuntime) detectStandalone(results chan struct {
 }
 
 func (runtime *pythonRuntime) Detect(
-	client *github.Client,
-	directoryContent []*github.RepositoryContent,
-	owner, name, path string,
-	repoContentOptions github.RepositoryContentGetOptions,
+	fileSystem FileSystem,
+	directoryContent []DirEntry,
+	dir string,
 	paketo, heroku *BuilderInfo,
 ) error {
 	results := make(chan struct {
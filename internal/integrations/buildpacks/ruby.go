@@ -2,13 +2,12 @@ package buildpacks
 
 import (
 	"bufio"
-	"context"
+	"bytes"
 	"fmt"
+	"path"
 	"regexp"
 	"strings"
 	"sync"
-
-	"github.com/google/go-github/v41/github"
 )
 
 type rubyRuntime struct {
@@ -116,26 +115,19 @@ func (runtime *rubyRuntime) detectPassenger(gemfileContent string, results chan
 }
 
 func (runtime *rubyRuntime) detectRackup(
-	client *github.Client, owner, name string,
-	repoContentOptions github.RepositoryContentGetOptions, results chan struct {
+	fileSystem FileSystem, dir string, results chan struct {
 		string
 		bool
 	},
 ) {
-	fileContent, _, _, err := client.Repositories.GetContents(context.Background(),
-		owner, name, "Gemfile.lock", &repoContentOptions)
-	if err != nil {
-		runtime.wg.Done()
-		return
-	}
-	gemfileLockContent, err := fileContent.GetContent()
+	gemfileLockContent, err := fileSystem.ReadFile(path.Join(dir, "Gemfile.lock"))
 	if err != nil {
 		runtime.wg.Done()
 		return
 	}
 
 	rackFound := false
-	scanner := bufio.NewScanner(strings.NewReader(gemfileLockContent))
+	scanner := bufio.NewScanner(bytes.NewReader(gemfileLockContent))
 	for scanner.Scan() {
 		if strings.TrimSpace(scanner.Text()) == "GEM" {
 			for scanner.Scan() {
@@ -180,10 +172,9 @@ func (runtime *rubyRuntime) detectRake(gemfileContent string, results chan struc
 }
 
 func (runtime *rubyRuntime) Detect(
-	client *github.Client,
-	directoryContent []*github.RepositoryContent,
-	owner, name, path string,
-	repoContentOptions github.RepositoryContentGetOptions,
+	fileSystem FileSystem,
+	directoryContent []DirEntry,
+	dir string,
 	paketo, heroku *BuilderInfo,
 ) error {
 	gemfileFound := false
@@ -191,7 +182,7 @@ func (runtime *rubyRuntime) Detect(
 	configRuFound := false
 	rakefileFound := false
 	for i := range directoryContent {
-		name := directoryContent[i].GetName()
+		name := directoryContent[i].Name
 		if name == "Gemfile" {
 			gemfileFound = true
 		} else if name == "Gemfile.lock" {
@@ -218,18 +209,13 @@ func (runtime *rubyRuntime) Detect(
 		return nil
 	}
 
-	fileContent, _, _, err := client.Repositories.GetContents(context.Background(), owner, name, "Gemfile", &repoContentOptions)
-	if err != nil {
-		paketo.Others = append(paketo.Others, paketoBuildpackInfo)
-		heroku.Others = append(heroku.Others, herokuBuildpackInfo)
-		return fmt.Errorf("error fetching contents of Gemfile for %s/%s: %v", owner, name, err)
-	}
-	gemfileContent, err := fileContent.GetContent()
+	fileContent, err := fileSystem.ReadFile(path.Join(dir, "Gemfile"))
 	if err != nil {
 		paketo.Others = append(paketo.Others, paketoBuildpackInfo)
 		heroku.Others = append(heroku.Others, herokuBuildpackInfo)
-		return fmt.Errorf("error calling GetContent() on Gemfile for %s/%s: %v", owner, name, err)
+		return fmt.Errorf("error fetching contents of Gemfile: %v", err)
 	}
+	gemfileContent := string(fileContent)
 
 	count := 6
 	if !configRuFound {
@@ -265,7 +251,7 @@ func (runtime *rubyRuntime) Detect(
 	}
 	go runtime.detectPassenger(gemfileContent, results)
 	if !configRuFound && gemfileLockFound {
-		go runtime.detectRackup(client, owner, name, repoContentOptions, results)
+		go runtime.detectRackup(fileSystem, dir, results)
 	}
 	if rakefileFound {
 		go runtime.detectRake(gemfileContent, results)
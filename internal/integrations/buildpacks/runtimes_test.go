@@ -0,0 +1,134 @@
+package buildpacks
+
+import (
+	"reflect"
+	"testing"
+)
+
+type runtimeTest struct {
+	name      string
+	fixture   string
+	detected  []string
+	config    map[string]interface{}
+	configFor string
+}
+
+var runtimeTests = []runtimeTest{
+	{
+		name:      "maven project with java.version",
+		fixture:   "java-maven",
+		detected:  []string{"Java"},
+		configFor: "Java",
+		config: map[string]interface{}{
+			"build_tool":  maven,
+			"jvm_version": "17",
+		},
+	},
+	{
+		name:      "gradle project with sourceCompatibility",
+		fixture:   "java-gradle",
+		detected:  []string{"Java"},
+		configFor: "Java",
+		config: map[string]interface{}{
+			"build_tool":  gradle,
+			"jvm_version": "8",
+		},
+	},
+	{
+		name:      "composer project with public directory",
+		fixture:   "php",
+		detected:  []string{"PHP"},
+		configFor: "PHP",
+		config: map[string]interface{}{
+			"dependency_manager": composer,
+			"php_version":        ">=8.0",
+			"web_dir":            "public",
+			"web_server":         "php-server",
+		},
+	},
+	{
+		name:      "csproj with global.json",
+		fixture:   "dotnet",
+		detected:  []string{".NET"},
+		configFor: ".NET",
+		config: map[string]interface{}{
+			"project_file":     "web.csproj",
+			"target_framework": "net6.0",
+			"sdk_version":      "6.0.100",
+		},
+	},
+	{
+		name:      "cargo project with toolchain file",
+		fixture:   "rust",
+		detected:  []string{"Rust"},
+		configFor: "Rust",
+		config: map[string]interface{}{
+			"binary":    "hello-server",
+			"toolchain": "1.58.1",
+		},
+	},
+	{
+		name:      "plain html site",
+		fixture:   "static-html",
+		detected:  []string{"Static"},
+		configFor: "Static",
+		config: map[string]interface{}{
+			"web_server": nginx,
+			"root":       ".",
+		},
+	},
+	{
+		name:      "create-react-app site",
+		fixture:   "static-react",
+		detected:  []string{"NodeJS", "Static"},
+		configFor: "Static",
+		config: map[string]interface{}{
+			"web_server":   nginx,
+			"build_script": "build",
+			"root":         "build",
+		},
+	},
+	{
+		name:     "go module is not detected by the new runtimes",
+		fixture:  "go",
+		detected: []string{"Go"},
+	},
+}
+
+func TestDetectRuntimes(t *testing.T) {
+	for _, test := range runtimeTests {
+		builders, err := Detect(NewLocalFileSystem("testdata/"+test.fixture), "")
+
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+
+		for _, builder := range []string{PaketoBuilder, HerokuBuilder} {
+			info := builders[builder]
+
+			detected := []string{}
+			var config map[string]interface{}
+
+			for _, bp := range info.Detected {
+				detected = append(detected, bp.Name)
+
+				if bp.Name == test.configFor {
+					config = bp.Config
+				}
+			}
+
+			if !reflect.DeepEqual(detected, test.detected) {
+				t.Errorf("%s: expected %s to detect %v, got %v", test.name, builder, test.detected, detected)
+			}
+
+			if test.configFor != "" && !reflect.DeepEqual(config, test.config) {
+				t.Errorf("%s: expected %s config %v, got %v", test.name, builder, test.config, config)
+			}
+
+			// every runtime is reported by each builder, either as detected or as another option
+			if total := len(info.Detected) + len(info.Others); total != len(Runtimes) {
+				t.Errorf("%s: expected %s to list %d runtimes, got %d", test.name, builder, len(Runtimes), total)
+			}
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package buildpacks
+
+import (
+	"bufio"
+	"bytes"
+	"path"
+	"regexp"
+	"strings"
+)
+
+var (
+	cargoSectionRe = regexp.MustCompile(`^\s*\[+\s*([A-Za-z0-9_.\-]+)\s*\]+`)
+	cargoKeyValRe  = regexp.MustCompile(`^\s*([A-Za-z0-9_\-]+)\s*=\s*"([^"]*)"`)
+)
+
+type rustRuntime struct{}
+
+func NewRustRuntime() Runtime {
+	return &rustRuntime{}
+}
+
+// detectPackageName returns the name of the package in Cargo.toml, which is the name of
+// the default binary
+func (runtime *rustRuntime) detectPackageName(fileSystem FileSystem, dir string) string {
+	data, err := fileSystem.ReadFile(path.Join(dir, "Cargo.toml"))
+	if err != nil {
+		return ""
+	}
+
+	section := ""
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if matches := cargoSectionRe.FindStringSubmatch(line); matches != nil {
+			section = matches[1]
+		} else if matches := cargoKeyValRe.FindStringSubmatch(line); matches != nil && section == "package" && matches[1] == "name" {
+			return matches[2]
+		}
+	}
+
+	return ""
+}
+
+// detectToolchain reads the toolchain from a rust-toolchain or rust-toolchain.toml file
+func (runtime *rustRuntime) detectToolchain(fileSystem FileSystem, dir, toolchainFile string) string {
+	data, err := fileSystem.ReadFile(path.Join(dir, toolchainFile))
+	if err != nil {
+		return ""
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if matches := cargoKeyValRe.FindStringSubmatch(line); matches != nil {
+			if matches[1] == "channel" {
+				return matches[2]
+			}
+		} else if line != "" && !strings.HasPrefix(line, "[") && !strings.HasPrefix(line, "#") {
+			// legacy rust-toolchain files only contain the toolchain name
+			return line
+		}
+	}
+
+	return ""
+}
+
+func (runtime *rustRuntime) Detect(
+	fileSystem FileSystem,
+	directoryContent []DirEntry,
+	dir string,
+	paketo, heroku *BuilderInfo,
+) error {
+	cargoTomlFound := false
+	toolchainFile := ""
+	for i := range directoryContent {
+		name := directoryContent[i].Name
+		if name == "Cargo.toml" {
+			cargoTomlFound = true
+		} else if name == "rust-toolchain" || name == "rust-toolchain.toml" {
+			toolchainFile = name
+		}
+	}
+
+	paketoBuildpackInfo := BuildpackInfo{
+		Name:      "Rust",
+		Buildpack: "docker.io/paketocommunity/rust",
+	}
+	// there is no official Rust buildpack for heroku, so use the most widely used
+	// community buildpack from the buildpack registry
+	herokuBuildpackInfo := BuildpackInfo{
+		Name:      "Rust",
+		Buildpack: "emk/rust",
+	}
+
+	if !cargoTomlFound {
+		paketo.Others = append(paketo.Others, paketoBuildpackInfo)
+		heroku.Others = append(heroku.Others, herokuBuildpackInfo)
+		return nil
+	}
+
+	toolchain := ""
+	if toolchainFile != "" {
+		toolchain = runtime.detectToolchain(fileSystem, dir, toolchainFile)
+	}
+
+	if toolchain == "" {
+		toolchain = "stable"
+	}
+
+	config := map[string]interface{}{
+		"toolchain": toolchain,
+	}
+
+	if packageName := runtime.detectPackageName(fileSystem, dir); packageName != "" {
+		config["binary"] = packageName
+	}
+
+	paketoBuildpackInfo.Config = config
+	paketo.Detected = append(paketo.Detected, paketoBuildpackInfo)
+
+	herokuBuildpackInfo.Config = config
+	heroku.Detected = append(heroku.Detected, herokuBuildpackInfo)
+
+	return nil
+}
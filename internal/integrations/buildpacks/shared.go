@@ -1,9 +1,5 @@
 package buildpacks
 
-import (
-	"github.com/google/go-github/v41/github"
-)
-
 const (
 	// NodeJS
 	yarn = "yarn"
@@ -26,6 +22,16 @@ const (
 	rackup    = "rackup"
 	rake      = "rake"
 
+	// Java
+	maven  = "maven"
+	gradle = "gradle"
+
+	// PHP
+	composer = "composer"
+
+	// Static
+	nginx = "nginx"
+
 	// Common
 	standalone = "standalone"
 
@@ -49,12 +55,9 @@ type BuilderInfo struct {
 
 type Runtime interface {
 	Detect(
-		*github.Client, // github client to pull contents of files
-		[]*github.RepositoryContent, // the root folder structure of the git repo
-		string, // owner
-		string, // name
-		string, // path
-		github.RepositoryContentGetOptions, // SHA, branch or tag
+		FileSystem, // file system to pull contents of files
+		[]DirEntry, // the contents of the directory being detected
+		string, // the directory, relative to the root of the file system
 		*BuilderInfo, // paketo
 		*BuilderInfo, // heroku
 	) error
@@ -66,4 +69,9 @@ var Runtimes = []Runtime{
 	NewNodeRuntime(),
 	NewPythonRuntime(),
 	NewRubyRuntime(),
+	NewJavaRuntime(),
+	NewPHPRuntime(),
+	NewDotnetRuntime(),
+	NewRustRuntime(),
+	NewStaticRuntime(),
 }
@@ -0,0 +1,138 @@
+package buildpacks
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+)
+
+// the output directories of the build scripts of common frontend frameworks
+var staticOutputDirs = []struct {
+	dependency string
+	outputDir  string
+}{
+	{"react-scripts", "build"},
+	{"@angular/cli", "dist"},
+	{"@vue/cli-service", "dist"},
+	{"vite", "dist"},
+	{"gatsby", "public"},
+	{"@docusaurus/core", "build"},
+}
+
+type staticRuntime struct{}
+
+func NewStaticRuntime() Runtime {
+	return &staticRuntime{}
+}
+
+func (runtime *staticRuntime) Detect(
+	fileSystem FileSystem,
+	directoryContent []DirEntry,
+	dir string,
+	paketo, heroku *BuilderInfo,
+) error {
+	indexHTMLFound := false
+	packageJSONFound := false
+	publicIndexHTMLFound := false
+	for i := range directoryContent {
+		name := directoryContent[i].Name
+		if name == "index.html" {
+			indexHTMLFound = true
+		} else if name == "package.json" {
+			packageJSONFound = true
+		} else if name == "public" && directoryContent[i].IsDir {
+			if _, err := fileSystem.ReadFile(path.Join(dir, "public", "index.html")); err == nil {
+				publicIndexHTMLFound = true
+			}
+		}
+	}
+
+	paketoBuildpackInfo := BuildpackInfo{
+		Name:      "Static",
+		Buildpack: "gcr.io/paketo-buildpacks/web-servers",
+	}
+	// heroku does not have an official buildpack for static sites, so use the community
+	// buildpack from the buildpack registry
+	herokuBuildpackInfo := BuildpackInfo{
+		Name:      "Static",
+		Buildpack: "heroku-community/static",
+	}
+
+	config := map[string]interface{}{
+		"web_server": nginx,
+	}
+
+	if packageJSONFound {
+		fileContent, err := fileSystem.ReadFile(path.Join(dir, "package.json"))
+		if err != nil {
+			paketo.Others = append(paketo.Others, paketoBuildpackInfo)
+			heroku.Others = append(heroku.Others, herokuBuildpackInfo)
+			return fmt.Errorf("error fetching contents of package.json: %v", err)
+		}
+
+		var packageJSON struct {
+			Scripts         map[string]string `json:"scripts"`
+			Dependencies    map[string]string `json:"dependencies"`
+			DevDependencies map[string]string `json:"devDependencies"`
+		}
+
+		err = json.Unmarshal(fileContent, &packageJSON)
+		if err != nil {
+			paketo.Others = append(paketo.Others, paketoBuildpackInfo)
+			heroku.Others = append(heroku.Others, herokuBuildpackInfo)
+			return fmt.Errorf("error decoding package.json contents to struct: %v", err)
+		}
+
+		// a package with a build script but without a start script is built into static files
+		// which are served by a web server
+		outputDir, frameworkFound := runtime.getOutputDir(packageJSON.Dependencies, packageJSON.DevDependencies)
+
+		if packageJSON.Scripts["build"] != "" && (packageJSON.Scripts["start"] == "" || frameworkFound) {
+			config["build_script"] = "build"
+			config["root"] = outputDir
+
+			paketoBuildpackInfo.Config = config
+			paketo.Detected = append(paketo.Detected, paketoBuildpackInfo)
+
+			herokuBuildpackInfo.Config = config
+			heroku.Detected = append(heroku.Detected, herokuBuildpackInfo)
+
+			return nil
+		}
+	}
+
+	if indexHTMLFound || (!packageJSONFound && publicIndexHTMLFound) {
+		if indexHTMLFound {
+			config["root"] = "."
+		} else {
+			config["root"] = "public"
+		}
+
+		paketoBuildpackInfo.Config = config
+		paketo.Detected = append(paketo.Detected, paketoBuildpackInfo)
+
+		herokuBuildpackInfo.Config = config
+		heroku.Detected = append(heroku.Detected, herokuBuildpackInfo)
+
+		return nil
+	}
+
+	paketo.Others = append(paketo.Others, paketoBuildpackInfo)
+	heroku.Others = append(heroku.Others, herokuBuildpackInfo)
+
+	return nil
+}
+
+// getOutputDir returns the output directory of the build script, based on the frontend
+// framework in the dependencies of a package.json
+func (runtime *staticRuntime) getOutputDir(deps ...map[string]string) (string, bool) {
+	for _, output := range staticOutputDirs {
+		for _, dep := range deps {
+			if _, ok := dep[output.dependency]; ok {
+				return output.outputDir, true
+			}
+		}
+	}
+
+	return "build", false
+}
@@ -0,0 +1,146 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/porter-dev/porter/internal/integrations/gitlab"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	porterCLIImage = "public.ecr.aws/o1j4x7p4/porter-cli:latest"
+
+	// ciFileName is the pipeline file which GitLab runs by default
+	ciFileName = ".gitlab-ci.yml"
+)
+
+// GitlabCI sets up a GitLab CI pipeline which deploys a release on every push to a branch.
+// The Porter token is stored as a project CI/CD variable, and the pipeline is written to a
+// separate file which is included from .gitlab-ci.yml, so existing pipelines are preserved.
+type GitlabCI struct {
+	Client *gitlab.Client
+
+	ServerURL   string
+	PorterToken string
+
+	ProjectID        uint
+	ClusterID        uint
+	ReleaseName      string
+	ReleaseNamespace string
+
+	// GitRepo is the full path of the GitLab project, such as "group/subgroup/name"
+	GitRepo   string
+	GitBranch string
+
+	DryRun bool
+}
+
+// Setup creates the CI variable and commits the pipeline to the repository. It returns the
+// generated pipeline, which is not committed if DryRun is set.
+func (g *GitlabCI) Setup() ([]byte, error) {
+	branch, err := g.getBranch()
+
+	if err != nil {
+		return nil, err
+	}
+
+	pipelineYAML, err := g.GetPipelineYAML(branch)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if g.DryRun {
+		return pipelineYAML, nil
+	}
+
+	if err := setTokenVariable(g.Client, g.GitRepo, g.ProjectID, g.PorterToken); err != nil {
+		return nil, err
+	}
+
+	err = commitIncludedFile(
+		g.Client,
+		g.GitRepo,
+		branch,
+		g.GetPipelineFileName(),
+		pipelineYAML,
+		fmt.Sprintf("Add Porter deployment pipeline for %s", g.ReleaseName),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pipelineYAML, nil
+}
+
+// Cleanup removes the pipeline from the repository. The CI variable is kept, since it may
+// be shared with other pipelines in the same Porter project.
+func (g *GitlabCI) Cleanup() error {
+	branch, err := g.getBranch()
+
+	if err != nil {
+		return err
+	}
+
+	return deleteIncludedFile(
+		g.Client,
+		g.GitRepo,
+		branch,
+		g.GetPipelineFileName(),
+		fmt.Sprintf("Remove Porter deployment pipeline for %s", g.ReleaseName),
+	)
+}
+
+// GetPipelineFileName returns the path of the generated pipeline in the repository
+func (g *GitlabCI) GetPipelineFileName() string {
+	return fmt.Sprintf(".gitlab/porter_%s.yml", strings.ToLower(strings.ReplaceAll(g.ReleaseName, "-", "_")))
+}
+
+// GetPipelineYAML returns a pipeline which runs `porter update` on every push to the branch
+func (g *GitlabCI) GetPipelineYAML(branch string) ([]byte, error) {
+	jobName := fmt.Sprintf("porter-deploy-%s", g.ReleaseName)
+
+	pipeline := yaml.MapSlice{
+		{
+			Key: jobName,
+			Value: &Job{
+				Image: getPorterCLIImage(),
+				Rules: []*Rule{
+					{
+						If: fmt.Sprintf(`$CI_PIPELINE_SOURCE == "push" && $CI_COMMIT_BRANCH == "%s"`, branch),
+					},
+				},
+				Variables: getPorterVariables(g.ServerURL, g.ProjectID, g.ClusterID),
+				Script: []string{
+					fmt.Sprintf(
+						"porter update --app %s --namespace %s --tag $CI_COMMIT_SHORT_SHA --stream",
+						g.ReleaseName,
+						g.ReleaseNamespace,
+					),
+				},
+				Timeout: "20 minutes",
+			},
+		},
+	}
+
+	return yaml.Marshal(pipeline)
+}
+
+func (g *GitlabCI) getBranch() (string, error) {
+	if g.GitBranch != "" {
+		return g.GitBranch, nil
+	}
+
+	// use the default branch of the project
+	project, err := g.Client.GetProject(context.Background(), g.GitRepo)
+
+	if err != nil {
+		return "", err
+	}
+
+	return project.DefaultBranch, nil
+}
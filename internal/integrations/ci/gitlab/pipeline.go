@@ -0,0 +1,224 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/porter-dev/porter/internal/integrations/gitlab"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Job is a job in a GitLab CI pipeline
+type Job struct {
+	Image     *Image            `yaml:"image,omitempty"`
+	Rules     []*Rule           `yaml:"rules,omitempty"`
+	Variables map[string]string `yaml:"variables,omitempty"`
+	Script    []string          `yaml:"script,omitempty"`
+	Timeout   string            `yaml:"timeout,omitempty"`
+}
+
+// Image is the container image that a job runs in
+type Image struct {
+	Name       string   `yaml:"name"`
+	Entrypoint []string `yaml:"entrypoint"`
+}
+
+// Rule determines whether a job is added to a pipeline
+type Rule struct {
+	If string `yaml:"if,omitempty"`
+}
+
+func getPorterCLIImage() *Image {
+	// the image entrypoint is the porter binary, so it is overridden to run the job script
+	return &Image{
+		Name:       porterCLIImage,
+		Entrypoint: []string{""},
+	}
+}
+
+func getPorterTokenVariableName(projectID uint) string {
+	return fmt.Sprintf("PORTER_TOKEN_%d", projectID)
+}
+
+func getPorterVariables(serverURL string, projectID, clusterID uint) map[string]string {
+	return map[string]string{
+		"PORTER_HOST":    serverURL,
+		"PORTER_PROJECT": fmt.Sprintf("%d", projectID),
+		"PORTER_CLUSTER": fmt.Sprintf("%d", clusterID),
+		"PORTER_TOKEN":   fmt.Sprintf("$%s", getPorterTokenVariableName(projectID)),
+	}
+}
+
+func setTokenVariable(client *gitlab.Client, repo string, projectID uint, token string) error {
+	// masked variables have to match GitLab's masking requirements, so fall back to an
+	// unmasked variable if the token cannot be masked
+	err := client.SetVariable(context.Background(), repo, getPorterTokenVariableName(projectID), token, true)
+
+	if err != nil {
+		err = client.SetVariable(context.Background(), repo, getPorterTokenVariableName(projectID), token, false)
+	}
+
+	return err
+}
+
+// commitIncludedFile commits a pipeline file and adds it to the includes of .gitlab-ci.yml
+func commitIncludedFile(client *gitlab.Client, repo, branch, fileName string, contents []byte, message string) error {
+	if err := client.CommitFile(context.Background(), repo, branch, fileName, contents, message); err != nil {
+		return err
+	}
+
+	ciYAML, err := client.GetRawFile(context.Background(), repo, branch, ciFileName)
+
+	if err != nil && !gitlab.IsNotFound(err) {
+		return err
+	}
+
+	newCIYAML, changed, err := addInclude(ciYAML, fileName)
+
+	if err != nil {
+		return err
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return client.CommitFile(context.Background(), repo, branch, ciFileName, newCIYAML, message)
+}
+
+// deleteIncludedFile deletes a pipeline file and removes it from the includes of .gitlab-ci.yml
+func deleteIncludedFile(client *gitlab.Client, repo, branch, fileName, message string) error {
+	ciYAML, err := client.GetRawFile(context.Background(), repo, branch, ciFileName)
+
+	if err != nil && !gitlab.IsNotFound(err) {
+		return err
+	}
+
+	if err == nil {
+		newCIYAML, changed, err := removeInclude(ciYAML, fileName)
+
+		if err != nil {
+			return err
+		}
+
+		if changed {
+			if err := client.CommitFile(context.Background(), repo, branch, ciFileName, newCIYAML, message); err != nil {
+				return err
+			}
+		}
+	}
+
+	return client.DeleteFile(context.Background(), repo, branch, fileName, message)
+}
+
+// addInclude adds a local include to a .gitlab-ci.yml file, keeping the order of the
+// existing keys. It returns false if the file already includes the path.
+func addInclude(ciYAML []byte, path string) ([]byte, bool, error) {
+	ci := yaml.MapSlice{}
+
+	if err := yaml.Unmarshal(ciYAML, &ci); err != nil {
+		return nil, false, fmt.Errorf("could not parse %s: %w", ciFileName, err)
+	}
+
+	for i, item := range ci {
+		if item.Key != "include" {
+			continue
+		}
+
+		includes := normalizeIncludes(item.Value)
+
+		for _, include := range includes {
+			if includePath(include) == path {
+				return ciYAML, false, nil
+			}
+		}
+
+		ci[i].Value = append(includes, yaml.MapSlice{{Key: "local", Value: path}})
+
+		res, err := yaml.Marshal(ci)
+
+		return res, true, err
+	}
+
+	// includes are conventionally at the top of the file
+	ci = append(yaml.MapSlice{{
+		Key:   "include",
+		Value: []interface{}{yaml.MapSlice{{Key: "local", Value: path}}},
+	}}, ci...)
+
+	res, err := yaml.Marshal(ci)
+
+	return res, true, err
+}
+
+// removeInclude removes a local include from a .gitlab-ci.yml file. It returns false if the
+// file does not include the path.
+func removeInclude(ciYAML []byte, path string) ([]byte, bool, error) {
+	ci := yaml.MapSlice{}
+
+	if err := yaml.Unmarshal(ciYAML, &ci); err != nil {
+		return nil, false, fmt.Errorf("could not parse %s: %w", ciFileName, err)
+	}
+
+	for i, item := range ci {
+		if item.Key != "include" {
+			continue
+		}
+
+		includes := normalizeIncludes(item.Value)
+		newIncludes := make([]interface{}, 0)
+
+		for _, include := range includes {
+			if includePath(include) != path {
+				newIncludes = append(newIncludes, include)
+			}
+		}
+
+		if len(newIncludes) == len(includes) {
+			return ciYAML, false, nil
+		}
+
+		if len(newIncludes) == 0 {
+			ci = append(ci[:i], ci[i+1:]...)
+		} else {
+			ci[i].Value = newIncludes
+		}
+
+		res, err := yaml.Marshal(ci)
+
+		return res, true, err
+	}
+
+	return ciYAML, false, nil
+}
+
+// normalizeIncludes converts the include keyword, which may be a single string, a single
+// include object or a list of either, to a list
+func normalizeIncludes(val interface{}) []interface{} {
+	switch v := val.(type) {
+	case []interface{}:
+		return v
+	case nil:
+		return []interface{}{}
+	}
+
+	return []interface{}{val}
+}
+
+func includePath(include interface{}) string {
+	switch v := include.(type) {
+	case string:
+		return v
+	case yaml.MapSlice:
+		for _, item := range v {
+			if item.Key == "local" {
+				path, _ := item.Value.(string)
+
+				return path
+			}
+		}
+	}
+
+	return ""
+}
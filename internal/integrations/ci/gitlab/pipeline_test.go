@@ -0,0 +1,59 @@
+package gitlab
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAddInclude(t *testing.T) {
+	existing := []byte(`stages:
+- test
+include: templates/common.yml
+test:
+  script:
+  - make test
+`)
+
+	res, changed, err := addInclude(existing, ".gitlab/porter_web.yml")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !changed {
+		t.Fatalf("expected the include to be added")
+	}
+
+	for _, expected := range []string{"- templates/common.yml", "- local: .gitlab/porter_web.yml", "make test"} {
+		if !strings.Contains(string(res), expected) {
+			t.Errorf("expected result to contain %q, got:\n%s", expected, res)
+		}
+	}
+
+	// adding the same include again is a no-op
+	if _, changed, _ := addInclude(res, ".gitlab/porter_web.yml"); changed {
+		t.Errorf("expected the include to not be added twice")
+	}
+
+	res, changed, err = removeInclude(res, ".gitlab/porter_web.yml")
+
+	if err != nil || !changed {
+		t.Fatalf("expected the include to be removed, got changed=%v err=%v", changed, err)
+	}
+
+	if strings.Contains(string(res), "porter_web") {
+		t.Errorf("expected include to be removed, got:\n%s", res)
+	}
+}
+
+func TestAddIncludeToEmptyFile(t *testing.T) {
+	res, changed, err := addInclude(nil, ".gitlab/porter_web.yml")
+
+	if err != nil || !changed {
+		t.Fatalf("expected the include to be added, got changed=%v err=%v", changed, err)
+	}
+
+	if string(res) != "include:\n- local: .gitlab/porter_web.yml\n" {
+		t.Errorf("unexpected result:\n%s", res)
+	}
+}
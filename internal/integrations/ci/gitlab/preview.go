@@ -0,0 +1,105 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/porter-dev/porter/internal/integrations/gitlab"
+
+	"gopkg.in/yaml.v2"
+)
+
+// PreviewVariable is set to "true" on pipelines which are created by Porter to deploy a
+// preview environment for a merge request
+const PreviewVariable = "PORTER_PREVIEW"
+
+type EnvOpts struct {
+	Client *gitlab.Client
+
+	ServerURL       string
+	PorterToken     string
+	GitRepo         string
+	EnvironmentName string
+
+	ProjectID, ClusterID uint
+}
+
+// SetupEnv writes the preview environment pipeline to the default branch of the repository.
+// The pipeline only runs when Porter creates it for a merge request, and runs `porter apply`
+// in the namespace passed by Porter.
+func SetupEnv(opts *EnvOpts) error {
+	project, err := opts.Client.GetProject(context.Background(), opts.GitRepo)
+
+	if err != nil {
+		return err
+	}
+
+	if err := setTokenVariable(opts.Client, opts.GitRepo, opts.ProjectID, opts.PorterToken); err != nil {
+		return err
+	}
+
+	previewYAML, err := getPreviewPipelineYAML(opts)
+
+	if err != nil {
+		return err
+	}
+
+	return commitIncludedFile(
+		opts.Client,
+		opts.GitRepo,
+		project.DefaultBranch,
+		getPreviewFileName(opts.EnvironmentName),
+		previewYAML,
+		fmt.Sprintf("Add Porter preview environment pipeline for %s", opts.EnvironmentName),
+	)
+}
+
+// DeleteEnv removes the preview environment pipeline from the default branch
+func DeleteEnv(opts *EnvOpts) error {
+	project, err := opts.Client.GetProject(context.Background(), opts.GitRepo)
+
+	if err != nil {
+		return err
+	}
+
+	return deleteIncludedFile(
+		opts.Client,
+		opts.GitRepo,
+		project.DefaultBranch,
+		getPreviewFileName(opts.EnvironmentName),
+		fmt.Sprintf("Remove Porter preview environment pipeline for %s", opts.EnvironmentName),
+	)
+}
+
+// GetPreviewNamespace returns the namespace of the preview environment for a merge request
+func GetPreviewNamespace(mergeRequestID uint, repoName string) string {
+	return fmt.Sprintf("pr-%d-%s", mergeRequestID, strings.ToLower(strings.ReplaceAll(repoName, "_", "-")))
+}
+
+func getPreviewFileName(envName string) string {
+	return fmt.Sprintf(".gitlab/porter_%s_env.yml", strings.ToLower(envName))
+}
+
+func getPreviewPipelineYAML(opts *EnvOpts) ([]byte, error) {
+	pipeline := yaml.MapSlice{
+		{
+			Key: "porter-preview",
+			Value: &Job{
+				Image: getPorterCLIImage(),
+				Rules: []*Rule{
+					{
+						If: fmt.Sprintf(`$CI_PIPELINE_SOURCE == "api" && $%s == "true"`, PreviewVariable),
+					},
+				},
+				Variables: getPorterVariables(opts.ServerURL, opts.ProjectID, opts.ClusterID),
+				Script: []string{
+					"porter apply -f porter.yaml",
+				},
+				Timeout: "30 minutes",
+			},
+		},
+	}
+
+	return yaml.Marshal(pipeline)
+}
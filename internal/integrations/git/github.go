@@ -0,0 +1,195 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/v41/github"
+	"github.com/porter-dev/porter/api/types"
+)
+
+type githubProvider struct {
+	client *github.Client
+}
+
+// NewGithubProvider returns a Provider which uses a GitHub app installation client
+func NewGithubProvider(client *github.Client) Provider {
+	return &githubProvider{client}
+}
+
+func (p *githubProvider) Kind() string {
+	return "github"
+}
+
+func (p *githubProvider) ListRepos(ctx context.Context) ([]types.Repo, error) {
+	res := make([]types.Repo, 0)
+	var mu sync.Mutex
+
+	err := forEachGithubPage(func(page int) (*github.Response, error) {
+		repos, resp, err := p.client.Apps.ListRepos(ctx, &github.ListOptions{Page: page, PerPage: 100})
+
+		if err != nil {
+			return nil, err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		for _, repo := range repos.Repositories {
+			res = append(res, types.Repo{
+				FullName: repo.GetFullName(),
+				Kind:     p.Kind(),
+			})
+		}
+
+		return resp, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (p *githubProvider) ListBranches(ctx context.Context, repo string) ([]string, error) {
+	owner, name, err := splitGithubRepo(repo)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0)
+	var mu sync.Mutex
+
+	err = forEachGithubPage(func(page int) (*github.Response, error) {
+		branches, resp, err := p.client.Repositories.ListBranches(ctx, owner, name, &github.BranchListOptions{
+			ListOptions: github.ListOptions{Page: page, PerPage: 100},
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		for _, branch := range branches {
+			res = append(res, branch.GetName())
+		}
+
+		return resp, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (p *githubProvider) ListContents(ctx context.Context, repo, ref, dir string) ([]types.GithubDirectoryItem, error) {
+	owner, name, err := splitGithubRepo(repo)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, contents, _, err := p.client.Repositories.GetContents(ctx, owner, name, dir, &github.RepositoryContentGetOptions{
+		Ref: ref,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]types.GithubDirectoryItem, 0)
+
+	for _, content := range contents {
+		res = append(res, types.GithubDirectoryItem{
+			Path: content.GetPath(),
+			Type: content.GetType(),
+		})
+	}
+
+	return res, nil
+}
+
+func (p *githubProvider) GetFile(ctx context.Context, repo, ref, path string) ([]byte, error) {
+	owner, name, err := splitGithubRepo(repo)
+
+	if err != nil {
+		return nil, err
+	}
+
+	file, _, _, err := p.client.Repositories.GetContents(ctx, owner, name, path, &github.RepositoryContentGetOptions{
+		Ref: ref,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if file == nil {
+		return nil, fmt.Errorf("%s is not a file", path)
+	}
+
+	contents, err := file.GetContent()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(contents), nil
+}
+
+// githubPageWorkers is the number of pages of a GitHub list which are fetched concurrently
+const githubPageWorkers = 5
+
+// forEachGithubPage calls fetch with the first page of a GitHub list, and then with the
+// remaining pages concurrently, so fetch must be safe to call from multiple goroutines
+func forEachGithubPage(fetch func(page int) (*github.Response, error)) error {
+	resp, err := fetch(1)
+
+	if err != nil {
+		return err
+	}
+
+	var fetchErr error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	wg.Add(githubPageWorkers)
+
+	for i := 0; i < githubPageWorkers; i++ {
+		go func(page int) {
+			defer wg.Done()
+
+			for ; page <= resp.LastPage; page += githubPageWorkers {
+				if _, err := fetch(page); err != nil {
+					mu.Lock()
+					fetchErr = err
+					mu.Unlock()
+
+					return
+				}
+			}
+		}(i + 2)
+	}
+
+	wg.Wait()
+
+	return fetchErr
+}
+
+func splitGithubRepo(repo string) (string, string, error) {
+	parts := strings.SplitN(repo, "/", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid github repository %s: must be in the form owner/name", repo)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,46 @@
+package git
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/v41/github"
+)
+
+func TestForEachGithubPage(t *testing.T) {
+	var pages []int
+	var mu sync.Mutex
+
+	err := forEachGithubPage(func(page int) (*github.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		pages = append(pages, page)
+
+		return &github.Response{LastPage: 12}, nil
+	})
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	sort.Ints(pages)
+
+	if len(pages) != 12 || pages[0] != 1 || pages[11] != 12 {
+		t.Errorf("expected pages 1 to 12 to be fetched once, got %v", pages)
+	}
+
+	err = forEachGithubPage(func(page int) (*github.Response, error) {
+		if page == 7 {
+			return nil, fmt.Errorf("rate limited")
+		}
+
+		return &github.Response{LastPage: 12}, nil
+	})
+
+	if err == nil {
+		t.Errorf("expected the error of a page to be returned")
+	}
+}
@@ -0,0 +1,85 @@
+package git
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/integrations/gitlab"
+)
+
+type gitlabProvider struct {
+	client *gitlab.Client
+}
+
+// NewGitlabProvider returns a Provider which uses a GitLab client
+func NewGitlabProvider(client *gitlab.Client) Provider {
+	return &gitlabProvider{client}
+}
+
+func (p *gitlabProvider) Kind() string {
+	return "gitlab"
+}
+
+func (p *gitlabProvider) ListRepos(ctx context.Context) ([]types.Repo, error) {
+	projects, err := p.client.ListProjects(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]types.Repo, 0)
+
+	for _, project := range projects {
+		res = append(res, types.Repo{
+			FullName: project.PathWithNamespace,
+			Kind:     p.Kind(),
+		})
+	}
+
+	return res, nil
+}
+
+func (p *gitlabProvider) ListBranches(ctx context.Context, repo string) ([]string, error) {
+	branches, err := p.client.ListBranches(ctx, repo)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0)
+
+	for _, branch := range branches {
+		res = append(res, branch.Name)
+	}
+
+	return res, nil
+}
+
+func (p *gitlabProvider) ListContents(ctx context.Context, repo, ref, dir string) ([]types.GithubDirectoryItem, error) {
+	items, err := p.client.ListTree(ctx, repo, ref, dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]types.GithubDirectoryItem, 0)
+
+	for _, item := range items {
+		itemType := ItemTypeFile
+
+		if item.Type == "tree" {
+			itemType = ItemTypeDir
+		}
+
+		res = append(res, types.GithubDirectoryItem{
+			Path: item.Path,
+			Type: itemType,
+		})
+	}
+
+	return res, nil
+}
+
+func (p *gitlabProvider) GetFile(ctx context.Context, repo, ref, path string) ([]byte, error) {
+	return p.client.GetRawFile(ctx, repo, ref, path)
+}
@@ -0,0 +1,33 @@
+package git
+
+import (
+	"context"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// Provider is a git hosting provider which Porter can read repositories from. Repositories
+// are referenced by their full path, such as "owner/name" on GitHub or
+// "group/subgroup/name" on GitLab.
+type Provider interface {
+	// Kind returns the kind of the provider, which is used as the Kind of listed repos
+	Kind() string
+
+	// ListRepos lists the repositories which Porter can access
+	ListRepos(ctx context.Context) ([]types.Repo, error)
+
+	// ListBranches lists the branch names of a repository
+	ListBranches(ctx context.Context, repo string) ([]string, error)
+
+	// ListContents lists the files and directories in a directory of a repository
+	ListContents(ctx context.Context, repo, ref, dir string) ([]types.GithubDirectoryItem, error)
+
+	// GetFile returns the contents of a file in a repository
+	GetFile(ctx context.Context, repo, ref, path string) ([]byte, error)
+}
+
+// Directory item types returned by ListContents, which match the GitHub contents API
+const (
+	ItemTypeFile = "file"
+	ItemTypeDir  = "dir"
+)
@@ -0,0 +1,118 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Variable is a project-level CI/CD variable
+type Variable struct {
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	Masked    bool   `json:"masked"`
+	Protected bool   `json:"protected"`
+}
+
+// SetVariable creates or updates a project CI/CD variable. Variables are not protected, so
+// that they are available to pipelines on merge request branches.
+func (c *Client) SetVariable(ctx context.Context, project, key, value string, masked bool) error {
+	variable := &Variable{
+		Key:    key,
+		Value:  value,
+		Masked: masked,
+	}
+
+	_, err := c.do(ctx, http.MethodPut, projectPath(project)+"/variables/"+url.PathEscape(key), nil, variable, nil)
+
+	if IsNotFound(err) {
+		_, err = c.do(ctx, http.MethodPost, projectPath(project)+"/variables", nil, variable, nil)
+	}
+
+	return err
+}
+
+// DeleteVariable deletes a project CI/CD variable. It does not return an error if the
+// variable does not exist.
+func (c *Client) DeleteVariable(ctx context.Context, project, key string) error {
+	_, err := c.do(ctx, http.MethodDelete, projectPath(project)+"/variables/"+url.PathEscape(key), nil, nil, nil)
+
+	if IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+type pipelineVariable struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type createPipelineRequest struct {
+	Ref       string              `json:"ref"`
+	Variables []*pipelineVariable `json:"variables,omitempty"`
+}
+
+// Pipeline is a CI/CD pipeline run
+type Pipeline struct {
+	ID     int    `json:"id"`
+	Status string `json:"status"`
+	Ref    string `json:"ref"`
+	WebURL string `json:"web_url"`
+}
+
+// CreatePipeline runs a pipeline on a ref with the given variables
+func (c *Client) CreatePipeline(ctx context.Context, project, ref string, variables map[string]string) (*Pipeline, error) {
+	req := &createPipelineRequest{
+		Ref: ref,
+	}
+
+	for key, val := range variables {
+		req.Variables = append(req.Variables, &pipelineVariable{
+			Key:   key,
+			Value: val,
+		})
+	}
+
+	res := &Pipeline{}
+
+	if _, err := c.do(ctx, http.MethodPost, projectPath(project)+"/pipeline", nil, req, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Hook is a project webhook
+type Hook struct {
+	ID                  int    `json:"id"`
+	URL                 string `json:"url"`
+	Token               string `json:"token,omitempty"`
+	MergeRequestsEvents bool   `json:"merge_requests_events"`
+	PushEvents          bool   `json:"push_events"`
+}
+
+// CreateMergeRequestHook registers a webhook for merge request events on a project, unless
+// a webhook with the same URL already exists
+func (c *Client) CreateMergeRequestHook(ctx context.Context, project, hookURL, token string) error {
+	hooks := make([]*Hook, 0)
+
+	if _, err := c.do(ctx, http.MethodGet, projectPath(project)+"/hooks", nil, nil, &hooks); err != nil {
+		return err
+	}
+
+	for _, hook := range hooks {
+		if hook.URL == hookURL {
+			return nil
+		}
+	}
+
+	_, err := c.do(ctx, http.MethodPost, projectPath(project)+"/hooks", nil, &Hook{
+		URL:                 hookURL,
+		Token:               token,
+		MergeRequestsEvents: true,
+	}, nil)
+
+	return err
+}
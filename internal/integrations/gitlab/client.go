@@ -0,0 +1,206 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+// DefaultInstanceURL is the URL of the hosted GitLab instance
+const DefaultInstanceURL = "https://gitlab.com"
+
+// Client is a client for the GitLab REST API (v4). It supports both gitlab.com and
+// self-hosted GitLab instances.
+type Client struct {
+	instanceURL string
+	httpClient  *http.Client
+	token       string
+}
+
+// NewClient returns a client which authenticates using the given http client, for example
+// one returned by an oauth2.Config
+func NewClient(instanceURL string, httpClient *http.Client) *Client {
+	return &Client{
+		instanceURL: strings.TrimSuffix(instanceURL, "/"),
+		httpClient:  httpClient,
+	}
+}
+
+// NewTokenClient returns a client which authenticates using a personal, project or group
+// access token
+func NewTokenClient(instanceURL, token string) *Client {
+	return &Client{
+		instanceURL: strings.TrimSuffix(instanceURL, "/"),
+		httpClient:  http.DefaultClient,
+		token:       token,
+	}
+}
+
+// OAuthConfig returns the OAuth config for a GitLab application registered on an instance
+func OAuthConfig(instanceURL, clientID, clientSecret, redirectURL string) *oauth2.Config {
+	instanceURL = strings.TrimSuffix(instanceURL, "/")
+
+	return &oauth2.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   instanceURL + "/oauth/authorize",
+			TokenURL:  instanceURL + "/oauth/token",
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+		RedirectURL: redirectURL,
+		Scopes:      []string{"api", "read_user"},
+	}
+}
+
+// InstanceURL returns the URL of the GitLab instance
+func (c *Client) InstanceURL() string {
+	return c.instanceURL
+}
+
+// ErrorResponse is returned when the GitLab API responds with a non-2xx status code
+type ErrorResponse struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *ErrorResponse) Error() string {
+	return fmt.Sprintf("gitlab API returned status %d: %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound returns true if the error is a 404 from the GitLab API
+func IsNotFound(err error) bool {
+	errResp, ok := err.(*ErrorResponse)
+
+	return ok && errResp.StatusCode == http.StatusNotFound
+}
+
+// projectPath returns the API path for a project, which can be referenced by its full path
+// (such as "group/subgroup/name") if it is URL-encoded
+func projectPath(project string) string {
+	return "projects/" + url.PathEscape(project)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out interface{}) (*http.Response, error) {
+	reqURL := fmt.Sprintf("%s/api/v4/%s", c.instanceURL, path)
+
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+
+	var bodyReader io.Reader
+
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+
+		if err != nil {
+			return nil, err
+		}
+
+		bodyReader = bytes.NewReader(bodyBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, bodyReader)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.token != "" {
+		req.Header.Set("PRIVATE-TOKEN", c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	respBytes, err := ioutil.ReadAll(resp.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		errResp := &ErrorResponse{
+			StatusCode: resp.StatusCode,
+			Message:    string(respBytes),
+		}
+
+		// gitlab returns errors as {"message": ...} or {"error": ...}
+		msg := struct {
+			Message interface{} `json:"message"`
+			Error   string      `json:"error"`
+		}{}
+
+		if err := json.Unmarshal(respBytes, &msg); err == nil {
+			if msg.Message != nil {
+				errResp.Message = fmt.Sprintf("%v", msg.Message)
+			} else if msg.Error != "" {
+				errResp.Message = msg.Error
+			}
+		}
+
+		return resp, errResp
+	}
+
+	if out != nil && len(respBytes) > 0 {
+		if raw, ok := out.(*[]byte); ok {
+			*raw = respBytes
+		} else if err := json.Unmarshal(respBytes, out); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// listAll requests every page of a list endpoint, passing the body of each page to onPage
+func (c *Client) listAll(ctx context.Context, path string, query url.Values, onPage func(body []byte) error) error {
+	if query == nil {
+		query = url.Values{}
+	}
+
+	query.Set("per_page", "100")
+	page := 1
+
+	for {
+		query.Set("page", strconv.Itoa(page))
+
+		body := make([]byte, 0)
+
+		resp, err := c.do(ctx, http.MethodGet, path, query, nil, &body)
+
+		if err != nil {
+			return err
+		}
+
+		if err := onPage(body); err != nil {
+			return err
+		}
+
+		nextPage, err := strconv.Atoi(resp.Header.Get("X-Next-Page"))
+
+		if err != nil || nextPage <= page {
+			return nil
+		}
+
+		page = nextPage
+	}
+}
@@ -0,0 +1,80 @@
+package gitlab
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+const (
+	// EventTypeMergeRequest is the value of the X-Gitlab-Event header for merge request events
+	EventTypeMergeRequest = "Merge Request Hook"
+
+	eventHeader = "X-Gitlab-Event"
+	tokenHeader = "X-Gitlab-Token"
+)
+
+// Merge request actions sent in merge request events
+const (
+	MergeRequestActionOpen   = "open"
+	MergeRequestActionReopen = "reopen"
+	MergeRequestActionUpdate = "update"
+	MergeRequestActionClose  = "close"
+	MergeRequestActionMerge  = "merge"
+)
+
+// MergeRequestEvent is the payload of a merge request webhook event
+type MergeRequestEvent struct {
+	ObjectKind string `json:"object_kind"`
+
+	Project struct {
+		ID                int    `json:"id"`
+		Name              string `json:"name"`
+		Namespace         string `json:"namespace"`
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+
+	ObjectAttributes struct {
+		IID          uint   `json:"iid"`
+		Title        string `json:"title"`
+		State        string `json:"state"`
+		Action       string `json:"action"`
+		SourceBranch string `json:"source_branch"`
+		TargetBranch string `json:"target_branch"`
+		// Oldrev is only set on update events which add commits to the merge request
+		Oldrev string `json:"oldrev"`
+
+		LastCommit struct {
+			ID string `json:"id"`
+		} `json:"last_commit"`
+	} `json:"object_attributes"`
+}
+
+// ParseMergeRequestEvent validates the secret token of a webhook request and parses the
+// merge request event in the body. It returns a nil event if the request is a different
+// kind of event.
+func ParseMergeRequestEvent(r *http.Request, secret string) (*MergeRequestEvent, error) {
+	if secret == "" || subtle.ConstantTimeCompare([]byte(r.Header.Get(tokenHeader)), []byte(secret)) != 1 {
+		return nil, fmt.Errorf("invalid gitlab webhook token")
+	}
+
+	if r.Header.Get(eventHeader) != EventTypeMergeRequest {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	event := &MergeRequestEvent{}
+
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
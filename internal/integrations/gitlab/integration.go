@@ -0,0 +1,47 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/oauth"
+	"github.com/porter-dev/porter/internal/repository"
+	"golang.org/x/oauth2"
+
+	ints "github.com/porter-dev/porter/internal/models/integrations"
+)
+
+// NewClientFromIntegration returns a client authenticated with a GitLab integration. For
+// OAuth integrations, the access token is refreshed and saved if it has expired.
+func NewClientFromIntegration(gi *ints.GitlabIntegration, repo repository.Repository) (*Client, error) {
+	switch gi.AuthMechanism {
+	case types.GitlabAuthPersonalAccessToken:
+		return NewTokenClient(gi.InstanceURL, string(gi.AccessToken)), nil
+	case types.GitlabAuthOAuth:
+		if len(gi.AccessToken) == 0 {
+			return nil, fmt.Errorf("gitlab integration %d has not been authorized", gi.ID)
+		}
+
+		conf := OAuthConfig(gi.InstanceURL, string(gi.ClientID), string(gi.AppClientSecret), "")
+
+		accessToken, expiry, err := oauth.GetAccessToken(
+			gi.SharedOAuthModel,
+			conf,
+			oauth.MakeUpdateGitlabIntegrationFunction(gi, repo),
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return NewClient(gi.InstanceURL, conf.Client(context.Background(), &oauth2.Token{
+			AccessToken:  accessToken,
+			RefreshToken: string(gi.RefreshToken),
+			Expiry:       *expiry,
+			TokenType:    "Bearer",
+		})), nil
+	}
+
+	return nil, fmt.Errorf("unknown gitlab auth mechanism %s", gi.AuthMechanism)
+}
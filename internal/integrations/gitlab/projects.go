@@ -0,0 +1,237 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// User is the GitLab user that the client is authenticated as
+type User struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+// Project is a GitLab project (repository)
+type Project struct {
+	ID                int    `json:"id"`
+	Name              string `json:"name"`
+	Path              string `json:"path"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	DefaultBranch     string `json:"default_branch"`
+	WebURL            string `json:"web_url"`
+
+	Namespace struct {
+		FullPath string `json:"full_path"`
+	} `json:"namespace"`
+}
+
+// Branch is a branch of a GitLab project
+type Branch struct {
+	Name      string `json:"name"`
+	Protected bool   `json:"protected"`
+
+	Commit struct {
+		ID string `json:"id"`
+	} `json:"commit"`
+}
+
+// TreeItem is a file ("blob") or directory ("tree") in a project repository
+type TreeItem struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	Type string `json:"type"`
+}
+
+// developerAccessLevel is the minimum access level required to push to a project
+const developerAccessLevel = "30"
+
+// GetCurrentUser returns the user that the client is authenticated as
+func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
+	user := &User{}
+
+	if _, err := c.do(ctx, http.MethodGet, "user", nil, nil, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ListProjects lists the projects which the authenticated user can push to
+func (c *Client) ListProjects(ctx context.Context) ([]*Project, error) {
+	res := make([]*Project, 0)
+
+	query := url.Values{}
+	query.Set("membership", "true")
+	query.Set("min_access_level", developerAccessLevel)
+	query.Set("archived", "false")
+	query.Set("simple", "true")
+
+	err := c.listAll(ctx, "projects", query, func(body []byte) error {
+		page := make([]*Project, 0)
+
+		if err := json.Unmarshal(body, &page); err != nil {
+			return err
+		}
+
+		res = append(res, page...)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetProject returns a project by its full path, such as "group/subgroup/name"
+func (c *Client) GetProject(ctx context.Context, project string) (*Project, error) {
+	res := &Project{}
+
+	if _, err := c.do(ctx, http.MethodGet, projectPath(project), nil, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ListBranches lists the branches of a project
+func (c *Client) ListBranches(ctx context.Context, project string) ([]*Branch, error) {
+	res := make([]*Branch, 0)
+
+	err := c.listAll(ctx, projectPath(project)+"/repository/branches", nil, func(body []byte) error {
+		page := make([]*Branch, 0)
+
+		if err := json.Unmarshal(body, &page); err != nil {
+			return err
+		}
+
+		res = append(res, page...)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ListTree lists the files and directories in a directory of a project repository
+func (c *Client) ListTree(ctx context.Context, project, ref, dir string) ([]*TreeItem, error) {
+	res := make([]*TreeItem, 0)
+
+	query := url.Values{}
+	query.Set("ref", ref)
+
+	if dir != "" && dir != "." && dir != "./" {
+		query.Set("path", dir)
+	}
+
+	err := c.listAll(ctx, projectPath(project)+"/repository/tree", query, func(body []byte) error {
+		page := make([]*TreeItem, 0)
+
+		if err := json.Unmarshal(body, &page); err != nil {
+			return err
+		}
+
+		res = append(res, page...)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetRawFile returns the contents of a file in a project repository
+func (c *Client) GetRawFile(ctx context.Context, project, ref, filePath string) ([]byte, error) {
+	query := url.Values{}
+	query.Set("ref", ref)
+
+	res := make([]byte, 0)
+
+	_, err := c.do(
+		ctx,
+		http.MethodGet,
+		projectPath(project)+"/repository/files/"+url.PathEscape(filePath)+"/raw",
+		query,
+		nil,
+		&res,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+type commitFileRequest struct {
+	Branch        string `json:"branch"`
+	Content       string `json:"content"`
+	Encoding      string `json:"encoding"`
+	CommitMessage string `json:"commit_message"`
+}
+
+// CommitFile creates or updates a file in a project repository
+func (c *Client) CommitFile(ctx context.Context, project, branch, filePath string, contents []byte, message string) error {
+	path := projectPath(project) + "/repository/files/" + url.PathEscape(filePath)
+
+	req := &commitFileRequest{
+		Branch:        branch,
+		Content:       base64.StdEncoding.EncodeToString(contents),
+		Encoding:      "base64",
+		CommitMessage: message,
+	}
+
+	_, err := c.GetRawFile(ctx, project, branch, filePath)
+
+	if IsNotFound(err) {
+		_, err = c.do(ctx, http.MethodPost, path, nil, req, nil)
+
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, http.MethodPut, path, nil, req, nil)
+
+	return err
+}
+
+type deleteFileRequest struct {
+	Branch        string `json:"branch"`
+	CommitMessage string `json:"commit_message"`
+}
+
+// DeleteFile deletes a file from a project repository. It does not return an error if the
+// file does not exist.
+func (c *Client) DeleteFile(ctx context.Context, project, branch, filePath, message string) error {
+	_, err := c.do(
+		ctx,
+		http.MethodDelete,
+		projectPath(project)+"/repository/files/"+url.PathEscape(filePath),
+		nil,
+		&deleteFileRequest{
+			Branch:        branch,
+			CommitMessage: message,
+		},
+		nil,
+	)
+
+	if IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
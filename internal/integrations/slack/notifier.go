@@ -19,9 +19,13 @@ type Notifier interface {
 type DeploymentStatus string
 
 const (
-	StatusHelmDeployed DeploymentStatus = "helm_deployed"
-	StatusPodCrashed   DeploymentStatus = "pod_crashed"
-	StatusHelmFailed   DeploymentStatus = "helm_failed"
+	StatusHelmDeployed  DeploymentStatus = "helm_deployed"
+	StatusPodCrashed    DeploymentStatus = "pod_crashed"
+	StatusHelmFailed    DeploymentStatus = "helm_failed"
+	StatusDriftDetected DeploymentStatus = "drift_detected"
+	StatusRolledBack    DeploymentStatus = "rolled_back"
+	StatusAlertFiring   DeploymentStatus = "alert_firing"
+	StatusAlertResolved DeploymentStatus = "alert_resolved"
 )
 
 type NotifyOpts struct {
@@ -94,6 +98,15 @@ func (s *SlackNotifier) Notify(opts *NotifyOpts) error {
 		if opts.Status == StatusHelmFailed && !s.Config.Failure {
 			return nil
 		}
+		if opts.Status == StatusDriftDetected && !s.Config.Failure {
+			return nil
+		}
+		if opts.Status == StatusRolledBack && !s.Config.Failure {
+			return nil
+		}
+		if (opts.Status == StatusAlertFiring || opts.Status == StatusAlertResolved) && !s.Config.Failure {
+			return nil
+		}
 	}
 
 	// we create a basic payload as a fallback if the detailed payload with "info" fails, due to
@@ -144,6 +157,12 @@ func getSlackBlocks(opts *NotifyOpts) ([]*SlackBlock, []*SlackBlock) {
 		res = append(res, getHelmMessageBlock(opts))
 	} else if opts.Status == StatusPodCrashed {
 		res = append(res, getPodCrashedMessageBlock(opts))
+	} else if opts.Status == StatusDriftDetected {
+		res = append(res, getDriftDetectedMessageBlock(opts))
+	} else if opts.Status == StatusRolledBack {
+		res = append(res, getRolledBackMessageBlock(opts))
+	} else if opts.Status == StatusAlertFiring || opts.Status == StatusAlertResolved {
+		res = append(res, getAlertMessageBlock(opts))
 	}
 
 	res = append(
@@ -162,7 +181,7 @@ func getSlackBlocks(opts *NotifyOpts) ([]*SlackBlock, []*SlackBlock) {
 		)
 	}
 
-	if opts.Status == StatusHelmDeployed || opts.Status == StatusHelmFailed {
+	if opts.Status == StatusHelmDeployed || opts.Status == StatusHelmFailed || opts.Status == StatusRolledBack {
 		res = append(res, getMarkdownBlock(fmt.Sprintf("*Version:* %d", opts.Version)))
 	}
 
@@ -216,14 +235,59 @@ func getPodCrashedMessageBlock(opts *NotifyOpts) *SlackBlock {
 	return getMarkdownBlock(md)
 }
 
+func getDriftDetectedMessageBlock(opts *NotifyOpts) *SlackBlock {
+	md := fmt.Sprintf(
+		":warning: The live resources of your application %s have drifted from the deployed release. <%s|View the application.>",
+		"`"+opts.Name+"`",
+		opts.URL,
+	)
+
+	return getMarkdownBlock(md)
+}
+
+func getRolledBackMessageBlock(opts *NotifyOpts) *SlackBlock {
+	md := fmt.Sprintf(
+		":rewind: Your application %s failed its rollout health checks and was automatically rolled back on Porter. <%s|View the application.>",
+		"`"+opts.Name+"`",
+		opts.URL,
+	)
+
+	return getMarkdownBlock(md)
+}
+
+func getAlertMessageBlock(opts *NotifyOpts) *SlackBlock {
+	var md string
+
+	switch opts.Status {
+	case StatusAlertFiring:
+		md = fmt.Sprintf(
+			":rotating_light: An alert is firing for your application %s on Porter. <%s|View the application.>",
+			"`"+opts.Name+"`",
+			opts.URL,
+		)
+	case StatusAlertResolved:
+		md = fmt.Sprintf(
+			":white_check_mark: An alert for your application %s has been resolved. <%s|View the application.>",
+			"`"+opts.Name+"`",
+			opts.URL,
+		)
+	}
+
+	return getMarkdownBlock(md)
+}
+
 func getInfoBlock(opts *NotifyOpts) *SlackBlock {
 	var md string
 
 	switch opts.Status {
+	case StatusDriftDetected, StatusAlertFiring, StatusAlertResolved:
+		md = fmt.Sprintf("```\n%s\n```", opts.Info)
 	case StatusHelmFailed:
 		md = getFailedInfoMessage(opts)
 	case StatusPodCrashed:
 		md = getFailedInfoMessage(opts)
+	case StatusRolledBack:
+		md = getFailedInfoMessage(opts)
 	default:
 		return nil
 	}
@@ -0,0 +1,25 @@
+package cost
+
+import (
+	"errors"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// GetClusterCostConfig reads the cost config for a cluster. If no config has been saved, an
+// empty config is returned, so every node will be reported as unpriced.
+func GetClusterCostConfig(repo repository.Repository, projectID, clusterID uint) (*types.ClusterCostConfig, error) {
+	conf, err := repo.ClusterCostConfig().ReadClusterCostConfig(projectID, clusterID)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &types.ClusterCostConfig{
+			NodePrices: make([]*types.NodeTypePrice, 0),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return conf.ToClusterCostConfigType(), nil
+}
@@ -0,0 +1,267 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/prometheus"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// cpuToMemoryCostRatio is the relative cost of one CPU core to one GB of memory, used to split
+// the price of a node between CPU and memory. This matches the ratio of on-demand vCPU and
+// memory prices across the major cloud providers.
+const cpuToMemoryCostRatio = 7.5
+
+// instanceTypeLabels are the node labels which contain the instance type of a node
+var instanceTypeLabels = []string{"node.kubernetes.io/instance-type", "beta.kubernetes.io/instance-type"}
+
+// Pricing contains the hourly prices of nodes, keyed by instance type
+type Pricing struct {
+	DefaultHourlyPrice float64
+	InstanceTypePrices map[string]float64
+}
+
+// NewPricing creates a Pricing from a cluster cost config
+func NewPricing(conf *types.ClusterCostConfig) *Pricing {
+	res := &Pricing{
+		DefaultHourlyPrice: conf.DefaultHourlyPrice,
+		InstanceTypePrices: make(map[string]float64),
+	}
+
+	for _, price := range conf.NodePrices {
+		res.InstanceTypePrices[price.InstanceType] = price.HourlyPrice
+	}
+
+	return res
+}
+
+// NodePrice returns the hourly price for a node, and false if no price is configured for it
+func (p *Pricing) NodePrice(node *v1.Node) (float64, bool) {
+	for _, label := range instanceTypeLabels {
+		if instanceType, exists := node.Labels[label]; exists {
+			if price, exists := p.InstanceTypePrices[instanceType]; exists {
+				return price, true
+			}
+		}
+	}
+
+	return p.DefaultHourlyPrice, p.DefaultHourlyPrice > 0
+}
+
+// ParseWindow parses the length of a reporting window. In addition to Go durations such as
+// "12h", windows can be given in days, such as "7d".
+func ParseWindow(window string) (time.Duration, error) {
+	if window == "" {
+		return 24 * time.Hour, nil
+	}
+
+	var res time.Duration
+
+	if strings.HasSuffix(window, "d") {
+		days, err := strconv.ParseFloat(strings.TrimSuffix(window, "d"), 64)
+
+		if err != nil {
+			return 0, fmt.Errorf("invalid window %s", window)
+		}
+
+		res = time.Duration(days * float64(24*time.Hour))
+	} else {
+		var err error
+
+		res, err = time.ParseDuration(window)
+
+		if err != nil {
+			return 0, fmt.Errorf("invalid window %s", window)
+		}
+	}
+
+	if res < time.Hour {
+		return 0, fmt.Errorf("window must be at least 1h")
+	}
+
+	return res, nil
+}
+
+type ReportOpts struct {
+	By     types.CostAllocationGroup
+	Method types.CostAllocationMethod
+	Window time.Duration
+}
+
+// GetCostReport computes the cost of a cluster over the reporting window, and attributes
+// the cost to namespaces or releases
+func GetCostReport(clientset kubernetes.Interface, pricing *Pricing, opts *ReportOpts) (*types.CostReport, error) {
+	now := time.Now()
+
+	nodeList, err := clientset.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	resolver, err := NewReleaseResolver(clientset)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []*PodUsage
+
+	switch opts.Method {
+	case types.CostAllocationMethodUsage:
+		promSvc, found, err := prometheus.GetPrometheusService(clientset)
+
+		if err != nil {
+			return nil, err
+		} else if !found {
+			return nil, fmt.Errorf("prometheus must be installed to allocate costs by usage")
+		}
+
+		usages, err = GetPrometheusUsage(clientset, promSvc, resolver, opts.Window)
+
+		if err != nil {
+			return nil, err
+		}
+	default:
+		podList, err := clientset.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{})
+
+		if err != nil {
+			return nil, err
+		}
+
+		usages = GetRequestedUsage(podList.Items, resolver, opts.Window, now)
+	}
+
+	return Allocate(nodeList.Items, pricing, usages, opts, now), nil
+}
+
+type nodeRates struct {
+	cpuRate float64
+	memRate float64
+}
+
+// Allocate attributes the cost of the given nodes to the pod usages. The price of each node
+// is split into a price per core-hour and a price per GB-hour, and pods are charged at the
+// rates of the node they ran on. Pods whose node is unknown are charged the cluster average
+// rates. Node capacity which is not attributed to any pod is reported as idle.
+func Allocate(
+	nodes []v1.Node,
+	pricing *Pricing,
+	usages []*PodUsage,
+	opts *ReportOpts,
+	now time.Time,
+) *types.CostReport {
+	res := &types.CostReport{
+		By:            opts.By,
+		Method:        opts.Method,
+		Start:         now.Add(-1 * opts.Window),
+		End:           now,
+		UnpricedNodes: make([]string, 0),
+		Allocations:   make([]*types.CostAllocation, 0),
+	}
+
+	rates := make(map[string]*nodeRates)
+
+	var totalCPUCoreHours, totalMemGBHours float64
+
+	for i := range nodes {
+		node := &nodes[i]
+		price, ok := pricing.NodePrice(node)
+
+		if !ok {
+			res.UnpricedNodes = append(res.UnpricedNodes, node.Name)
+		}
+
+		hours := lifetimeHours(node.CreationTimestamp.Time, opts.Window, now)
+		cores := float64(node.Status.Allocatable.Cpu().MilliValue()) / 1000
+		memGB := float64(node.Status.Allocatable.Memory().Value()) / bytesPerGB
+
+		totalCPUCoreHours += cores * hours
+		totalMemGBHours += memGB * hours
+		res.TotalCost += price * hours
+
+		if cores*cpuToMemoryCostRatio+memGB == 0 {
+			continue
+		}
+
+		memRate := price / (cores*cpuToMemoryCostRatio + memGB)
+
+		rates[node.Name] = &nodeRates{
+			cpuRate: memRate * cpuToMemoryCostRatio,
+			memRate: memRate,
+		}
+	}
+
+	avgRates := &nodeRates{}
+
+	if totalCPUCoreHours*cpuToMemoryCostRatio+totalMemGBHours > 0 {
+		avgRates.memRate = res.TotalCost / (totalCPUCoreHours*cpuToMemoryCostRatio + totalMemGBHours)
+		avgRates.cpuRate = avgRates.memRate * cpuToMemoryCostRatio
+	}
+
+	allocations := make(map[string]*types.CostAllocation)
+
+	var allocCPUCoreHours, allocMemGBHours float64
+
+	for _, usage := range usages {
+		rate, exists := rates[usage.Node]
+
+		if !exists {
+			rate = avgRates
+		}
+
+		key, alloc := usage.Namespace, &types.CostAllocation{Name: usage.Namespace}
+
+		if opts.By == types.CostAllocationGroupRelease {
+			key = usage.Namespace + "/" + usage.Release
+			alloc = &types.CostAllocation{Name: usage.Release, Namespace: usage.Namespace}
+		}
+
+		if _, exists := allocations[key]; !exists {
+			allocations[key] = alloc
+		}
+
+		alloc = allocations[key]
+
+		cpuCost := usage.CPUCoreHours * rate.cpuRate
+		memCost := usage.MemoryGBHours * rate.memRate
+
+		alloc.CPUCoreHours += usage.CPUCoreHours
+		alloc.MemoryGBHours += usage.MemoryGBHours
+		alloc.CPUCost += cpuCost
+		alloc.MemoryCost += memCost
+		alloc.TotalCost += cpuCost + memCost
+
+		allocCPUCoreHours += usage.CPUCoreHours
+		allocMemGBHours += usage.MemoryGBHours
+		res.AllocatedCost += cpuCost + memCost
+	}
+
+	for _, alloc := range allocations {
+		res.Allocations = append(res.Allocations, alloc)
+	}
+
+	sort.SliceStable(res.Allocations, func(i, j int) bool {
+		if res.Allocations[i].TotalCost != res.Allocations[j].TotalCost {
+			return res.Allocations[i].TotalCost > res.Allocations[j].TotalCost
+		}
+
+		return res.Allocations[i].Namespace+"/"+res.Allocations[i].Name < res.Allocations[j].Namespace+"/"+res.Allocations[j].Name
+	})
+
+	res.IdleCost = math.Max(res.TotalCost-res.AllocatedCost, 0)
+	res.IdleCPUCoreHours = math.Max(totalCPUCoreHours-allocCPUCoreHours, 0)
+	res.IdleMemoryGBHours = math.Max(totalMemGBHours-allocMemGBHours, 0)
+
+	return res
+}
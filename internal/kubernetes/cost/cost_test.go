@@ -0,0 +1,107 @@
+package cost_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/cost"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseWindow(t *testing.T) {
+	tests := map[string]time.Duration{
+		"":    24 * time.Hour,
+		"7d":  7 * 24 * time.Hour,
+		"12h": 12 * time.Hour,
+	}
+
+	for window, expected := range tests {
+		res, err := cost.ParseWindow(window)
+
+		if err != nil {
+			t.Fatalf("window %q: unexpected error: %v", window, err)
+		}
+
+		if res != expected {
+			t.Errorf("window %q: expected %s, got %s", window, expected, res)
+		}
+	}
+
+	for _, window := range []string{"10m", "abc", "xd"} {
+		if _, err := cost.ParseWindow(window); err == nil {
+			t.Errorf("window %q: expected error", window)
+		}
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	now := time.Now()
+
+	node := v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "node-1",
+			Labels:            map[string]string{"node.kubernetes.io/instance-type": "t3.medium"},
+			CreationTimestamp: metav1.NewTime(now.Add(-48 * time.Hour)),
+		},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("2"),
+				v1.ResourceMemory: resource.MustParse("4Gi"),
+			},
+		},
+	}
+
+	unpriced := v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "node-2",
+			Labels:            map[string]string{"node.kubernetes.io/instance-type": "m5.large"},
+			CreationTimestamp: metav1.NewTime(now.Add(-48 * time.Hour)),
+		},
+	}
+
+	pricing := cost.NewPricing(&types.ClusterCostConfig{
+		NodePrices: []*types.NodeTypePrice{{InstanceType: "t3.medium", HourlyPrice: 0.19}},
+	})
+
+	// each pod requests half of the node for the full window
+	usages := []*cost.PodUsage{
+		{Namespace: "default", Pod: "web-abc-1", Node: "node-1", Release: "web", CPUCoreHours: 24, MemoryGBHours: 48},
+		{Namespace: "default", Pod: "worker-abc-1", Node: "node-1", Release: "worker", CPUCoreHours: 12, MemoryGBHours: 24},
+	}
+
+	report := cost.Allocate([]v1.Node{node, unpriced}, pricing, usages, &cost.ReportOpts{
+		By:     types.CostAllocationGroupRelease,
+		Method: types.CostAllocationMethodRequests,
+		Window: 24 * time.Hour,
+	}, now)
+
+	expTotal := 0.19 * 24
+
+	if !approxEqual(report.TotalCost, expTotal) {
+		t.Errorf("expected total cost %f, got %f", expTotal, report.TotalCost)
+	}
+
+	if len(report.Allocations) != 2 || report.Allocations[0].Name != "web" {
+		t.Fatalf("expected allocations for web and worker, sorted by cost")
+	}
+
+	if !approxEqual(report.Allocations[0].TotalCost, expTotal/2) {
+		t.Errorf("expected web cost %f, got %f", expTotal/2, report.Allocations[0].TotalCost)
+	}
+
+	if !approxEqual(report.IdleCost, expTotal/4) {
+		t.Errorf("expected idle cost %f, got %f", expTotal/4, report.IdleCost)
+	}
+
+	if len(report.UnpricedNodes) != 1 || report.UnpricedNodes[0] != "node-2" {
+		t.Errorf("expected node-2 to be unpriced, got %v", report.UnpricedNodes)
+	}
+}
+
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
@@ -0,0 +1,116 @@
+package cost
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// releaseLabels are the labels used to link a workload to the Helm release it belongs to
+var releaseLabels = []string{"app.kubernetes.io/instance", "release"}
+
+// ReleaseResolver links pods to Helm releases. Pods reported by Prometheus may no longer exist,
+// so pods are also resolved through the controllers which created them.
+type ReleaseResolver struct {
+	pods     map[string]string
+	owners   map[string]string
+	podNodes map[string]string
+}
+
+// NewReleaseResolver lists the pods and pod controllers in the cluster and builds a resolver
+func NewReleaseResolver(clientset kubernetes.Interface) (*ReleaseResolver, error) {
+	res := &ReleaseResolver{
+		pods:     make(map[string]string),
+		owners:   make(map[string]string),
+		podNodes: make(map[string]string),
+	}
+
+	pods, err := clientset.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pod := range pods.Items {
+		key := pod.Namespace + "/" + pod.Name
+
+		res.pods[key] = releaseFromLabels(pod.Labels)
+		res.podNodes[key] = pod.Spec.NodeName
+	}
+
+	replicaSets, err := clientset.AppsV1().ReplicaSets("").List(context.TODO(), metav1.ListOptions{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rs := range replicaSets.Items {
+		res.owners[rs.Namespace+"/"+rs.Name] = releaseFromLabels(rs.Labels)
+	}
+
+	statefulSets, err := clientset.AppsV1().StatefulSets("").List(context.TODO(), metav1.ListOptions{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ss := range statefulSets.Items {
+		res.owners[ss.Namespace+"/"+ss.Name] = releaseFromLabels(ss.Labels)
+	}
+
+	daemonSets, err := clientset.AppsV1().DaemonSets("").List(context.TODO(), metav1.ListOptions{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ds := range daemonSets.Items {
+		res.owners[ds.Namespace+"/"+ds.Name] = releaseFromLabels(ds.Labels)
+	}
+
+	jobs, err := clientset.BatchV1().Jobs("").List(context.TODO(), metav1.ListOptions{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, job := range jobs.Items {
+		res.owners[job.Namespace+"/"+job.Name] = releaseFromLabels(job.Labels)
+	}
+
+	return res, nil
+}
+
+// Release returns the name of the release a pod belongs to, or an empty string if the pod
+// cannot be linked to a release
+func (r *ReleaseResolver) Release(namespace, pod string) string {
+	if release, exists := r.pods[namespace+"/"+pod]; exists {
+		return release
+	}
+
+	// pods created by a controller are named after the controller with a generated suffix
+	if i := strings.LastIndex(pod, "-"); i > 0 {
+		return r.owners[namespace+"/"+pod[:i]]
+	}
+
+	return ""
+}
+
+// Node returns the node a pod is currently scheduled on, if the pod still exists
+func (r *ReleaseResolver) Node(namespace, pod string) string {
+	return r.podNodes[namespace+"/"+pod]
+}
+
+func releaseFromLabels(lbls labels.Set) string {
+	for _, label := range releaseLabels {
+		if lbls.Has(label) {
+			return lbls.Get(label)
+		}
+	}
+
+	return ""
+}
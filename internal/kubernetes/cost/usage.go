@@ -0,0 +1,155 @@
+package cost
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/porter-dev/porter/internal/kubernetes/nodes"
+	"github.com/porter-dev/porter/internal/kubernetes/prometheus"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const bytesPerGB = 1 << 30
+
+// PodUsage is the amount of resources attributed to a single pod over the reporting window
+type PodUsage struct {
+	Namespace string
+	Pod       string
+	Node      string
+	Release   string
+
+	CPUCoreHours  float64
+	MemoryGBHours float64
+}
+
+// GetRequestedUsage computes the resources requested by the given pods over the reporting
+// window. Since requests are not stored historically, this assumes the pods have requested
+// the same resources since they started, or since the start of the window.
+func GetRequestedUsage(pods []v1.Pod, resolver *ReleaseResolver, window time.Duration, now time.Time) []*PodUsage {
+	res := make([]*PodUsage, 0)
+
+	for i := range pods {
+		pod := &pods[i]
+
+		if pod.Spec.NodeName == "" || pod.Status.Phase != v1.PodRunning {
+			continue
+		}
+
+		hours := window.Hours()
+
+		if pod.Status.StartTime != nil {
+			hours = lifetimeHours(pod.Status.StartTime.Time, window, now)
+		}
+
+		reqs := nodes.GetPodRequests(pod)
+
+		res = append(res, &PodUsage{
+			Namespace:     pod.Namespace,
+			Pod:           pod.Name,
+			Node:          pod.Spec.NodeName,
+			Release:       resolver.Release(pod.Namespace, pod.Name),
+			CPUCoreHours:  float64(reqs.Cpu().MilliValue()) / 1000 * hours,
+			MemoryGBHours: float64(reqs.Memory().Value()) / bytesPerGB * hours,
+		})
+	}
+
+	return res
+}
+
+// GetPrometheusUsage queries Prometheus for the CPU and memory used by all pods over the
+// reporting window, including pods which no longer exist
+func GetPrometheusUsage(
+	clientset kubernetes.Interface,
+	promSvc *v1.Service,
+	resolver *ReleaseResolver,
+	window time.Duration,
+) ([]*PodUsage, error) {
+	step := 5 * time.Minute
+
+	if window > 24*time.Hour {
+		step = time.Hour
+	}
+
+	// the usage is sampled at each step over the window, so the sum of the samples multiplied
+	// by the step is the total usage in core-hours or byte-hours
+	subquery := fmt.Sprintf("[%ds:%ds]", int64(window.Seconds()), int64(step.Seconds()))
+
+	cpuQuery := fmt.Sprintf(
+		`sum_over_time(sum by (namespace, pod, node) (rate(container_cpu_usage_seconds_total{container!="POD",container!=""}[5m]))%s)`,
+		subquery,
+	)
+
+	memQuery := fmt.Sprintf(
+		`sum_over_time(sum by (namespace, pod, node) (container_memory_working_set_bytes{container!="POD",container!=""})%s)`,
+		subquery,
+	)
+
+	cpuSamples, err := prometheus.QueryInstant(clientset, promSvc, cpuQuery)
+
+	if err != nil {
+		return nil, err
+	}
+
+	memSamples, err := prometheus.QueryInstant(clientset, promSvc, memQuery)
+
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make(map[string]*PodUsage)
+
+	getUsage := func(sample *prometheus.VectorSample) *PodUsage {
+		namespace, pod := sample.Labels["namespace"], sample.Labels["pod"]
+		key := namespace + "/" + pod
+
+		if _, exists := usages[key]; !exists {
+			node := sample.Labels["node"]
+
+			if node == "" {
+				node = resolver.Node(namespace, pod)
+			}
+
+			usages[key] = &PodUsage{
+				Namespace: namespace,
+				Pod:       pod,
+				Node:      node,
+				Release:   resolver.Release(namespace, pod),
+			}
+		}
+
+		return usages[key]
+	}
+
+	for _, sample := range cpuSamples {
+		getUsage(sample).CPUCoreHours += sample.Value * step.Hours()
+	}
+
+	for _, sample := range memSamples {
+		getUsage(sample).MemoryGBHours += sample.Value / bytesPerGB * step.Hours()
+	}
+
+	res := make([]*PodUsage, 0, len(usages))
+
+	for _, usage := range usages {
+		res = append(res, usage)
+	}
+
+	return res, nil
+}
+
+// lifetimeHours returns the number of hours between the start time and now, capped at the
+// length of the reporting window
+func lifetimeHours(start time.Time, window time.Duration, now time.Time) float64 {
+	lifetime := now.Sub(start)
+
+	if lifetime > window {
+		lifetime = window
+	}
+
+	if lifetime < 0 {
+		return 0
+	}
+
+	return lifetime.Hours()
+}
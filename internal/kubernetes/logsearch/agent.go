@@ -0,0 +1,89 @@
+package logsearch
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	porter_agent "github.com/porter-dev/porter/internal/kubernetes/porter_agent/v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// agentBackend searches the logs which are stored by the porter-agent
+type agentBackend struct {
+	clientset kubernetes.Interface
+	service   *v1.Service
+}
+
+func newAgentBackend(clientset kubernetes.Interface) (*agentBackend, error) {
+	service, err := porter_agent.GetAgentService(clientset)
+
+	if err != nil {
+		return nil, fmt.Errorf("no log backend is installed in the cluster: %w", err)
+	}
+
+	if len(service.Spec.Ports) == 0 {
+		return nil, fmt.Errorf("agent service has no exposed ports to query")
+	}
+
+	return &agentBackend{clientset, service}, nil
+}
+
+func (a *agentBackend) Name() string {
+	return "porter-agent"
+}
+
+func (a *agentBackend) Search(q *Query) (*types.SearchLogsResponse, error) {
+	params := map[string]string{
+		"namespace":  q.Namespace,
+		"start_time": fmt.Sprintf("%d", q.Start.Unix()),
+		"end_time":   fmt.Sprintf("%d", q.End.Unix()),
+		"limit":      fmt.Sprintf("%d", q.Limit),
+	}
+
+	if q.ReleaseName != "" {
+		params["pod_prefix"] = q.ReleaseName + "-"
+	}
+
+	if q.PodName != "" {
+		params["pod_name"] = q.PodName
+	}
+
+	if q.ContainerName != "" {
+		params["container_name"] = q.ContainerName
+	}
+
+	if q.Search != "" && q.Regex {
+		params["regex"] = q.Search
+	} else if q.Search != "" {
+		params["search"] = q.Search
+	}
+
+	if q.Cursor != "" {
+		params["cursor"] = q.Cursor
+	}
+
+	agentResp, err := porter_agent.SearchLogs(a.clientset, a.service, params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.SearchLogsResponse{
+		Logs:       make([]*types.LogLine, 0),
+		NextCursor: agentResp.NextCursor,
+		Backend:    a.Name(),
+	}
+
+	for _, line := range agentResp.Logs {
+		res.Logs = append(res.Logs, &types.LogLine{
+			Timestamp:     time.Unix(0, line.Timestamp).UTC(),
+			PodName:       line.PodName,
+			ContainerName: line.ContainerName,
+			Line:          line.Line,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,266 @@
+package logsearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// lokiServiceSelectors are the labels set on the Loki service by the Loki helm charts
+var lokiServiceSelectors = []string{
+	"app.kubernetes.io/name=loki,app.kubernetes.io/component!=memberlist",
+	"app=loki",
+}
+
+func getLokiService(clientset kubernetes.Interface) (*v1.Service, bool, error) {
+	for _, selector := range lokiServiceSelectors {
+		services, err := clientset.CoreV1().Services("").List(context.TODO(), metav1.ListOptions{
+			LabelSelector: selector,
+		})
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		for _, svc := range services.Items {
+			// headless services cannot be reached through the service proxy
+			if svc.Spec.ClusterIP != v1.ClusterIPNone && len(svc.Spec.Ports) > 0 {
+				return &svc, true, nil
+			}
+		}
+	}
+
+	return nil, false, nil
+}
+
+type lokiBackend struct {
+	clientset kubernetes.Interface
+	service   *v1.Service
+}
+
+func (l *lokiBackend) Name() string {
+	return "loki"
+}
+
+func (l *lokiBackend) Search(q *Query) (*types.SearchLogsResponse, error) {
+	cursor, err := parseLokiCursor(q.Cursor)
+
+	if err != nil {
+		return nil, err
+	}
+
+	start := q.Start
+
+	if cursor != nil {
+		start = time.Unix(0, cursor.timestamp)
+	}
+
+	// lines which were returned with the previous page are skipped, and one more line is
+	// requested to find out whether there is another page
+	limit := int(q.Limit) + 1
+
+	if cursor != nil {
+		limit += cursor.skip
+	}
+
+	resp := l.clientset.CoreV1().Services(l.service.Namespace).ProxyGet(
+		"http",
+		l.service.Name,
+		fmt.Sprintf("%d", l.service.Spec.Ports[0].Port),
+		"/loki/api/v1/query_range",
+		map[string]string{
+			"query":     getLokiQuery(q),
+			"start":     fmt.Sprintf("%d", start.UnixNano()),
+			"end":       fmt.Sprintf("%d", q.End.UnixNano()),
+			"limit":     fmt.Sprintf("%d", limit),
+			"direction": "forward",
+		},
+	)
+
+	rawQuery, err := resp.DoRaw(context.TODO())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parseLokiResponse(rawQuery, q.Limit, cursor)
+}
+
+// lokiReleaseLabel is the label which promtail sets to the app.kubernetes.io/instance label
+// of a pod, which is the name of the release of the pod
+const lokiReleaseLabel = "instance"
+
+// getLokiQuery returns the LogQL query for a search. The pod, container and instance labels
+// are the labels set by promtail. The pods of a release are matched by their instance label
+// rather than by their name, since the release name is also a prefix of the pod names of
+// other releases, such as web-worker for web.
+func getLokiQuery(q *Query) string {
+	matchers := []string{
+		fmt.Sprintf("namespace=%s", strconv.Quote(q.Namespace)),
+	}
+
+	if q.PodName != "" {
+		matchers = append(matchers, fmt.Sprintf("pod=%s", strconv.Quote(q.PodName)))
+	} else if q.ReleaseName != "" {
+		matchers = append(matchers, fmt.Sprintf("%s=%s", lokiReleaseLabel, strconv.Quote(q.ReleaseName)))
+	}
+
+	if q.ContainerName != "" {
+		matchers = append(matchers, fmt.Sprintf("container=%s", strconv.Quote(q.ContainerName)))
+	}
+
+	query := fmt.Sprintf("{%s}", strings.Join(matchers, ", "))
+
+	if q.Search != "" && q.Regex {
+		query = fmt.Sprintf("%s |~ %s", query, strconv.Quote(q.Search))
+	} else if q.Search != "" {
+		query = fmt.Sprintf("%s |= %s", query, strconv.Quote(q.Search))
+	}
+
+	return query
+}
+
+// lokiCursor is the position of the next page of a search. Loki can only start a query at a
+// timestamp, so the cursor also stores the number of lines at that timestamp which were
+// already returned.
+type lokiCursor struct {
+	timestamp int64
+	skip      int
+}
+
+func parseLokiCursor(cursor string) (*lokiCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	var res lokiCursor
+
+	if _, err := fmt.Sscanf(cursor, "%d:%d", &res.timestamp, &res.skip); err != nil {
+		return nil, fmt.Errorf("invalid cursor %s", cursor)
+	}
+
+	return &res, nil
+}
+
+func (c *lokiCursor) String() string {
+	return fmt.Sprintf("%d:%d", c.timestamp, c.skip)
+}
+
+type lokiQueryResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		Result []struct {
+			Stream map[string]string `json:"stream"`
+			Values [][2]string       `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+type lokiLine struct {
+	timestamp int64
+	line      *types.LogLine
+}
+
+// parseLokiResponse merges the streams of a Loki query into a single page of lines, ordered
+// from oldest to newest
+func parseLokiResponse(rawQuery []byte, limit uint, cursor *lokiCursor) (*types.SearchLogsResponse, error) {
+	queryResp := &lokiQueryResponse{}
+
+	if err := json.Unmarshal(rawQuery, queryResp); err != nil {
+		return nil, err
+	}
+
+	if queryResp.Status != "success" {
+		return nil, fmt.Errorf("loki query failed with status %s", queryResp.Status)
+	}
+
+	lines := make([]*lokiLine, 0)
+
+	for _, stream := range queryResp.Data.Result {
+		for _, value := range stream.Values {
+			timestamp, err := strconv.ParseInt(value[0], 10, 64)
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid loki timestamp %s", value[0])
+			}
+
+			lines = append(lines, &lokiLine{
+				timestamp: timestamp,
+				line: &types.LogLine{
+					Timestamp:     time.Unix(0, timestamp).UTC(),
+					PodName:       stream.Stream["pod"],
+					ContainerName: stream.Stream["container"],
+					Line:          value[1],
+				},
+			})
+		}
+	}
+
+	// lines with the same timestamp are ordered by their stream, so that the order is the
+	// same for every page
+	sort.SliceStable(lines, func(i, j int) bool {
+		if lines[i].timestamp != lines[j].timestamp {
+			return lines[i].timestamp < lines[j].timestamp
+		}
+
+		if lines[i].line.PodName != lines[j].line.PodName {
+			return lines[i].line.PodName < lines[j].line.PodName
+		}
+
+		return lines[i].line.ContainerName < lines[j].line.ContainerName
+	})
+
+	// skip the lines at the cursor timestamp which were returned with the previous page
+	if cursor != nil {
+		skipped := 0
+
+		for skipped < cursor.skip && skipped < len(lines) && lines[skipped].timestamp == cursor.timestamp {
+			skipped++
+		}
+
+		lines = lines[skipped:]
+	}
+
+	res := &types.SearchLogsResponse{
+		Logs:    make([]*types.LogLine, 0),
+		Backend: "loki",
+	}
+
+	for i, line := range lines {
+		if i == int(limit) {
+			break
+		}
+
+		res.Logs = append(res.Logs, line.line)
+	}
+
+	if len(lines) > int(limit) {
+		last := lines[limit-1].timestamp
+		next := &lokiCursor{timestamp: last}
+
+		for _, line := range lines[:limit] {
+			if line.timestamp == last {
+				next.skip++
+			}
+		}
+
+		// lines at the cursor timestamp which were skipped on this page must be skipped
+		// again on the next page
+		if cursor != nil && cursor.timestamp == last {
+			next.skip += cursor.skip
+		}
+
+		res.NextCursor = next.String()
+	}
+
+	return res, nil
+}
@@ -0,0 +1,125 @@
+package logsearch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+func TestGetLokiQuery(t *testing.T) {
+	tests := []struct {
+		query    *Query
+		expected string
+	}{
+		{
+			query:    &Query{Namespace: "default"},
+			expected: `{namespace="default"}`,
+		},
+		{
+			query:    &Query{Namespace: "default", ReleaseName: "web.app", ContainerName: "web", Search: `say "hi"`},
+			expected: `{namespace="default", instance="web.app", container="web"} |= "say \"hi\""`,
+		},
+		{
+			query:    &Query{Namespace: "default", ReleaseName: "web", PodName: "web-1", Search: "^error", Regex: true},
+			expected: `{namespace="default", pod="web-1"} |~ "^error"`,
+		},
+	}
+
+	for _, test := range tests {
+		if res := getLokiQuery(test.query); res != test.expected {
+			t.Errorf("expected %s, got %s", test.expected, res)
+		}
+	}
+}
+
+func TestGetLokiQueryReleasesWithSharedPrefix(t *testing.T) {
+	// the pods of web-worker, such as web-worker-1, are prefixed by the name of web
+	web := getLokiQuery(&Query{Namespace: "default", ReleaseName: "web"})
+	worker := getLokiQuery(&Query{Namespace: "default", ReleaseName: "web-worker"})
+
+	if web != `{namespace="default", instance="web"}` {
+		t.Errorf("expected the pods of web to be matched by their release label, got %s", web)
+	}
+
+	if worker != `{namespace="default", instance="web-worker"}` {
+		t.Errorf("expected the pods of web-worker to be matched by their release label, got %s", worker)
+	}
+
+	if strings.Contains(web, "pod") {
+		t.Errorf("expected the pods of web not to be matched by name, got %s", web)
+	}
+}
+
+const testLokiResponse = `{
+	"status": "success",
+	"data": {
+		"resultType": "streams",
+		"result": [
+			{
+				"stream": {"pod": "web-1", "container": "web"},
+				"values": [["100", "a"], ["200", "c"], ["300", "e"]]
+			},
+			{
+				"stream": {"pod": "web-2", "container": "web"},
+				"values": [["100", "b"], ["200", "d"]]
+			}
+		]
+	}
+}`
+
+func TestParseLokiResponse(t *testing.T) {
+	res, err := parseLokiResponse([]byte(testLokiResponse), 3, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if lines := getLines(res.Logs); lines != "a,b,c" {
+		t.Errorf("expected lines a,b,c, got %s", lines)
+	}
+
+	if res.NextCursor != "200:1" {
+		t.Fatalf("expected cursor 200:1, got %s", res.NextCursor)
+	}
+
+	cursor, err := parseLokiCursor(res.NextCursor)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the next query starts at the cursor timestamp, so loki returns the lines from 200
+	// onwards, including the line which was already returned
+	res, err = parseLokiResponse([]byte(`{
+		"status": "success",
+		"data": {
+			"result": [
+				{"stream": {"pod": "web-1", "container": "web"}, "values": [["200", "c"], ["300", "e"]]},
+				{"stream": {"pod": "web-2", "container": "web"}, "values": [["200", "d"]]}
+			]
+		}
+	}`), 3, cursor)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if lines := getLines(res.Logs); lines != "d,e" {
+		t.Errorf("expected lines d,e, got %s", lines)
+	}
+
+	if res.NextCursor != "" {
+		t.Errorf("expected no cursor, got %s", res.NextCursor)
+	}
+}
+
+func getLines(logs []*types.LogLine) string {
+	lines := make([]string, 0)
+
+	for _, log := range logs {
+		lines = append(lines, log.Line)
+	}
+
+	return strings.Join(lines, ",")
+}
@@ -0,0 +1,105 @@
+package logsearch
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DefaultRange is the time range covered by a search which does not set a start time
+const DefaultRange = time.Hour
+
+// Query is a search of the historical logs of a namespace, or of a release in a namespace
+type Query struct {
+	Namespace string
+
+	// ReleaseName limits the search to the pods of a release. Loki matches the pods by the
+	// release label which promtail stores with their logs, and the porter-agent matches them
+	// by their name, which is prefixed by the release name.
+	ReleaseName string
+
+	PodName       string
+	ContainerName string
+
+	Start time.Time
+	End   time.Time
+
+	// Search is the text each line must contain, or the regular expression each line must
+	// match if Regex is set
+	Search string
+	Regex  bool
+
+	Limit  uint
+	Cursor string
+}
+
+// NewQuery validates a search request and returns the query for it, with the defaults of
+// the request set
+func NewQuery(namespace, releaseName string, req *types.SearchLogsRequest) (*Query, error) {
+	q := &Query{
+		Namespace:     namespace,
+		ReleaseName:   releaseName,
+		PodName:       req.PodName,
+		ContainerName: req.ContainerName,
+		End:           time.Now(),
+		Search:        req.Query,
+		Regex:         req.Regex,
+		Limit:         req.Limit,
+		Cursor:        req.Cursor,
+	}
+
+	if req.EndRange != 0 {
+		q.End = time.Unix(int64(req.EndRange), 0)
+	}
+
+	q.Start = q.End.Add(-DefaultRange)
+
+	if req.StartRange != 0 {
+		q.Start = time.Unix(int64(req.StartRange), 0)
+	}
+
+	if !q.Start.Before(q.End) {
+		return nil, fmt.Errorf("start_range must be before end_range")
+	}
+
+	if q.Limit == 0 {
+		q.Limit = types.DefaultLogSearchLimit
+	} else if q.Limit > types.MaxLogSearchLimit {
+		return nil, fmt.Errorf("limit cannot be greater than %d", types.MaxLogSearchLimit)
+	}
+
+	if q.Regex {
+		if _, err := regexp.Compile(q.Search); err != nil {
+			return nil, fmt.Errorf("invalid query expression: %w", err)
+		}
+	}
+
+	return q, nil
+}
+
+// Backend searches the historical logs of a cluster
+type Backend interface {
+	// Name returns the name of the backend, which is returned with the search results
+	Name() string
+
+	// Search returns a page of the lines which match the query, ordered from oldest to
+	// newest
+	Search(q *Query) (*types.SearchLogsResponse, error)
+}
+
+// GetBackend returns the log backend of a cluster. Loki is used if it is installed in the
+// cluster, and the porter-agent is used otherwise.
+func GetBackend(clientset kubernetes.Interface) (Backend, error) {
+	lokiSvc, found, err := getLokiService(clientset)
+
+	if err != nil {
+		return nil, err
+	} else if found {
+		return &lokiBackend{clientset, lokiSvc}, nil
+	}
+
+	return newAgentBackend(clientset)
+}
@@ -0,0 +1,287 @@
+package logstream
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DefaultPollInterval is the interval at which the pods of a release are listed to pick up
+// new pods, if the options do not set an interval
+const DefaultPollInterval = 5 * time.Second
+
+// PodLister returns the pods whose logs should be streamed. It is called on every poll, so
+// that pods which are created while the logs are streamed are picked up.
+type PodLister func() ([]v1.Pod, error)
+
+// Options configure which logs are streamed
+type Options struct {
+	// Container limits the stream to containers with this name
+	Container string
+
+	// Since and TailLines limit the logs which are streamed from the containers that are
+	// running when the stream starts. Containers which start afterwards are streamed from
+	// their first line.
+	Since     time.Duration
+	TailLines int64
+
+	// Grep filters the streamed lines, if set
+	Grep *regexp.Regexp
+
+	// Follow keeps streaming logs and picking up new pods until the context is cancelled.
+	// Otherwise, the current logs of every container are streamed once.
+	Follow bool
+
+	PollInterval time.Duration
+}
+
+// containerStream is the state of the log stream of a single container
+type containerStream struct {
+	active       bool
+	restartCount int32
+	endedAt      time.Time
+}
+
+type streamer struct {
+	clientset kubernetes.Interface
+	namespace string
+	opts      *Options
+	onLine    func(line *types.ReleaseLogLine) error
+
+	mu      sync.Mutex
+	streams map[string]*containerStream
+	wg      sync.WaitGroup
+	errCh   chan error
+}
+
+// Stream streams the logs of every container of the pods returned by listPods, and calls
+// onLine with every line. Calls to onLine are serialized. It returns once the context is
+// cancelled or onLine returns an error, or once every log has been streamed if the options
+// do not follow the logs.
+func Stream(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	namespace string,
+	listPods PodLister,
+	opts *Options,
+	onLine func(line *types.ReleaseLogLine) error,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var onLineMu sync.Mutex
+
+	s := &streamer{
+		clientset: clientset,
+		namespace: namespace,
+		opts:      opts,
+		onLine: func(line *types.ReleaseLogLine) error {
+			onLineMu.Lock()
+			defer onLineMu.Unlock()
+
+			return onLine(line)
+		},
+		streams: make(map[string]*containerStream),
+		errCh:   make(chan error, 1),
+	}
+
+	// wait for every container stream to exit before returning, so that onLine is not
+	// called after Stream returns
+	defer s.wg.Wait()
+
+	pollInterval := opts.PollInterval
+
+	if pollInterval == 0 {
+		pollInterval = DefaultPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	initial := true
+
+	for {
+		pods, err := listPods()
+
+		if err != nil {
+			return err
+		}
+
+		for i := range pods {
+			s.streamPod(ctx, &pods[i], initial)
+		}
+
+		initial = false
+
+		if !opts.Follow {
+			done := make(chan struct{})
+
+			go func() {
+				s.wg.Wait()
+				close(done)
+			}()
+
+			select {
+			case err := <-s.errCh:
+				return err
+			case <-done:
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-s.errCh:
+			return err
+		case <-ticker.C:
+		}
+	}
+}
+
+// streamPod starts a log stream for every container of the pod which has started and is not
+// already streamed
+func (s *streamer) streamPod(ctx context.Context, pod *v1.Pod, initial bool) {
+	containers := make([]v1.Container, 0)
+	containers = append(containers, pod.Spec.InitContainers...)
+	containers = append(containers, pod.Spec.Containers...)
+
+	statuses := make([]v1.ContainerStatus, 0)
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+
+	for _, container := range containers {
+		if s.opts.Container != "" && container.Name != s.opts.Container {
+			continue
+		}
+
+		status := getContainerStatus(statuses, container.Name)
+
+		// logs can only be read once the container has started
+		if status == nil || (status.State.Running == nil && status.State.Terminated == nil) {
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s", pod.Name, container.Name)
+		logOpts := &v1.PodLogOptions{
+			Container: container.Name,
+			Follow:    s.opts.Follow,
+		}
+
+		s.mu.Lock()
+
+		stream, exists := s.streams[key]
+
+		switch {
+		case exists && (stream.active || status.RestartCount <= stream.restartCount):
+			// the container is already streamed, or it has exited and has not restarted
+			s.mu.Unlock()
+			continue
+		case exists:
+			// the container has restarted since its last stream ended, so only the logs of
+			// the new run are streamed
+			sinceTime := metav1.NewTime(stream.endedAt)
+			logOpts.SinceTime = &sinceTime
+		case initial:
+			if s.opts.Since != 0 {
+				sinceSeconds := int64(s.opts.Since.Seconds())
+				logOpts.SinceSeconds = &sinceSeconds
+			}
+
+			if s.opts.TailLines != 0 {
+				tailLines := s.opts.TailLines
+				logOpts.TailLines = &tailLines
+			}
+		}
+
+		s.streams[key] = &containerStream{
+			active:       true,
+			restartCount: status.RestartCount,
+		}
+
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+
+		go func(podName string) {
+			defer s.wg.Done()
+
+			opened, err := s.streamContainer(ctx, podName, logOpts)
+
+			s.mu.Lock()
+
+			if opened {
+				s.streams[key].active = false
+				s.streams[key].endedAt = time.Now()
+			} else {
+				// the stream is retried on the next poll
+				delete(s.streams, key)
+			}
+
+			s.mu.Unlock()
+
+			if err != nil {
+				select {
+				case s.errCh <- err:
+				default:
+				}
+			}
+		}(pod.Name)
+	}
+}
+
+// streamContainer streams the logs of a single container, and returns false if the stream
+// could not be opened. It only returns an error if onLine fails, since the logs of a container
+// can become unavailable at any time during a rollout.
+func (s *streamer) streamContainer(ctx context.Context, podName string, logOpts *v1.PodLogOptions) (bool, error) {
+	stream, err := s.clientset.CoreV1().Pods(s.namespace).GetLogs(podName, logOpts).Stream(ctx)
+
+	if err != nil {
+		return false, nil
+	}
+
+	defer stream.Close()
+
+	r := bufio.NewReader(stream)
+
+	for {
+		line, err := r.ReadString('\n')
+
+		if line = strings.TrimRight(line, "\r\n"); line != "" && (s.opts.Grep == nil || s.opts.Grep.MatchString(line)) {
+			onLineErr := s.onLine(&types.ReleaseLogLine{
+				PodName:       podName,
+				ContainerName: logOpts.Container,
+				Line:          line,
+			})
+
+			if onLineErr != nil {
+				return true, onLineErr
+			}
+		}
+
+		// the stream ends with io.EOF once the container exits, or with an error once the
+		// context is cancelled
+		if err != nil {
+			return true, nil
+		}
+	}
+}
+
+func getContainerStatus(statuses []v1.ContainerStatus, name string) *v1.ContainerStatus {
+	for i := range statuses {
+		if statuses[i].Name == name {
+			return &statuses[i]
+		}
+	}
+
+	return nil
+}
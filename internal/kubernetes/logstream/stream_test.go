@@ -0,0 +1,104 @@
+package logstream
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/porter-dev/porter/api/types"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func getTestPod(name string, running bool, containers ...string) v1.Pod {
+	pod := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+	}
+
+	for _, container := range containers {
+		pod.Spec.Containers = append(pod.Spec.Containers, v1.Container{Name: container})
+
+		status := v1.ContainerStatus{Name: container}
+
+		if running {
+			status.State.Running = &v1.ContainerStateRunning{}
+		} else {
+			status.State.Waiting = &v1.ContainerStateWaiting{Reason: "ContainerCreating"}
+		}
+
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, status)
+	}
+
+	return pod
+}
+
+func TestStream(t *testing.T) {
+	pods := []v1.Pod{
+		getTestPod("web-1", true, "web", "sidecar"),
+		getTestPod("web-2", true, "web"),
+		getTestPod("web-3", false, "web"),
+	}
+
+	clientset := fake.NewSimpleClientset()
+
+	listPods := func() ([]v1.Pod, error) {
+		return pods, nil
+	}
+
+	tests := []struct {
+		name     string
+		opts     *Options
+		expected []string
+	}{
+		{
+			name:     "all started containers",
+			opts:     &Options{},
+			expected: []string{"web-1/sidecar", "web-1/web", "web-2/web"},
+		},
+		{
+			name:     "selected container",
+			opts:     &Options{Container: "web"},
+			expected: []string{"web-1/web", "web-2/web"},
+		},
+		{
+			name:     "grep without matches",
+			opts:     &Options{Grep: regexp.MustCompile("^error")},
+			expected: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		lines := make([]string, 0)
+
+		err := Stream(context.Background(), clientset, "default", listPods, test.opts, func(line *types.ReleaseLogLine) error {
+			if line.Line != "fake logs" {
+				t.Errorf("%s: unexpected line %q", test.name, line.Line)
+			}
+
+			lines = append(lines, line.PodName+"/"+line.ContainerName)
+
+			return nil
+		})
+
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+
+		sort.Strings(lines)
+
+		if len(lines) != len(test.expected) {
+			t.Fatalf("%s: expected %v, got %v", test.name, test.expected, lines)
+		}
+
+		for i := range lines {
+			if lines[i] != test.expected[i] {
+				t.Errorf("%s: expected %v, got %v", test.name, test.expected, lines)
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package nodepool
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubectl/pkg/drain"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CordonAndDrain marks the given nodes as unschedulable and evicts the pods running on them.
+// Evictions respect pod disruption budgets, and DaemonSet and mirror pods are skipped. This
+// does not wait for the evicted pods to terminate.
+func CordonAndDrain(clientset kubernetes.Interface, nodeNames []string) error {
+	out := &bytes.Buffer{}
+
+	helper := &drain.Helper{
+		Ctx:                 context.Background(),
+		Client:              clientset,
+		Force:               true,
+		IgnoreAllDaemonSets: true,
+		DeleteEmptyDirData:  true,
+		GracePeriodSeconds:  -1,
+		Out:                 out,
+		ErrOut:              out,
+	}
+
+	evictionVersion, err := drain.CheckEvictionSupport(clientset)
+
+	if err != nil {
+		return err
+	}
+
+	if evictionVersion.Empty() {
+		return fmt.Errorf("the cluster does not support pod evictions")
+	}
+
+	errs := make([]string, 0)
+
+	for _, nodeName := range nodeNames {
+		node, err := clientset.CoreV1().Nodes().Get(context.Background(), nodeName, metav1.GetOptions{})
+
+		if err != nil {
+			return err
+		}
+
+		if err := drain.RunCordonOrUncordon(helper, node, true); err != nil {
+			return fmt.Errorf("could not cordon node %s: %w", nodeName, err)
+		}
+
+		podList, podErrs := helper.GetPodsForDeletion(nodeName)
+
+		if len(podErrs) > 0 {
+			return fmt.Errorf("could not list pods on node %s: %v", nodeName, podErrs)
+		}
+
+		for _, pod := range podList.Pods() {
+			if err := helper.EvictPod(pod, evictionVersion); err != nil {
+				errs = append(errs, evictionError(pod, err))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("could not evict all pods: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func evictionError(pod v1.Pod, err error) string {
+	return fmt.Sprintf("%s/%s: %s", pod.Namespace, pod.Name, err.Error())
+}
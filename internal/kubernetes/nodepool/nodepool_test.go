@@ -0,0 +1,88 @@
+package nodepool_test
+
+import (
+	"testing"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/nodepool"
+	"github.com/porter-dev/porter/internal/kubernetes/nodes"
+)
+
+func TestGetNodePoolsFromLegacyValues(t *testing.T) {
+	values := map[string]interface{}{
+		"machine_type":                       "t3.medium",
+		"min_instances":                      float64(1),
+		"max_instances":                      float64(10),
+		"additional_nodegroup_enabled":       true,
+		"additional_nodegroup_machine_type":  "t3.xlarge",
+		"additional_nodegroup_min_instances": float64(0),
+		"additional_nodegroup_max_instances": float64(3),
+		"additional_nodegroup_label":         "porter.run/workload-kind=database",
+	}
+
+	pools, err := nodepool.GetNodePools(types.InfraEKS, values)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(pools) != 2 {
+		t.Fatalf("expected 2 node pools, got %d", len(pools))
+	}
+
+	if pools[0].Name != "application" || pools[0].InstanceType != "t3.medium" || pools[0].MaxSize != 10 {
+		t.Errorf("unexpected application pool: %+v", pools[0])
+	}
+
+	if pools[1].Labels["porter.run/workload-kind"] != "database" {
+		t.Errorf("expected additional pool to have the workload-kind label, got %v", pools[1].Labels)
+	}
+
+	// remove the additional pool and add a new one
+	pools[0].MaxSize = 20
+	pools = append(pools[:1], &types.NodePool{Name: "jobs", InstanceType: "c6i.2xlarge", MaxSize: 5})
+
+	nodepool.SetNodePools(types.InfraEKS, values, pools)
+
+	if values["max_instances"] != uint(20) {
+		t.Errorf("expected legacy max_instances value to be updated, got %v", values["max_instances"])
+	}
+
+	if values["additional_nodegroup_enabled"] != false {
+		t.Errorf("expected additional node group to be disabled")
+	}
+
+	pools, err = nodepool.GetNodePools(types.InfraEKS, values)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(pools) != 2 || pools[1].Name != "jobs" || pools[1].InstanceType != "c6i.2xlarge" {
+		t.Errorf("expected pools to be read from the node pool list, got %+v", pools)
+	}
+}
+
+func TestAttachNodes(t *testing.T) {
+	pools := []*types.NodePool{{Name: "application", Managed: true}}
+
+	nodeList := []*nodes.NodeWithUsageData{
+		{Name: "node-1", Labels: map[string]string{nodepool.PoolLabel: "application"}},
+		{Name: "node-2", Labels: map[string]string{"doks.digitalocean.com/node-pool": "legacy-pool"}},
+		{Name: "node-3", Labels: map[string]string{}},
+	}
+
+	res := nodepool.AttachNodes(types.InfraDOKS, pools, nodeList)
+
+	if len(res) != 2 {
+		t.Fatalf("expected 2 node pools, got %d", len(res))
+	}
+
+	if len(res[0].Nodes) != 1 || res[0].Nodes[0].Name != "node-1" {
+		t.Errorf("expected node-1 to be attached to the application pool")
+	}
+
+	if res[1].Name != "legacy-pool" || res[1].Managed {
+		t.Errorf("expected an unmanaged legacy-pool, got %+v", res[1])
+	}
+}
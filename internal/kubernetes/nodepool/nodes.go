@@ -0,0 +1,71 @@
+package nodepool
+
+import (
+	"sort"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/nodes"
+)
+
+// PoolLabel is the label which cluster templates set on the nodes of each node pool
+const PoolLabel = "porter.run/node-pool"
+
+// providerPoolLabels are the labels which each cloud provider sets on the nodes of a node pool
+var providerPoolLabels = map[types.InfraKind]string{
+	types.InfraEKS:  "eks.amazonaws.com/nodegroup",
+	types.InfraGKE:  "cloud.google.com/gke-nodepool",
+	types.InfraDOKS: "doks.digitalocean.com/node-pool",
+	types.InfraAKS:  "kubernetes.azure.com/agentpool",
+}
+
+// AttachNodes assigns the nodes in the cluster to their node pools. Nodes which belong to a
+// pool that is not configured in the infra values are grouped into unmanaged pools.
+func AttachNodes(kind types.InfraKind, pools []*types.NodePool, nodeList []*nodes.NodeWithUsageData) []*types.NodePool {
+	poolsByName := make(map[string]*types.NodePool)
+
+	for _, pool := range pools {
+		pool.Nodes = make([]*nodes.NodeWithUsageData, 0)
+		poolsByName[pool.Name] = pool
+	}
+
+	res := append([]*types.NodePool{}, pools...)
+	unmanaged := make([]*types.NodePool, 0)
+
+	for _, node := range nodeList {
+		name := PoolName(kind, node.Labels)
+
+		if name == "" {
+			continue
+		}
+
+		pool, exists := poolsByName[name]
+
+		if !exists {
+			pool = &types.NodePool{
+				Name:         name,
+				InstanceType: node.Labels["node.kubernetes.io/instance-type"],
+				Nodes:        make([]*nodes.NodeWithUsageData, 0),
+			}
+
+			poolsByName[name] = pool
+			unmanaged = append(unmanaged, pool)
+		}
+
+		pool.Nodes = append(pool.Nodes, node)
+	}
+
+	sort.Slice(unmanaged, func(i, j int) bool {
+		return unmanaged[i].Name < unmanaged[j].Name
+	})
+
+	return append(res, unmanaged...)
+}
+
+// PoolName returns the name of the node pool a node belongs to, based on the node's labels
+func PoolName(kind types.InfraKind, labels map[string]string) string {
+	if name, exists := labels[PoolLabel]; exists {
+		return name
+	}
+
+	return labels[providerPoolLabels[kind]]
+}
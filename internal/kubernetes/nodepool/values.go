@@ -0,0 +1,209 @@
+package nodepool
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/porter-dev/porter/api/types"
+)
+
+// ValuesKey is the infra value which holds the list of node pools for a cluster
+const ValuesKey = "node_pools"
+
+// poolValues is the representation of a node pool in the infra values
+type poolValues struct {
+	Name         string            `json:"name"`
+	InstanceType string            `json:"instance_type"`
+	MinSize      uint              `json:"min_size"`
+	MaxSize      uint              `json:"max_size"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	Taints       []string          `json:"taints,omitempty"`
+}
+
+// legacyPool maps a node pool onto the individual values used by older cluster templates
+type legacyPool struct {
+	name         string
+	enabled      string
+	instanceType string
+	minSize      string
+	maxSize      string
+	label        string
+	taint        string
+}
+
+var legacyPools = map[types.InfraKind][]*legacyPool{
+	types.InfraEKS: {
+		{
+			name:         "application",
+			instanceType: "machine_type",
+			minSize:      "min_instances",
+			maxSize:      "max_instances",
+		},
+		{
+			name:         "additional",
+			enabled:      "additional_nodegroup_enabled",
+			instanceType: "additional_nodegroup_machine_type",
+			minSize:      "additional_nodegroup_min_instances",
+			maxSize:      "additional_nodegroup_max_instances",
+			label:        "additional_nodegroup_label",
+			taint:        "additional_nodegroup_taint",
+		},
+	},
+	types.InfraAKS: {
+		{
+			name:         "application",
+			instanceType: "app_machine_type",
+		},
+	},
+}
+
+// SupportsNodePools returns true if node pools can be managed for the given kind of infra
+func SupportsNodePools(kind types.InfraKind) bool {
+	switch kind {
+	case types.InfraEKS, types.InfraGKE, types.InfraDOKS, types.InfraAKS:
+		return true
+	}
+
+	return false
+}
+
+// GetNodePools reads the node pools from the infra values. If the values were created by an
+// older template which does not use the node pool list, the pools are read from the
+// individual machine type and size values.
+func GetNodePools(kind types.InfraKind, values map[string]interface{}) ([]*types.NodePool, error) {
+	res := make([]*types.NodePool, 0)
+
+	if rawPools, exists := values[ValuesKey]; exists {
+		poolBytes, err := json.Marshal(rawPools)
+
+		if err != nil {
+			return nil, err
+		}
+
+		pools := make([]*poolValues, 0)
+
+		if err := json.Unmarshal(poolBytes, &pools); err != nil {
+			return nil, fmt.Errorf("could not parse node pools: %w", err)
+		}
+
+		for _, pool := range pools {
+			res = append(res, &types.NodePool{
+				Name:         pool.Name,
+				InstanceType: pool.InstanceType,
+				MinSize:      pool.MinSize,
+				MaxSize:      pool.MaxSize,
+				Labels:       pool.Labels,
+				Taints:       pool.Taints,
+				Managed:      true,
+			})
+		}
+
+		return res, nil
+	}
+
+	for _, legacy := range legacyPools[kind] {
+		if legacy.enabled != "" {
+			if enabled, _ := values[legacy.enabled].(bool); !enabled {
+				continue
+			}
+		}
+
+		pool := &types.NodePool{
+			Name:    legacy.name,
+			Managed: true,
+		}
+
+		pool.InstanceType, _ = values[legacy.instanceType].(string)
+		pool.MinSize = uintValue(values[legacy.minSize])
+		pool.MaxSize = uintValue(values[legacy.maxSize])
+
+		if label, ok := values[legacy.label].(string); ok && label != "" {
+			pool.Labels = parseLabel(label)
+		}
+
+		if taint, ok := values[legacy.taint].(string); ok && taint != "" {
+			pool.Taints = []string{taint}
+		}
+
+		res = append(res, pool)
+	}
+
+	return res, nil
+}
+
+// SetNodePools writes the node pools to the infra values. Pools which map onto the
+// individual values used by older templates are written to those values as well, so that
+// they are applied regardless of the template version.
+func SetNodePools(kind types.InfraKind, values map[string]interface{}, pools []*types.NodePool) {
+	rawPools := make([]interface{}, 0)
+	poolsByName := make(map[string]*types.NodePool)
+
+	for _, pool := range pools {
+		poolsByName[pool.Name] = pool
+
+		rawPool := map[string]interface{}{
+			"name":          pool.Name,
+			"instance_type": pool.InstanceType,
+			"min_size":      pool.MinSize,
+			"max_size":      pool.MaxSize,
+		}
+
+		if len(pool.Labels) > 0 {
+			rawPool["labels"] = pool.Labels
+		}
+
+		if len(pool.Taints) > 0 {
+			rawPool["taints"] = pool.Taints
+		}
+
+		rawPools = append(rawPools, rawPool)
+	}
+
+	values[ValuesKey] = rawPools
+
+	for _, legacy := range legacyPools[kind] {
+		pool, exists := poolsByName[legacy.name]
+
+		if legacy.enabled != "" {
+			values[legacy.enabled] = exists
+		}
+
+		if !exists {
+			continue
+		}
+
+		values[legacy.instanceType] = pool.InstanceType
+
+		if legacy.minSize != "" {
+			values[legacy.minSize] = pool.MinSize
+		}
+
+		if legacy.maxSize != "" {
+			values[legacy.maxSize] = pool.MaxSize
+		}
+	}
+}
+
+func uintValue(val interface{}) uint {
+	switch v := val.(type) {
+	case float64:
+		return uint(v)
+	case int:
+		return uint(v)
+	case uint:
+		return v
+	}
+
+	return 0
+}
+
+// parseLabel parses a label of the form key=value
+func parseLabel(label string) map[string]string {
+	for i := range label {
+		if label[i] == '=' {
+			return map[string]string{label[:i]: label[i+1:]}
+		}
+	}
+
+	return map[string]string{label: ""}
+}
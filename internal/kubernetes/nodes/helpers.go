@@ -123,3 +123,11 @@ func DescribeNodeResource(nodeNonTerminatedPodsList *corev1.PodList, node *corev
 		fractionEphemeralStorageLimits: fractionEphemeralStorageLimits,
 	}
 }
+
+// GetPodRequests returns the total resources requested by a pod, including init containers
+// and pod overhead
+func GetPodRequests(pod *corev1.Pod) corev1.ResourceList {
+	reqs, _ := podRequestsAndLimits(pod)
+
+	return reqs
+}
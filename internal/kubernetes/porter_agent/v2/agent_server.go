@@ -3,11 +3,15 @@ package v2
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/Masterminds/semver/v3"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -130,3 +134,79 @@ func GetLogs(
 
 	return logsResp, nil
 }
+
+// LogSearchMinChartVersion is the first version of the porter-agent chart whose agent serves
+// the log search endpoint
+const LogSearchMinChartVersion = "0.4.0"
+
+// ErrAgentUpgradeRequired is returned when the porter-agent of a cluster does not support a
+// request, and has to be upgraded first
+var ErrAgentUpgradeRequired = errors.New("the porter-agent in this cluster does not support log search: agent upgrade required")
+
+// SearchLogs searches the logs stored by the agent with a GET request to /logs/search. The
+// params are sent as the query string of the search:
+//
+//   - namespace: required, the namespace of the pods to search
+//   - start_time, end_time: required, the time range as unix seconds
+//   - limit: the maximum number of lines to return
+//   - pod_prefix, pod_name, container_name: optional filters on the pods and containers
+//   - search or regex: optional, a substring or an RE2 expression which lines must match
+//   - cursor: optional, the next_cursor of the previous page
+//
+// The agent responds with a LogSearchResponse, whose lines are ordered from oldest to newest
+// with nanosecond unix timestamps, and whose next_cursor is empty on the last page.
+//
+// ErrAgentUpgradeRequired is returned if the agent chart is older than
+// LogSearchMinChartVersion, or if the agent does not serve the endpoint.
+func SearchLogs(
+	clientset kubernetes.Interface,
+	service *v1.Service,
+	params map[string]string,
+) (*LogSearchResponse, error) {
+	if version, ok := getChartVersion(service); ok && version.LessThan(semver.MustParse(LogSearchMinChartVersion)) {
+		return nil, ErrAgentUpgradeRequired
+	}
+
+	resp := clientset.CoreV1().Services(service.Namespace).ProxyGet(
+		"http",
+		service.Name,
+		fmt.Sprintf("%d", service.Spec.Ports[0].Port),
+		"/logs/search",
+		params,
+	)
+
+	rawQuery, err := resp.DoRaw(context.Background())
+
+	if k8serrors.IsNotFound(err) {
+		return nil, ErrAgentUpgradeRequired
+	} else if err != nil {
+		return nil, err
+	}
+
+	searchResp := &LogSearchResponse{}
+
+	err = json.Unmarshal(rawQuery, searchResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return searchResp, nil
+}
+
+// getChartVersion returns the version of the porter-agent chart from the chart label of the
+// agent service, if the label is set
+func getChartVersion(service *v1.Service) (*semver.Version, bool) {
+	chart, ok := service.Labels["helm.sh/chart"]
+
+	if !ok || !strings.HasPrefix(chart, "porter-agent-") {
+		return nil, false
+	}
+
+	version, err := semver.NewVersion(strings.TrimPrefix(chart, "porter-agent-"))
+
+	if err != nil {
+		return nil, false
+	}
+
+	return version, true
+}
@@ -0,0 +1,48 @@
+package v2
+
+import (
+	"errors"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSearchLogsRequiresAgentUpgrade(t *testing.T) {
+	service := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "porter-agent-controller-manager",
+			Namespace: "porter-agent-system",
+			Labels:    map[string]string{"helm.sh/chart": "porter-agent-0.3.2"},
+		},
+		Spec: v1.ServiceSpec{Ports: []v1.ServicePort{{Port: 80}}},
+	}
+
+	_, err := SearchLogs(fake.NewSimpleClientset(service), service, map[string]string{})
+
+	if !errors.Is(err, ErrAgentUpgradeRequired) {
+		t.Errorf("expected agent upgrade to be required, got %v", err)
+	}
+}
+
+func TestGetChartVersion(t *testing.T) {
+	tests := map[string]string{
+		"porter-agent-0.4.0":     "0.4.0",
+		"porter-agent-1.2.3-rc1": "1.2.3-rc1",
+		"other-chart-0.4.0":      "",
+		"porter-agent-latest":    "",
+	}
+
+	for label, expVersion := range tests {
+		version, ok := getChartVersion(&v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"helm.sh/chart": label}},
+		})
+
+		if expVersion == "" && ok {
+			t.Errorf("%s: expected no version, got %s", label, version)
+		} else if expVersion != "" && (!ok || version.String() != expVersion) {
+			t.Errorf("%s: expected version %s, got %v", label, expVersion, version)
+		}
+	}
+}
@@ -53,3 +53,15 @@ type EventsResponse struct {
 type LogsResponse struct {
 	Contents string `json:"contents" form:"required"`
 }
+
+type LogSearchLine struct {
+	Timestamp     int64  `json:"timestamp"`
+	PodName       string `json:"pod_name"`
+	ContainerName string `json:"container_name"`
+	Line          string `json:"line"`
+}
+
+type LogSearchResponse struct {
+	Logs       []*LogSearchLine `json:"logs" form:"required"`
+	NextCursor string           `json:"next_cursor"`
+}
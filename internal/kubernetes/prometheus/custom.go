@@ -0,0 +1,261 @@
+package prometheus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// CustomQueryData is the data a custom metric query template is rendered with
+type CustomQueryData struct {
+	// Namespace and Name are the namespace and the name of the release
+	Namespace string
+	Name      string
+
+	// PodSelector is a PromQL label selector which matches the pods of the release, for
+	// example `namespace="default",pod=~"web-[a-z0-9]+-[a-z0-9]+"`
+	PodSelector string
+}
+
+// Controller is a controller of a release whose pods are matched by the pod selector of a
+// custom metric query
+type Controller struct {
+	Kind string
+	Name string
+}
+
+// GetCustomQueryData returns the data for the custom metric queries of a release. Controllers
+// of kinds which metrics cannot be queried for are ignored.
+func GetCustomQueryData(namespace, name string, controllers []Controller) (*CustomQueryData, error) {
+	regexes := make([]string, 0)
+
+	for _, controller := range controllers {
+		if strings.ToLower(controller.Kind) == "ingress" {
+			continue
+		}
+
+		regex, err := getSelectionRegex(controller.Kind, controller.Name)
+
+		if err != nil {
+			continue
+		}
+
+		regexes = append(regexes, regex)
+	}
+
+	if len(regexes) == 0 {
+		return nil, fmt.Errorf("release %s has no controllers to query metrics for", name)
+	}
+
+	return &CustomQueryData{
+		Namespace:   namespace,
+		Name:        name,
+		PodSelector: fmt.Sprintf(`namespace="%s",pod=~"%s"`, namespace, strings.Join(regexes, "|")),
+	}, nil
+}
+
+// RenderCustomQuery renders a custom metric query template
+func RenderCustomQuery(query string, data *CustomQueryData) (string, error) {
+	tmpl, err := template.New("query").Option("missingkey=error").Parse(query)
+
+	if err != nil {
+		return "", fmt.Errorf("invalid query template: %w", err)
+	}
+
+	var buf bytes.Buffer
+
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("invalid query template: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+type CustomMetricResult struct {
+	Date  int64   `json:"date"`
+	Value float64 `json:"value"`
+}
+
+// CustomMetricSeries is the result of a custom metric query for a single set of labels
+type CustomMetricSeries struct {
+	Labels  map[string]string    `json:"labels"`
+	Results []CustomMetricResult `json:"results"`
+}
+
+// QueryError is returned when Prometheus rejects a query
+type QueryError struct {
+	ErrorType string
+	Message   string
+}
+
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("prometheus rejected the query (%s): %s", e.ErrorType, e.Message)
+}
+
+type promAPIResponse struct {
+	Status    string          `json:"status"`
+	ErrorType string          `json:"errorType"`
+	Error     string          `json:"error"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// doPromRequest sends a request to the Prometheus HTTP API, and returns a QueryError if
+// Prometheus rejects the request
+func doPromRequest(
+	clientset kubernetes.Interface,
+	service *v1.Service,
+	path string,
+	params map[string]string,
+) (json.RawMessage, error) {
+	if len(service.Spec.Ports) == 0 {
+		return nil, fmt.Errorf("prometheus service has no exposed ports to query")
+	}
+
+	resp := clientset.CoreV1().Services(service.Namespace).ProxyGet(
+		"http",
+		service.Name,
+		fmt.Sprintf("%d", service.Spec.Ports[0].Port),
+		path,
+		params,
+	)
+
+	// the body of the response is returned along with the error if Prometheus responds with
+	// an error status, and contains the reason the query was rejected
+	rawQuery, err := resp.DoRaw(context.TODO())
+
+	apiResp := &promAPIResponse{}
+
+	if jsonErr := json.Unmarshal(rawQuery, apiResp); jsonErr != nil {
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, jsonErr
+	}
+
+	if apiResp.Status != "success" {
+		return nil, &QueryError{apiResp.ErrorType, apiResp.Error}
+	}
+
+	return apiResp.Data, nil
+}
+
+// ValidateQuery checks that Prometheus can evaluate a query, by evaluating it once
+func ValidateQuery(clientset kubernetes.Interface, service *v1.Service, query string) error {
+	_, err := doPromRequest(clientset, service, "/api/v1/query", map[string]string{
+		"query": query,
+	})
+
+	return err
+}
+
+// QueryRange evaluates a query over a time range, and returns a series for every set of
+// labels in the result
+func QueryRange(
+	clientset kubernetes.Interface,
+	service *v1.Service,
+	query string,
+	start, end uint,
+	step string,
+) ([]*CustomMetricSeries, error) {
+	data, err := doPromRequest(clientset, service, "/api/v1/query_range", map[string]string{
+		"query": query,
+		"start": fmt.Sprintf("%d", start),
+		"end":   fmt.Sprintf("%d", end),
+		"step":  step,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parseRangeQuery(data)
+}
+
+func parseRangeQuery(data json.RawMessage) ([]*CustomMetricSeries, error) {
+	matrix := &struct {
+		Result []struct {
+			Metric map[string]string `json:"metric"`
+			Values [][2]interface{}  `json:"values"`
+		} `json:"result"`
+	}{}
+
+	if err := json.Unmarshal(data, matrix); err != nil {
+		return nil, err
+	}
+
+	res := make([]*CustomMetricSeries, 0)
+
+	for _, result := range matrix.Result {
+		series := &CustomMetricSeries{
+			Labels:  result.Metric,
+			Results: make([]CustomMetricResult, 0),
+		}
+
+		for _, value := range result.Values {
+			date, ok := value[0].(float64)
+
+			if !ok {
+				return nil, fmt.Errorf("invalid timestamp in prometheus result")
+			}
+
+			strVal, ok := value[1].(string)
+
+			if !ok {
+				return nil, fmt.Errorf("invalid value in prometheus result")
+			}
+
+			val, err := strconv.ParseFloat(strVal, 64)
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid value in prometheus result: %w", err)
+			}
+
+			series.Results = append(series.Results, CustomMetricResult{
+				Date:  int64(date),
+				Value: val,
+			})
+		}
+
+		res = append(res, series)
+	}
+
+	return res, nil
+}
+
+// ListMetricNames returns the names of the metrics with series which match a label selector
+// between the start and the end of a time range
+func ListMetricNames(
+	clientset kubernetes.Interface,
+	service *v1.Service,
+	selector string,
+	start, end uint,
+) ([]string, error) {
+	data, err := doPromRequest(clientset, service, "/api/v1/label/__name__/values", map[string]string{
+		"match[]": fmt.Sprintf("{%s}", selector),
+		"start":   fmt.Sprintf("%d", start),
+		"end":     fmt.Sprintf("%d", end),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0)
+
+	if err := json.Unmarshal(data, &names); err != nil {
+		return nil, err
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
@@ -0,0 +1,56 @@
+package prometheus
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRenderCustomQuery(t *testing.T) {
+	data, err := GetCustomQueryData("default", "web", []Controller{
+		{Kind: "Deployment", Name: "web"},
+		{Kind: "Ingress", Name: "web"},
+		{Kind: "CronJob", Name: "web-cleanup"},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	query, err := RenderCustomQuery(`sum(rate(http_requests_total{ {{.PodSelector}} }[5m])) by (pod) # {{.Namespace}}/{{.Name}}`, data)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `sum(rate(http_requests_total{ namespace="default",pod=~"web-[a-z0-9]+-[a-z0-9]+|web-cleanup-[a-z0-9]+-[a-z0-9]+" }[5m])) by (pod) # default/web`
+
+	if query != expected {
+		t.Errorf("expected %s, got %s", expected, query)
+	}
+
+	if _, err := RenderCustomQuery(`up{ {{.Selector}} }`, data); err == nil {
+		t.Errorf("expected an error for an unknown placeholder")
+	}
+
+	if _, err := GetCustomQueryData("default", "web", []Controller{{Kind: "Ingress", Name: "web"}}); err == nil {
+		t.Errorf("expected an error for a release without controllers")
+	}
+}
+
+func TestParseRangeQuery(t *testing.T) {
+	raw := `{"resultType":"matrix","result":[{"metric":{"pod":"web-1"},"values":[[1650000000,"1.5"],[1650000060,"2"]]}]}`
+
+	series, err := parseRangeQuery(json.RawMessage(raw))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(series) != 1 || series[0].Labels["pod"] != "web-1" || len(series[0].Results) != 2 {
+		t.Fatalf("unexpected series: %+v", series)
+	}
+
+	if series[0].Results[1].Date != 1650000060 || series[0].Results[1].Value != 2 {
+		t.Errorf("unexpected result: %+v", series[0].Results[1])
+	}
+}
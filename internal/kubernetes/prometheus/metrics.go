@@ -121,12 +121,48 @@ func QueryPrometheus(
 		return nil, fmt.Errorf("prometheus service has no exposed ports to query")
 	}
 
-	selectionRegex, err := getSelectionRegex(opts.Kind, opts.Name)
+	query, err := GetQuery(clientset, service, opts)
 
 	if err != nil {
 		return nil, err
 	}
 
+	queryParams := map[string]string{
+		"query": query,
+		"start": fmt.Sprintf("%d", opts.StartRange),
+		"end":   fmt.Sprintf("%d", opts.EndRange),
+		"step":  opts.Resolution,
+	}
+
+	resp := clientset.CoreV1().Services(service.Namespace).ProxyGet(
+		"http",
+		service.Name,
+		fmt.Sprintf("%d", service.Spec.Ports[0].Port),
+		"/api/v1/query_range",
+		queryParams,
+	)
+
+	rawQuery, err := resp.DoRaw(context.TODO())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parseQuery(rawQuery, opts.Metric)
+}
+
+// GetQuery returns the PromQL query for a metric of the pods or the ingress of an application
+func GetQuery(
+	clientset kubernetes.Interface,
+	service *v1.Service,
+	opts *QueryOpts,
+) (string, error) {
+	selectionRegex, err := getSelectionRegex(opts.Kind, opts.Name)
+
+	if err != nil {
+		return "", err
+	}
+
 	var podSelector string
 
 	if len(opts.PodList) > 0 {
@@ -187,34 +223,40 @@ func QueryPrometheus(
 		}
 
 		query = createHPACurrentReplicasQuery(metricName, opts.Name, opts.Namespace, appLabel, hpaMetricName)
-	}
+	} else if opts.Metric == "memory:limit-pct" {
+		// containers without a memory limit report a limit of 0, and are ignored
+		query = fmt.Sprintf(
+			`container_memory_working_set_bytes{%s} / on(namespace,pod,container) (container_spec_memory_limit_bytes{%s} > 0) * 100`,
+			podSelector,
+			podSelector,
+		)
+	} else if opts.Metric == "hpa_replicas:max-pct" {
+		currMetricName, hpaMetricName := getKubeHPAMetricName(clientset, service, opts, "status_current_replicas")
+		maxMetricName, _ := getKubeHPAMetricName(clientset, service, opts, "spec_max_replicas")
+		ksmSvc, found, _ := getKubeStateMetricsService(clientset)
+		appLabel := ""
 
-	if opts.ShouldSum {
-		query = fmt.Sprintf("sum(%s)", query)
-	}
+		if found {
+			appLabel = ksmSvc.ObjectMeta.Labels["app.kubernetes.io/instance"]
+		}
 
-	queryParams := map[string]string{
-		"query": query,
-		"start": fmt.Sprintf("%d", opts.StartRange),
-		"end":   fmt.Sprintf("%d", opts.EndRange),
-		"step":  opts.Resolution,
+		query = fmt.Sprintf(
+			`(%s) / on(%s) (%s) * 100`,
+			createHPACurrentReplicasQuery(currMetricName, opts.Name, opts.Namespace, appLabel, hpaMetricName),
+			hpaMetricName,
+			createHPACurrentReplicasQuery(maxMetricName, opts.Name, opts.Namespace, appLabel, hpaMetricName),
+		)
 	}
 
-	resp := clientset.CoreV1().Services(service.Namespace).ProxyGet(
-		"http",
-		service.Name,
-		fmt.Sprintf("%d", service.Spec.Ports[0].Port),
-		"/api/v1/query_range",
-		queryParams,
-	)
-
-	rawQuery, err := resp.DoRaw(context.TODO())
+	if query == "" {
+		return "", fmt.Errorf("unsupported metric %s", opts.Metric)
+	}
 
-	if err != nil {
-		return nil, err
+	if opts.ShouldSum {
+		query = fmt.Sprintf("sum(%s)", query)
 	}
 
-	return parseQuery(rawQuery, opts.Metric)
+	return query, nil
 }
 
 type promRawQuery struct {
@@ -0,0 +1,102 @@
+package prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// VectorSample is a single sample of an instant vector returned by Prometheus
+type VectorSample struct {
+	Labels map[string]string `json:"labels"`
+	Value  float64           `json:"value"`
+}
+
+type promRawVectorQuery struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			Value  []interface{}     `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// QueryInstant runs an arbitrary PromQL query against the Prometheus service and returns the
+// resulting instant vector. Only queries that evaluate to an instant vector are supported.
+func QueryInstant(
+	clientset kubernetes.Interface,
+	service *v1.Service,
+	query string,
+) ([]*VectorSample, error) {
+	if len(service.Spec.Ports) == 0 {
+		return nil, fmt.Errorf("prometheus service has no exposed ports to query")
+	}
+
+	resp := clientset.CoreV1().Services(service.Namespace).ProxyGet(
+		"http",
+		service.Name,
+		fmt.Sprintf("%d", service.Spec.Ports[0].Port),
+		"/api/v1/query",
+		map[string]string{
+			"query": query,
+		},
+	)
+
+	rawQuery, err := resp.DoRaw(context.TODO())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parseVectorQuery(rawQuery)
+}
+
+func parseVectorQuery(rawQuery []byte) ([]*VectorSample, error) {
+	rawQueryObj := &promRawVectorQuery{}
+
+	if err := json.Unmarshal(rawQuery, rawQueryObj); err != nil {
+		return nil, err
+	}
+
+	if rawQueryObj.Status == "error" {
+		return nil, fmt.Errorf("prometheus query failed: %s", rawQueryObj.Error)
+	}
+
+	if rawQueryObj.Data.ResultType != "vector" {
+		return nil, fmt.Errorf("expected query to return a vector, got %s", rawQueryObj.Data.ResultType)
+	}
+
+	res := make([]*VectorSample, 0)
+
+	for _, result := range rawQueryObj.Data.Result {
+		if len(result.Value) != 2 {
+			continue
+		}
+
+		valStr, ok := result.Value[1].(string)
+
+		if !ok {
+			continue
+		}
+
+		val, err := strconv.ParseFloat(valStr, 64)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &VectorSample{
+			Labels: result.Metric,
+			Value:  val,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,177 @@
+package prometheus
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// RequestCounts are the number of requests to a set of ingresses in a time window, and the
+// number of those requests which did not meet an objective
+type RequestCounts struct {
+	Total float64
+	Bad   float64
+}
+
+// getIngressSelector returns the selector for the nginx series of a set of ingresses, which
+// matches the selector of the nginx dashboard metrics
+func getIngressSelector(namespace, ingressRegex string) string {
+	return fmt.Sprintf(`namespace="%s",ingress=~"%s"`, namespace, ingressRegex)
+}
+
+// GetErrorCounts returns the number of requests to the ingresses, and the number of requests
+// which returned a 5xx status code, in the window before the current time
+func GetErrorCounts(
+	clientset kubernetes.Interface,
+	service *v1.Service,
+	namespace, ingressRegex string,
+	window time.Duration,
+) (*RequestCounts, error) {
+	selector := getIngressSelector(namespace, ingressRegex)
+
+	total, err := querySum(clientset, service, fmt.Sprintf(
+		`sum(increase(nginx_ingress_controller_requests{%s}[%ds]))`,
+		selector, int64(window.Seconds()),
+	))
+
+	if err != nil {
+		return nil, err
+	}
+
+	bad, err := querySum(clientset, service, fmt.Sprintf(
+		`sum(increase(nginx_ingress_controller_requests{status=~"5.*",%s}[%ds]))`,
+		selector, int64(window.Seconds()),
+	))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &RequestCounts{total, bad}, nil
+}
+
+// GetLatencyCounts returns the number of requests to the ingresses, and the number of requests
+// slower than the latency bucket, in the window before the current time
+func GetLatencyCounts(
+	clientset kubernetes.Interface,
+	service *v1.Service,
+	namespace, ingressRegex, bucket string,
+	window time.Duration,
+) (*RequestCounts, error) {
+	selector := getIngressSelector(namespace, ingressRegex)
+
+	total, err := querySum(clientset, service, fmt.Sprintf(
+		`sum(increase(nginx_ingress_controller_request_duration_seconds_count{%s}[%ds]))`,
+		selector, int64(window.Seconds()),
+	))
+
+	if err != nil {
+		return nil, err
+	}
+
+	good, err := querySum(clientset, service, fmt.Sprintf(
+		`sum(increase(nginx_ingress_controller_request_duration_seconds_bucket{le="%s",%s}[%ds]))`,
+		bucket, selector, int64(window.Seconds()),
+	))
+
+	if err != nil {
+		return nil, err
+	}
+
+	bad := total - good
+
+	if bad < 0 {
+		bad = 0
+	}
+
+	return &RequestCounts{total, bad}, nil
+}
+
+// GetLatencyBucket returns the largest bucket of the nginx request duration histogram which
+// is within the threshold, since the share of requests under the threshold can only be counted
+// at a bucket boundary
+func GetLatencyBucket(
+	clientset kubernetes.Interface,
+	service *v1.Service,
+	namespace, ingressRegex string,
+	threshold time.Duration,
+) (string, error) {
+	data, err := doPromRequest(clientset, service, "/api/v1/label/le/values", map[string]string{
+		"match[]": fmt.Sprintf(
+			"nginx_ingress_controller_request_duration_seconds_bucket{%s}",
+			getIngressSelector(namespace, ingressRegex),
+		),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	values := make([]string, 0)
+
+	if err := json.Unmarshal(data, &values); err != nil {
+		return "", err
+	}
+
+	return getLatencyBucket(values, threshold)
+}
+
+func getLatencyBucket(values []string, threshold time.Duration) (string, error) {
+	type bucket struct {
+		label string
+		le    float64
+	}
+
+	buckets := make([]bucket, 0)
+
+	for _, val := range values {
+		le, err := strconv.ParseFloat(val, 64)
+
+		if err != nil {
+			continue
+		}
+
+		buckets = append(buckets, bucket{val, le})
+	}
+
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].le < buckets[j].le
+	})
+
+	res := ""
+
+	for _, b := range buckets {
+		if b.le > threshold.Seconds() {
+			break
+		}
+
+		res = b.label
+	}
+
+	if res == "" {
+		return "", fmt.Errorf("no request duration bucket is within the latency threshold of %s", threshold)
+	}
+
+	return res, nil
+}
+
+// querySum evaluates a query which returns a single sample, and returns 0 if the query
+// returned no samples
+func querySum(clientset kubernetes.Interface, service *v1.Service, query string) (float64, error) {
+	samples, err := QueryInstant(clientset, service, query)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(samples) == 0 {
+		return 0, nil
+	}
+
+	return samples[0].Value, nil
+}
@@ -0,0 +1,251 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/porter-dev/porter/api/types"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/kubernetes"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// QuotaName is the name of the ResourceQuota managed by Porter in each namespace
+	QuotaName = "porter-quota"
+
+	// LimitRangeName is the name of the LimitRange managed by Porter in each namespace
+	LimitRangeName = "porter-limits"
+)
+
+// when a quota limits cpu or memory, every container must set requests for them or it will be
+// rejected by the API server, so these defaults are used if the policy does not set any
+var (
+	fallbackCPURequest    = resource.MustParse("100m")
+	fallbackMemoryRequest = resource.MustParse("128Mi")
+)
+
+var managedLabels = map[string]string{
+	"owner": "porter",
+}
+
+// ValidatePolicy checks that all resource amounts in the policy are valid quantities
+func ValidatePolicy(policy *types.NamespacePolicy) error {
+	quantities := make(map[string]string)
+
+	if q := policy.Quota; q != nil {
+		quantities["quota.cpu"] = q.CPU
+		quantities["quota.memory"] = q.Memory
+		quantities["quota.storage"] = q.Storage
+	}
+
+	if lr := policy.LimitRange; lr != nil {
+		quantities["limit_range.default_cpu_request"] = lr.DefaultCPURequest
+		quantities["limit_range.default_memory_request"] = lr.DefaultMemoryRequest
+		quantities["limit_range.default_cpu_limit"] = lr.DefaultCPULimit
+		quantities["limit_range.default_memory_limit"] = lr.DefaultMemoryLimit
+		quantities["limit_range.max_cpu"] = lr.MaxCPU
+		quantities["limit_range.max_memory"] = lr.MaxMemory
+	}
+
+	for field, val := range quantities {
+		if val == "" {
+			continue
+		}
+
+		if _, err := resource.ParseQuantity(val); err != nil {
+			return fmt.Errorf("invalid quantity for %s: %s", field, val)
+		}
+	}
+
+	return nil
+}
+
+// Apply creates, updates or deletes the ResourceQuota and LimitRange in a namespace so that
+// they match the policy
+func Apply(clientset kubernetes.Interface, namespace string, policy *types.NamespacePolicy) error {
+	limitRange := policy.LimitRange
+
+	// if the quota limits cpu or memory, make sure containers without requests are defaulted
+	if q := policy.Quota; q != nil && (q.CPU != "" || q.Memory != "") {
+		if limitRange == nil {
+			limitRange = &types.NamespaceLimitRange{}
+		}
+
+		if limitRange.DefaultCPURequest == "" && limitRange.DefaultCPULimit == "" {
+			withDefault := *limitRange
+			withDefault.DefaultCPURequest = fallbackCPURequest.String()
+			limitRange = &withDefault
+		}
+
+		if limitRange.DefaultMemoryRequest == "" && limitRange.DefaultMemoryLimit == "" {
+			withDefault := *limitRange
+			withDefault.DefaultMemoryRequest = fallbackMemoryRequest.String()
+			limitRange = &withDefault
+		}
+	}
+
+	if err := applyResourceQuota(clientset, namespace, policy.Quota); err != nil {
+		return err
+	}
+
+	return applyLimitRange(clientset, namespace, limitRange)
+}
+
+// GetUsage returns the current usage of the Porter-managed quota in a namespace, or nil if
+// the namespace does not have a quota
+func GetUsage(clientset kubernetes.Interface, namespace string) (*types.NamespaceQuotaUsage, error) {
+	rq, err := clientset.CoreV1().ResourceQuotas(namespace).Get(context.Background(), QuotaName, metav1.GetOptions{})
+
+	if errors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	res := &types.NamespaceQuotaUsage{
+		Hard: make(map[string]string),
+		Used: make(map[string]string),
+	}
+
+	for name, val := range rq.Status.Hard {
+		res.Hard[string(name)] = val.String()
+	}
+
+	for name, val := range rq.Status.Used {
+		res.Used[string(name)] = val.String()
+	}
+
+	return res, nil
+}
+
+func applyResourceQuota(clientset kubernetes.Interface, namespace string, q *types.NamespaceQuota) error {
+	client := clientset.CoreV1().ResourceQuotas(namespace)
+
+	hard := v1.ResourceList{}
+
+	if q != nil {
+		setQuantity(hard, v1.ResourceRequestsCPU, q.CPU)
+		setQuantity(hard, v1.ResourceRequestsMemory, q.Memory)
+		setQuantity(hard, v1.ResourceRequestsStorage, q.Storage)
+
+		if q.Pods != 0 {
+			hard[v1.ResourcePods] = *resource.NewQuantity(int64(q.Pods), resource.DecimalSI)
+		}
+
+		if q.PersistentVolumeClaims != 0 {
+			hard[v1.ResourcePersistentVolumeClaims] = *resource.NewQuantity(int64(q.PersistentVolumeClaims), resource.DecimalSI)
+		}
+	}
+
+	existing, err := client.Get(context.Background(), QuotaName, metav1.GetOptions{})
+
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	exists := err == nil
+
+	if len(hard) == 0 {
+		if exists {
+			return client.Delete(context.Background(), QuotaName, metav1.DeleteOptions{})
+		}
+
+		return nil
+	}
+
+	if exists {
+		existing.Labels = managedLabels
+		existing.Spec.Hard = hard
+
+		_, err = client.Update(context.Background(), existing, metav1.UpdateOptions{})
+
+		return err
+	}
+
+	_, err = client.Create(context.Background(), &v1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      QuotaName,
+			Namespace: namespace,
+			Labels:    managedLabels,
+		},
+		Spec: v1.ResourceQuotaSpec{
+			Hard: hard,
+		},
+	}, metav1.CreateOptions{})
+
+	return err
+}
+
+func applyLimitRange(clientset kubernetes.Interface, namespace string, lr *types.NamespaceLimitRange) error {
+	client := clientset.CoreV1().LimitRanges(namespace)
+
+	item := v1.LimitRangeItem{
+		Type:           v1.LimitTypeContainer,
+		Default:        v1.ResourceList{},
+		DefaultRequest: v1.ResourceList{},
+		Max:            v1.ResourceList{},
+	}
+
+	if lr != nil {
+		setQuantity(item.DefaultRequest, v1.ResourceCPU, lr.DefaultCPURequest)
+		setQuantity(item.DefaultRequest, v1.ResourceMemory, lr.DefaultMemoryRequest)
+		setQuantity(item.Default, v1.ResourceCPU, lr.DefaultCPULimit)
+		setQuantity(item.Default, v1.ResourceMemory, lr.DefaultMemoryLimit)
+		setQuantity(item.Max, v1.ResourceCPU, lr.MaxCPU)
+		setQuantity(item.Max, v1.ResourceMemory, lr.MaxMemory)
+	}
+
+	existing, err := client.Get(context.Background(), LimitRangeName, metav1.GetOptions{})
+
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	exists := err == nil
+
+	if len(item.Default)+len(item.DefaultRequest)+len(item.Max) == 0 {
+		if exists {
+			return client.Delete(context.Background(), LimitRangeName, metav1.DeleteOptions{})
+		}
+
+		return nil
+	}
+
+	spec := v1.LimitRangeSpec{
+		Limits: []v1.LimitRangeItem{item},
+	}
+
+	if exists {
+		existing.Labels = managedLabels
+		existing.Spec = spec
+
+		_, err = client.Update(context.Background(), existing, metav1.UpdateOptions{})
+
+		return err
+	}
+
+	_, err = client.Create(context.Background(), &v1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      LimitRangeName,
+			Namespace: namespace,
+			Labels:    managedLabels,
+		},
+		Spec: spec,
+	}, metav1.CreateOptions{})
+
+	return err
+}
+
+func setQuantity(list v1.ResourceList, name v1.ResourceName, val string) {
+	if val == "" {
+		return
+	}
+
+	if qty, err := resource.ParseQuantity(val); err == nil {
+		list[name] = qty
+	}
+}
@@ -0,0 +1,52 @@
+package quota
+
+import (
+	"errors"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// GetPolicy reads the stored policy for a namespace. If no policy has been saved, an empty
+// policy is returned.
+func GetPolicy(repo repository.Repository, projectID, clusterID uint, namespace string) (*types.NamespacePolicy, error) {
+	policy, err := repo.NamespacePolicy().ReadNamespacePolicy(projectID, clusterID, namespace)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &types.NamespacePolicy{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return policy.ToNamespacePolicyType(), nil
+}
+
+// SavePolicy creates or updates the stored policy for a namespace
+func SavePolicy(
+	repo repository.Repository,
+	projectID, clusterID uint,
+	namespace string,
+	policy *types.NamespacePolicy,
+) (*models.NamespacePolicy, error) {
+	model, err := repo.NamespacePolicy().ReadNamespacePolicy(projectID, clusterID, namespace)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		model = &models.NamespacePolicy{
+			ProjectID: projectID,
+			ClusterID: clusterID,
+			Namespace: namespace,
+		}
+
+		model.SetPolicy(policy)
+
+		return repo.NamespacePolicy().CreateNamespacePolicy(model)
+	} else if err != nil {
+		return nil, err
+	}
+
+	model.SetPolicy(policy)
+
+	return repo.NamespacePolicy().UpdateNamespacePolicy(model)
+}
@@ -0,0 +1,248 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/porter-dev/porter/internal/kubernetes/nodes"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExceededResource is a single quota resource which would be exceeded by an upgrade
+type ExceededResource struct {
+	Resource  v1.ResourceName
+	Requested resource.Quantity
+	Used      resource.Quantity
+	Hard      resource.Quantity
+}
+
+// ExceededError is returned when an upgrade would exceed the namespace quota
+type ExceededError struct {
+	Namespace string
+	Exceeded  []*ExceededResource
+}
+
+func (e *ExceededError) Error() string {
+	msgs := make([]string, 0)
+
+	for _, exceeded := range e.Exceeded {
+		msgs = append(msgs, fmt.Sprintf(
+			"%s: requested %s more, but %s of %s is used",
+			exceeded.Resource,
+			exceeded.Requested.String(),
+			exceeded.Used.String(),
+			exceeded.Hard.String(),
+		))
+	}
+
+	return fmt.Sprintf("release would exceed the resource quota for namespace %s (%s)", e.Namespace, strings.Join(msgs, ", "))
+}
+
+// ValidateUpgrade checks whether replacing the old release manifest with the new manifest
+// would exceed the Porter-managed quota in the namespace. Only the steady-state resources of
+// the workloads are compared, so pods created during a rolling update are not counted.
+func ValidateUpgrade(clientset kubernetes.Interface, namespace, oldManifest, newManifest string) error {
+	rq, err := clientset.CoreV1().ResourceQuotas(namespace).Get(context.Background(), QuotaName, metav1.GetOptions{})
+
+	if errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	defaults, err := getContainerDefaults(clientset, namespace)
+
+	if err != nil {
+		return err
+	}
+
+	oldReqs := ManifestRequests(oldManifest, defaults)
+	newReqs := ManifestRequests(newManifest, defaults)
+
+	exceeded := make([]*ExceededResource, 0)
+
+	for name, hard := range rq.Spec.Hard {
+		delta := newReqs[name].DeepCopy()
+		delta.Sub(oldReqs[name])
+
+		if delta.Sign() <= 0 {
+			continue
+		}
+
+		total := rq.Status.Used[name].DeepCopy()
+		total.Add(delta)
+
+		if total.Cmp(hard) > 0 {
+			exceeded = append(exceeded, &ExceededResource{
+				Resource:  name,
+				Requested: delta,
+				Used:      rq.Status.Used[name],
+				Hard:      hard,
+			})
+		}
+	}
+
+	if len(exceeded) > 0 {
+		return &ExceededError{
+			Namespace: namespace,
+			Exceeded:  exceeded,
+		}
+	}
+
+	return nil
+}
+
+// ManifestRequests returns the quota resources requested by the objects in a manifest. Each
+// container without a cpu or memory request is assigned the given default requests.
+func ManifestRequests(manifest string, defaults *ContainerDefaults) v1.ResourceList {
+	res := v1.ResourceList{}
+	decoder := scheme.Codecs.UniversalDeserializer()
+
+	for _, doc := range releaseutil.SplitManifests(manifest) {
+		obj, _, err := decoder.Decode([]byte(doc), nil, nil)
+
+		if err != nil {
+			continue
+		}
+
+		switch o := obj.(type) {
+		case *appsv1.Deployment:
+			addPods(res, &o.Spec.Template.Spec, replicas(o.Spec.Replicas), defaults)
+		case *appsv1.StatefulSet:
+			addPods(res, &o.Spec.Template.Spec, replicas(o.Spec.Replicas), defaults)
+
+			for _, pvc := range o.Spec.VolumeClaimTemplates {
+				for i := int64(0); i < replicas(o.Spec.Replicas); i++ {
+					addPVC(res, &pvc)
+				}
+			}
+		case *appsv1.ReplicaSet:
+			addPods(res, &o.Spec.Template.Spec, replicas(o.Spec.Replicas), defaults)
+		case *batchv1.Job:
+			addPods(res, &o.Spec.Template.Spec, replicas(o.Spec.Parallelism), defaults)
+		case *v1.Pod:
+			addPods(res, &o.Spec, 1, defaults)
+		case *v1.PersistentVolumeClaim:
+			addPVC(res, o)
+		}
+	}
+
+	return res
+}
+
+// ContainerDefaults are the requests assigned to containers which do not set them
+type ContainerDefaults struct {
+	Requests v1.ResourceList
+	Limits   v1.ResourceList
+}
+
+func getContainerDefaults(clientset kubernetes.Interface, namespace string) (*ContainerDefaults, error) {
+	res := &ContainerDefaults{
+		Requests: v1.ResourceList{},
+		Limits:   v1.ResourceList{},
+	}
+
+	lr, err := clientset.CoreV1().LimitRanges(namespace).Get(context.Background(), LimitRangeName, metav1.GetOptions{})
+
+	if errors.IsNotFound(err) {
+		return res, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	for _, item := range lr.Spec.Limits {
+		if item.Type != v1.LimitTypeContainer {
+			continue
+		}
+
+		for name, qty := range item.DefaultRequest {
+			res.Requests[name] = qty
+		}
+
+		for name, qty := range item.Default {
+			res.Limits[name] = qty
+		}
+	}
+
+	return res, nil
+}
+
+func addPods(res v1.ResourceList, spec *v1.PodSpec, count int64, defaults *ContainerDefaults) {
+	if count == 0 {
+		return
+	}
+
+	pod := &v1.Pod{Spec: *spec.DeepCopy()}
+
+	for i := range pod.Spec.Containers {
+		applyDefaults(&pod.Spec.Containers[i], defaults)
+	}
+
+	for i := range pod.Spec.InitContainers {
+		applyDefaults(&pod.Spec.InitContainers[i], defaults)
+	}
+
+	reqs := nodes.GetPodRequests(pod)
+
+	addQuantity(res, v1.ResourcePods, *resource.NewQuantity(count, resource.DecimalSI))
+	addQuantity(res, v1.ResourceRequestsCPU, multiply(reqs[v1.ResourceCPU], count))
+	addQuantity(res, v1.ResourceRequestsMemory, multiply(reqs[v1.ResourceMemory], count))
+}
+
+// applyDefaults sets the requests of a container the same way the API server does: a
+// missing request defaults to the container's limit, then to the LimitRange defaults
+func applyDefaults(container *v1.Container, defaults *ContainerDefaults) {
+	if container.Resources.Requests == nil {
+		container.Resources.Requests = v1.ResourceList{}
+	}
+
+	for _, name := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+		if _, exists := container.Resources.Requests[name]; exists {
+			continue
+		}
+
+		if limit, exists := container.Resources.Limits[name]; exists {
+			container.Resources.Requests[name] = limit
+		} else if req, exists := defaults.Requests[name]; exists {
+			container.Resources.Requests[name] = req
+		} else if limit, exists := defaults.Limits[name]; exists {
+			container.Resources.Requests[name] = limit
+		}
+	}
+}
+
+func addPVC(res v1.ResourceList, pvc *v1.PersistentVolumeClaim) {
+	addQuantity(res, v1.ResourcePersistentVolumeClaims, *resource.NewQuantity(1, resource.DecimalSI))
+
+	if storage, exists := pvc.Spec.Resources.Requests[v1.ResourceStorage]; exists {
+		addQuantity(res, v1.ResourceRequestsStorage, storage)
+	}
+}
+
+func addQuantity(res v1.ResourceList, name v1.ResourceName, qty resource.Quantity) {
+	curr := res[name].DeepCopy()
+	curr.Add(qty)
+	res[name] = curr
+}
+
+func multiply(qty resource.Quantity, count int64) resource.Quantity {
+	return *resource.NewMilliQuantity(qty.MilliValue()*count, qty.Format)
+}
+
+func replicas(val *int32) int64 {
+	if val == nil {
+		return 1
+	}
+
+	return int64(*val)
+}
@@ -0,0 +1,123 @@
+package quota_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/porter-dev/porter/internal/kubernetes/quota"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const deploymentTemplate = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: %d
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+      - name: web
+        image: nginx
+        resources:
+          requests:
+            cpu: 500m
+`
+
+func manifest(replicas int) string {
+	return fmt.Sprintf(deploymentTemplate, replicas)
+}
+
+func newClientset() *fake.Clientset {
+	return fake.NewSimpleClientset(
+		&v1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: quota.QuotaName, Namespace: "default"},
+			Spec: v1.ResourceQuotaSpec{
+				Hard: v1.ResourceList{
+					v1.ResourceRequestsCPU:    resource.MustParse("2"),
+					v1.ResourceRequestsMemory: resource.MustParse("1Gi"),
+				},
+			},
+			Status: v1.ResourceQuotaStatus{
+				Used: v1.ResourceList{
+					v1.ResourceRequestsCPU:    resource.MustParse("1"),
+					v1.ResourceRequestsMemory: resource.MustParse("256Mi"),
+				},
+			},
+		},
+		&v1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{Name: quota.LimitRangeName, Namespace: "default"},
+			Spec: v1.LimitRangeSpec{
+				Limits: []v1.LimitRangeItem{{
+					Type: v1.LimitTypeContainer,
+					DefaultRequest: v1.ResourceList{
+						v1.ResourceMemory: resource.MustParse("128Mi"),
+					},
+				}},
+			},
+		},
+	)
+}
+
+func TestManifestRequestsAppliesDefaults(t *testing.T) {
+	reqs := quota.ManifestRequests(manifest(3), &quota.ContainerDefaults{
+		Requests: v1.ResourceList{v1.ResourceMemory: resource.MustParse("128Mi")},
+	})
+
+	expected := map[v1.ResourceName]string{
+		v1.ResourcePods:           "3",
+		v1.ResourceRequestsCPU:    "1500m",
+		v1.ResourceRequestsMemory: "384Mi",
+	}
+
+	for name, exp := range expected {
+		qty := reqs[name]
+
+		if qty.Cmp(resource.MustParse(exp)) != 0 {
+			t.Errorf("%s: expected %s, got %s", name, exp, qty.String())
+		}
+	}
+}
+
+func TestValidateUpgrade(t *testing.T) {
+	clientset := newClientset()
+
+	// 2 -> 4 replicas adds 1 cpu, which fits exactly in the remaining quota
+	if err := quota.ValidateUpgrade(clientset, "default", manifest(2), manifest(4)); err != nil {
+		t.Errorf("expected upgrade to fit in quota, got %v", err)
+	}
+
+	// 2 -> 5 replicas adds 1.5 cpu, which exceeds the quota
+	err := quota.ValidateUpgrade(clientset, "default", manifest(2), manifest(5))
+
+	var exceededErr *quota.ExceededError
+
+	if !errors.As(err, &exceededErr) {
+		t.Fatalf("expected quota exceeded error, got %v", err)
+	}
+
+	if len(exceededErr.Exceeded) != 1 || exceededErr.Exceeded[0].Resource != v1.ResourceRequestsCPU {
+		t.Errorf("expected only cpu requests to be exceeded, got %v", exceededErr)
+	}
+
+	// scaling down is always allowed
+	if err := quota.ValidateUpgrade(clientset, "default", manifest(5), manifest(1)); err != nil {
+		t.Errorf("expected scale down to be allowed, got %v", err)
+	}
+}
+
+func TestValidateUpgradeWithoutQuota(t *testing.T) {
+	if err := quota.ValidateUpgrade(fake.NewSimpleClientset(), "default", "", manifest(100)); err != nil {
+		t.Errorf("expected no error without a quota, got %v", err)
+	}
+}
@@ -0,0 +1,460 @@
+package remotebuild
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// LabelBuildID is the label on build jobs and pods which holds the id of the build
+	LabelBuildID = "porter.run/remote-build-id"
+
+	annotationImageRepoURI = "porter.run/image-repo-uri"
+	annotationTag          = "porter.run/tag"
+	annotationBuilder      = "porter.run/builder"
+
+	KanikoImage   = "gcr.io/kaniko-project/executor:v1.7.0"
+	BuildKitImage = "moby/buildkit:v0.9.3-rootless"
+	contextImage  = "busybox:1.35"
+
+	contextContainerName = "context"
+	buildContainerName   = "build"
+
+	workspaceDir = "/workspace"
+	contextDir   = workspaceDir + "/context"
+
+	// the file which marks the build context as uploaded
+	uploadedMarker = workspaceDir + "/.uploaded"
+
+	// ContextUploadTimeout is how long a build waits for its build context to be uploaded
+	ContextUploadTimeout = 10 * time.Minute
+
+	buildTimeout = time.Hour
+	jobTTL       = time.Hour
+)
+
+// ErrBuildNotFound is returned when a build does not exist in the namespace
+var ErrBuildNotFound = fmt.Errorf("build not found")
+
+// Opts are the options for an image build in the cluster
+type Opts struct {
+	Namespace    string
+	Builder      types.RemoteBuilder
+	ImageRepoURI string
+	Tag          string
+
+	// Dockerfile is the path of the Dockerfile relative to the root of the build context
+	Dockerfile string
+	BuildArgs  map[string]string
+
+	// CacheFrom and CacheTo are registry image refs used to import and export the build
+	// cache. Kaniko stores its cache in a single repository, which is the repository of
+	// CacheTo if set, or of the first CacheFrom ref otherwise.
+	CacheFrom []string
+	CacheTo   string
+
+	// DockerConfigJSON authenticates the builder with the registry the image is pushed to
+	DockerConfigJSON []byte
+}
+
+// Create creates a job which builds and pushes an image once its build context has been
+// uploaded with UploadContext
+func Create(clientset kubernetes.Interface, opts *Opts) (*types.RemoteBuild, error) {
+	if opts.Builder == "" {
+		opts.Builder = types.RemoteBuilderKaniko
+	}
+
+	if opts.Dockerfile == "" {
+		opts.Dockerfile = "Dockerfile"
+	}
+
+	id := utilrand.String(10)
+	name := jobName(id)
+
+	job, err := clientset.BatchV1().Jobs(opts.Namespace).Create(
+		context.Background(),
+		getJob(name, id, opts),
+		metav1.CreateOptions{},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the registry credentials are owned by the job, so they are deleted along with it
+	_, err = clientset.CoreV1().Secrets(opts.Namespace).Create(
+		context.Background(),
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: opts.Namespace,
+				Labels: map[string]string{
+					LabelBuildID: id,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(job, batchv1.SchemeGroupVersion.WithKind("Job")),
+				},
+			},
+			Type: v1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{
+				v1.DockerConfigJsonKey: opts.DockerConfigJSON,
+			},
+		},
+		metav1.CreateOptions{},
+	)
+
+	if err != nil {
+		Delete(clientset, opts.Namespace, id)
+
+		return nil, err
+	}
+
+	return toRemoteBuild(job, nil), nil
+}
+
+// Get returns a build and its status
+func Get(clientset kubernetes.Interface, namespace, id string) (*types.RemoteBuild, error) {
+	job, err := clientset.BatchV1().Jobs(namespace).Get(context.Background(), jobName(id), metav1.GetOptions{})
+
+	if err != nil && errors.IsNotFound(err) {
+		return nil, ErrBuildNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	pod, err := GetPod(clientset, namespace, id)
+
+	if err != nil && err != ErrBuildNotFound {
+		return nil, err
+	}
+
+	return toRemoteBuild(job, pod), nil
+}
+
+// Delete deletes a build job, along with its pod and registry credentials
+func Delete(clientset kubernetes.Interface, namespace, id string) error {
+	propagation := metav1.DeletePropagationBackground
+
+	err := clientset.BatchV1().Jobs(namespace).Delete(
+		context.Background(),
+		jobName(id),
+		metav1.DeleteOptions{
+			PropagationPolicy: &propagation,
+		},
+	)
+
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// GetPod returns the pod which runs a build
+func GetPod(clientset kubernetes.Interface, namespace, id string) (*v1.Pod, error) {
+	pods, err := clientset.CoreV1().Pods(namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", LabelBuildID, id),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pods.Items) == 0 {
+		return nil, ErrBuildNotFound
+	}
+
+	return &pods.Items[0], nil
+}
+
+func jobName(id string) string {
+	return fmt.Sprintf("porter-build-%s", id)
+}
+
+func getJob(name, id string, opts *Opts) *batchv1.Job {
+	backoffLimit := int32(0)
+	activeDeadline := int64(buildTimeout.Seconds())
+	ttl := int32(jobTTL.Seconds())
+
+	labels := map[string]string{
+		LabelBuildID: id,
+	}
+
+	annotations := map[string]string{
+		annotationImageRepoURI: opts.ImageRepoURI,
+		annotationTag:          opts.Tag,
+		annotationBuilder:      string(opts.Builder),
+	}
+
+	podAnnotations := map[string]string{}
+
+	buildContainer := getBuildContainer(opts)
+
+	if opts.Builder == types.RemoteBuilderBuildKit {
+		// rootless buildkit needs to create user namespaces, which the default apparmor
+		// profile does not allow
+		podAnnotations["container.apparmor.security.beta.kubernetes.io/"+buildContainerName] = "unconfined"
+	}
+
+	// the context container waits for the build context to be extracted into the workspace
+	// by UploadContext, and fails the build if it isn't uploaded in time
+	waitScript := fmt.Sprintf(
+		"mkdir -p %s; for i in $(seq %d); do [ -f %s ] && exit 0; sleep 1; done; echo 'timed out waiting for the build context to be uploaded'; exit 1",
+		contextDir, int(ContextUploadTimeout.Seconds()), uploadedMarker,
+	)
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   opts.Namespace,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            &backoffLimit,
+			ActiveDeadlineSeconds:   &activeDeadline,
+			TTLSecondsAfterFinished: &ttl,
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      labels,
+					Annotations: podAnnotations,
+				},
+				Spec: v1.PodSpec{
+					RestartPolicy: v1.RestartPolicyNever,
+					InitContainers: []v1.Container{
+						{
+							Name:    contextContainerName,
+							Image:   contextImage,
+							Command: []string{"sh", "-c", waitScript},
+							VolumeMounts: []v1.VolumeMount{
+								{
+									Name:      "workspace",
+									MountPath: workspaceDir,
+								},
+							},
+						},
+					},
+					Containers: []v1.Container{*buildContainer},
+					Volumes: []v1.Volume{
+						{
+							Name: "workspace",
+							VolumeSource: v1.VolumeSource{
+								EmptyDir: &v1.EmptyDirVolumeSource{},
+							},
+						},
+						{
+							Name: "docker-config",
+							VolumeSource: v1.VolumeSource{
+								Secret: &v1.SecretVolumeSource{
+									SecretName: name,
+									Items: []v1.KeyToPath{
+										{
+											Key:  v1.DockerConfigJsonKey,
+											Path: "config.json",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getBuildContainer(opts *Opts) *v1.Container {
+	destination := fmt.Sprintf("%s:%s", opts.ImageRepoURI, opts.Tag)
+
+	// sort build args so that the job spec is deterministic
+	buildArgKeys := make([]string, 0, len(opts.BuildArgs))
+
+	for key := range opts.BuildArgs {
+		buildArgKeys = append(buildArgKeys, key)
+	}
+
+	sort.Strings(buildArgKeys)
+
+	if opts.Builder == types.RemoteBuilderBuildKit {
+		dockerfileDir, dockerfileName := path.Split(path.Join(contextDir, opts.Dockerfile))
+
+		args := []string{
+			"build",
+			"--frontend", "dockerfile.v0",
+			"--local", "context=" + contextDir,
+			"--local", "dockerfile=" + strings.TrimSuffix(dockerfileDir, "/"),
+			"--opt", "filename=" + dockerfileName,
+			"--output", fmt.Sprintf("type=image,name=%s,push=true", destination),
+		}
+
+		for _, key := range buildArgKeys {
+			args = append(args, "--opt", fmt.Sprintf("build-arg:%s=%s", key, opts.BuildArgs[key]))
+		}
+
+		for _, ref := range opts.CacheFrom {
+			args = append(args, "--import-cache", "type=registry,ref="+ref)
+		}
+
+		if opts.CacheTo != "" {
+			args = append(args,
+				"--import-cache", "type=registry,ref="+opts.CacheTo,
+				"--export-cache", fmt.Sprintf("type=registry,ref=%s,mode=max", opts.CacheTo),
+			)
+		}
+
+		runAs := int64(1000)
+
+		return &v1.Container{
+			Name:    buildContainerName,
+			Image:   BuildKitImage,
+			Command: []string{"buildctl-daemonless.sh"},
+			Args:    args,
+			Env: []v1.EnvVar{
+				{
+					Name:  "BUILDKITD_FLAGS",
+					Value: "--oci-worker-no-process-sandbox",
+				},
+				{
+					Name:  "DOCKER_CONFIG",
+					Value: "/home/user/.docker",
+				},
+			},
+			SecurityContext: &v1.SecurityContext{
+				RunAsUser:  &runAs,
+				RunAsGroup: &runAs,
+				SeccompProfile: &v1.SeccompProfile{
+					Type: v1.SeccompProfileTypeUnconfined,
+				},
+			},
+			VolumeMounts: []v1.VolumeMount{
+				{
+					Name:      "workspace",
+					MountPath: workspaceDir,
+				},
+				{
+					Name:      "docker-config",
+					MountPath: "/home/user/.docker",
+				},
+			},
+		}
+	}
+
+	args := []string{
+		"--context=dir://" + contextDir,
+		"--dockerfile=" + path.Join(contextDir, opts.Dockerfile),
+		"--destination=" + destination,
+	}
+
+	for _, key := range buildArgKeys {
+		args = append(args, fmt.Sprintf("--build-arg=%s=%s", key, opts.BuildArgs[key]))
+	}
+
+	if cacheRepo := getKanikoCacheRepo(opts); cacheRepo != "" {
+		args = append(args, "--cache=true", "--cache-repo="+cacheRepo)
+	}
+
+	return &v1.Container{
+		Name:  buildContainerName,
+		Image: KanikoImage,
+		Args:  args,
+		VolumeMounts: []v1.VolumeMount{
+			{
+				Name:      "workspace",
+				MountPath: workspaceDir,
+			},
+			{
+				Name:      "docker-config",
+				MountPath: "/kaniko/.docker",
+			},
+		},
+	}
+}
+
+func getKanikoCacheRepo(opts *Opts) string {
+	ref := opts.CacheTo
+
+	if ref == "" && len(opts.CacheFrom) > 0 {
+		ref = opts.CacheFrom[0]
+	}
+
+	// strip the tag, since kaniko tags cached layers by their cache key
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		ref = ref[:i]
+	}
+
+	return ref
+}
+
+func toRemoteBuild(job *batchv1.Job, pod *v1.Pod) *types.RemoteBuild {
+	res := &types.RemoteBuild{
+		ID:           job.Labels[LabelBuildID],
+		Namespace:    job.Namespace,
+		Builder:      types.RemoteBuilder(job.Annotations[annotationBuilder]),
+		ImageRepoURI: job.Annotations[annotationImageRepoURI],
+		Tag:          job.Annotations[annotationTag],
+		Status:       types.RemoteBuildStatusPending,
+		CreatedAt:    job.CreationTimestamp.Time,
+	}
+
+	if job.Status.CompletionTime != nil {
+		res.CompletedAt = &job.Status.CompletionTime.Time
+	}
+
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != v1.ConditionTrue {
+			continue
+		}
+
+		switch cond.Type {
+		case batchv1.JobComplete:
+			res.Status = types.RemoteBuildStatusSucceeded
+			return res
+		case batchv1.JobFailed:
+			res.Status = types.RemoteBuildStatusFailed
+			res.Message = cond.Message
+			res.CompletedAt = &cond.LastTransitionTime.Time
+		}
+	}
+
+	if pod == nil {
+		return res
+	}
+
+	for _, status := range pod.Status.InitContainerStatuses {
+		if status.Name == contextContainerName && status.State.Terminated != nil && status.State.Terminated.ExitCode != 0 {
+			res.Status = types.RemoteBuildStatusFailed
+			res.Message = "the build context was not uploaded"
+			return res
+		}
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name != buildContainerName {
+			continue
+		}
+
+		if status.State.Terminated != nil && status.State.Terminated.ExitCode != 0 {
+			res.Status = types.RemoteBuildStatusFailed
+
+			if res.Message == "" {
+				res.Message = fmt.Sprintf("the build exited with code %d", status.State.Terminated.ExitCode)
+			}
+		} else if res.Status == types.RemoteBuildStatusPending && (status.State.Running != nil || status.State.Terminated != nil) {
+			res.Status = types.RemoteBuildStatusBuilding
+		}
+	}
+
+	return res
+}
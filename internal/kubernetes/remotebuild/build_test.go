@@ -0,0 +1,132 @@
+package remotebuild_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/remotebuild"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCreateAndGetBuild(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+
+	build, err := remotebuild.Create(clientset, &remotebuild.Opts{
+		Namespace:        "default",
+		ImageRepoURI:     "registry.example.com/porter/web",
+		Tag:              "abc1234",
+		Dockerfile:       "docker/prod.Dockerfile",
+		BuildArgs:        map[string]string{"NODE_ENV": "production"},
+		DockerConfigJSON: []byte(`{"auths":{}}`),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if build.Builder != types.RemoteBuilderKaniko || build.Status != types.RemoteBuildStatusPending {
+		t.Errorf("unexpected build: %+v", build)
+	}
+
+	job, err := clientset.BatchV1().Jobs("default").Get(context.Background(), "porter-build-"+build.ID, metav1.GetOptions{})
+
+	if err != nil {
+		t.Fatalf("expected build job to exist: %v", err)
+	}
+
+	args := strings.Join(job.Spec.Template.Spec.Containers[0].Args, " ")
+
+	for _, expected := range []string{
+		"--destination=registry.example.com/porter/web:abc1234",
+		"docker/prod.Dockerfile",
+		"--build-arg=NODE_ENV=production",
+	} {
+		if !strings.Contains(args, expected) {
+			t.Errorf("expected kaniko args to contain %q, got %q", expected, args)
+		}
+	}
+
+	secret, err := clientset.CoreV1().Secrets("default").Get(context.Background(), job.Name, metav1.GetOptions{})
+
+	if err != nil {
+		t.Fatalf("expected registry credentials to exist: %v", err)
+	}
+
+	if len(secret.OwnerReferences) != 1 || secret.OwnerReferences[0].Name != job.Name {
+		t.Errorf("expected registry credentials to be owned by the build job")
+	}
+
+	// mark the job as failed and check the status
+	job.Status.Conditions = []batchv1.JobCondition{{
+		Type:    batchv1.JobFailed,
+		Status:  v1.ConditionTrue,
+		Message: "BackoffLimitExceeded",
+	}}
+
+	_, err = clientset.BatchV1().Jobs("default").UpdateStatus(context.Background(), job, metav1.UpdateOptions{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	build, err = remotebuild.Get(clientset, "default", build.ID)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if build.Status != types.RemoteBuildStatusFailed || build.Message != "BackoffLimitExceeded" {
+		t.Errorf("expected failed build, got %+v", build)
+	}
+
+	if _, err := remotebuild.Get(clientset, "default", "missing"); err != remotebuild.ErrBuildNotFound {
+		t.Errorf("expected ErrBuildNotFound, got %v", err)
+	}
+}
+
+func TestCreateBuildWithRegistryCache(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+
+	for builder, expected := range map[types.RemoteBuilder][]string{
+		types.RemoteBuilderKaniko: {
+			"--cache=true",
+			"--cache-repo=registry.example.com/porter/web-cache",
+		},
+		types.RemoteBuilderBuildKit: {
+			"--import-cache type=registry,ref=registry.example.com/porter/web:abc1233",
+			"--export-cache type=registry,ref=registry.example.com/porter/web-cache:main,mode=max",
+		},
+	} {
+		build, err := remotebuild.Create(clientset, &remotebuild.Opts{
+			Namespace:    "default",
+			Builder:      builder,
+			ImageRepoURI: "registry.example.com/porter/web",
+			Tag:          "abc1234",
+			CacheFrom:    []string{"registry.example.com/porter/web:abc1233"},
+			CacheTo:      "registry.example.com/porter/web-cache:main",
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		job, err := clientset.BatchV1().Jobs("default").Get(context.Background(), "porter-build-"+build.ID, metav1.GetOptions{})
+
+		if err != nil {
+			t.Fatalf("expected build job to exist: %v", err)
+		}
+
+		args := strings.Join(job.Spec.Template.Spec.Containers[0].Args, " ")
+
+		for _, arg := range expected {
+			if !strings.Contains(args, arg) {
+				t.Errorf("expected %s args to contain %q, got %q", builder, arg, args)
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package remotebuild
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// StreamLogs writes the logs of the builder to w, line by line, until the build finishes or
+// the context is cancelled
+func StreamLogs(ctx context.Context, clientset kubernetes.Interface, namespace, id string, w io.Writer) error {
+	pod, err := waitForBuildContainer(ctx, clientset, namespace, id)
+
+	if err != nil {
+		return err
+	}
+
+	req := clientset.CoreV1().Pods(namespace).GetLogs(pod.Name, &v1.PodLogOptions{
+		Container: buildContainerName,
+		Follow:    true,
+	})
+
+	stream, err := req.Stream(ctx)
+
+	if err != nil {
+		return fmt.Errorf("could not open log stream for build %s: %w", id, err)
+	}
+
+	defer stream.Close()
+
+	r := bufio.NewReader(stream)
+
+	for {
+		line, err := r.ReadBytes('\n')
+
+		if len(line) > 0 {
+			if _, writeErr := w.Write(line); writeErr != nil {
+				return writeErr
+			}
+		}
+
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// waitForBuildContainer waits until the build container has started, which happens once the
+// build context has been uploaded and the builder image has been pulled
+func waitForBuildContainer(ctx context.Context, clientset kubernetes.Interface, namespace, id string) (*v1.Pod, error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	timeout := time.After(ContextUploadTimeout + 5*time.Minute)
+
+	for {
+		pod, err := GetPod(clientset, namespace, id)
+
+		if err != nil && err != ErrBuildNotFound {
+			return nil, err
+		}
+
+		if pod != nil {
+			for _, status := range pod.Status.InitContainerStatuses {
+				if status.Name == contextContainerName && status.State.Terminated != nil && status.State.Terminated.ExitCode != 0 {
+					return nil, fmt.Errorf("build %s failed before it started: the build context was not uploaded", id)
+				}
+			}
+
+			for _, status := range pod.Status.ContainerStatuses {
+				if status.Name == buildContainerName && (status.State.Running != nil || status.State.Terminated != nil) {
+					return pod, nil
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timeout:
+			return nil, fmt.Errorf("timed out waiting for build %s to start", id)
+		case <-ticker.C:
+		}
+	}
+}
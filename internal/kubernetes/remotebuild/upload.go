@@ -0,0 +1,96 @@
+package remotebuild
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// UploadContext extracts a gzipped tarball of the build context into the workspace of the
+// build, which starts the build once the tarball has been extracted
+func UploadContext(clientset kubernetes.Interface, restConf *rest.Config, namespace, id string, buildContext io.Reader) error {
+	pod, err := waitForContextContainer(clientset, namespace, id)
+
+	if err != nil {
+		return err
+	}
+
+	req := clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(pod.Name).
+		Namespace(namespace).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: contextContainerName,
+			Command: []string{
+				"sh", "-c", fmt.Sprintf("tar -xzf - -C %s && touch %s", contextDir, uploadedMarker),
+			},
+			Stdin:  true,
+			Stdout: true,
+			Stderr: true,
+		}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(restConf, "POST", req.URL())
+
+	if err != nil {
+		return err
+	}
+
+	stderr := &bytes.Buffer{}
+
+	err = exec.Stream(remotecommand.StreamOptions{
+		Stdin:  buildContext,
+		Stdout: io.Discard,
+		Stderr: stderr,
+	})
+
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("could not extract build context: %s", msg)
+		}
+
+		return fmt.Errorf("could not extract build context: %w", err)
+	}
+
+	return nil
+}
+
+// waitForContextContainer waits until the context container of the build is running, so
+// that the build context can be extracted into the workspace
+func waitForContextContainer(clientset kubernetes.Interface, namespace, id string) (*v1.Pod, error) {
+	timeout := time.Now().Add(2 * time.Minute)
+
+	for time.Now().Before(timeout) {
+		pod, err := GetPod(clientset, namespace, id)
+
+		if err != nil && err != ErrBuildNotFound {
+			return nil, err
+		}
+
+		if pod != nil {
+			for _, status := range pod.Status.InitContainerStatuses {
+				if status.Name != contextContainerName {
+					continue
+				}
+
+				if status.State.Running != nil {
+					return pod, nil
+				} else if status.State.Terminated != nil {
+					return nil, fmt.Errorf("build %s is no longer waiting for a build context", id)
+				}
+			}
+		}
+
+		time.Sleep(time.Second)
+	}
+
+	return nil, fmt.Errorf("timed out waiting for build %s to start", id)
+}
@@ -0,0 +1,20 @@
+package kubernetes
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// IsDeploymentRolledOut returns true if every replica of a deployment has been updated to the
+// latest revision of the deployment and is available
+func IsDeploymentRolledOut(depl *appsv1.Deployment) bool {
+	replicas := int32(1)
+
+	if depl.Spec.Replicas != nil {
+		replicas = *depl.Spec.Replicas
+	}
+
+	return depl.Status.ObservedGeneration >= depl.Generation &&
+		depl.Status.UpdatedReplicas == replicas &&
+		depl.Status.AvailableReplicas == replicas &&
+		depl.Status.Replicas == replicas
+}
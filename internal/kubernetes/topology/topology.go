@@ -0,0 +1,729 @@
+package topology
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/helm/grapher"
+	"helm.sh/helm/v3/pkg/release"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// GetReleaseTopology merges the object graph parsed from a release manifest with the live
+// objects in the cluster: replicasets and pods created by the release controllers, services,
+// ingresses, HPAs and PVCs, and the env group configmaps and secrets read by each workload.
+func GetReleaseTopology(clientset kubernetes.Interface, helmRelease *release.Release) (*types.ReleaseTopology, error) {
+	b := &builder{
+		clientset: clientset,
+		namespace: helmRelease.Namespace,
+		nodes:     make(map[string]*types.TopologyNode),
+		edgeSet:   make(map[string]bool),
+	}
+
+	yamlArr := grapher.ImportMultiDocYAML([]byte(helmRelease.Manifest))
+	objects := grapher.ParseObjs(yamlArr, helmRelease.Namespace)
+
+	parsed := grapher.ParsedObjs{
+		Objects: objects,
+	}
+
+	parsed.GetSpecRel()
+
+	idMap := make(map[int]string)
+
+	for _, obj := range parsed.Objects {
+		idMap[obj.ID] = b.addNode(obj.Kind, obj.Name, obj.Namespace, true).ID
+	}
+
+	for _, obj := range parsed.Objects {
+		for _, rel := range obj.Relations.SpecRels {
+			source, sourceExists := idMap[rel.Source]
+			target, targetExists := idMap[rel.Target]
+
+			if sourceExists && targetExists {
+				b.addEdge(source, target, types.TopologyEdgeSpec)
+			}
+		}
+	}
+
+	// controllers are resolved first so that services can be matched against live pods
+	for _, obj := range parsed.Objects {
+		var err error
+
+		switch obj.Kind {
+		case "Deployment":
+			err = b.resolveDeployment(obj)
+		case "StatefulSet":
+			err = b.resolveStatefulSet(obj)
+		case "DaemonSet":
+			err = b.resolveDaemonSet(obj)
+		case "Job":
+			err = b.resolveJob(obj)
+		case "CronJob":
+			err = b.resolveCronJob(obj)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, obj := range parsed.Objects {
+		var err error
+
+		switch obj.Kind {
+		case "Service":
+			err = b.resolveService(obj)
+		case "Ingress":
+			err = b.resolveIngress(obj)
+		case "HorizontalPodAutoscaler":
+			err = b.resolveHPA(obj)
+		case "PersistentVolumeClaim":
+			err = b.resolvePVC(obj.Name)
+		case "ConfigMap":
+			err = b.resolveConfigMap(obj.Name)
+		case "Secret":
+			err = b.resolveSecret(obj.Name)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return b.result(), nil
+}
+
+type builder struct {
+	clientset kubernetes.Interface
+	namespace string
+
+	nodes     map[string]*types.TopologyNode
+	nodeOrder []string
+	edges     []*types.TopologyEdge
+	edgeSet   map[string]bool
+
+	// live pods discovered while resolving controllers
+	pods []v1.Pod
+}
+
+func nodeID(kind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
+
+func (b *builder) addNode(kind, name, namespace string, inChart bool) *types.TopologyNode {
+	id := nodeID(kind, namespace, name)
+
+	if node, exists := b.nodes[id]; exists {
+		node.InChart = node.InChart || inChart
+		return node
+	}
+
+	node := &types.TopologyNode{
+		ID:        id,
+		Kind:      kind,
+		Name:      name,
+		Namespace: namespace,
+		Status:    types.TopologyNodeStatusUnknown,
+		InChart:   inChart,
+	}
+
+	b.nodes[id] = node
+	b.nodeOrder = append(b.nodeOrder, id)
+
+	return node
+}
+
+func (b *builder) addEdge(source, target string, edgeType types.TopologyEdgeType) {
+	if source == target {
+		return
+	}
+
+	key := fmt.Sprintf("%s|%s|%s", source, target, edgeType)
+
+	if b.edgeSet[key] {
+		return
+	}
+
+	b.edgeSet[key] = true
+
+	b.edges = append(b.edges, &types.TopologyEdge{
+		Source: source,
+		Target: target,
+		Type:   edgeType,
+	})
+}
+
+// addTargetNode returns the node an object of the release refers to. Objects which are not
+// part of the release are added as nodes outside the chart, and are marked missing if they do
+// not exist in the cluster.
+func (b *builder) addTargetNode(kind, name, namespace string) (*types.TopologyNode, error) {
+	if node, exists := b.nodes[nodeID(kind, namespace, name)]; exists {
+		return node, nil
+	}
+
+	node := b.addNode(kind, name, namespace, false)
+
+	var err error
+
+	switch kind {
+	case "Service":
+		_, err = b.clientset.CoreV1().Services(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	case "Deployment":
+		_, err = b.clientset.AppsV1().Deployments(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	case "StatefulSet":
+		_, err = b.clientset.AppsV1().StatefulSets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	case "ReplicaSet":
+		_, err = b.clientset.AppsV1().ReplicaSets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	default:
+		return node, nil
+	}
+
+	if err != nil {
+		return node, markMissing(node, err)
+	}
+
+	setStatus(node, types.TopologyNodeStatusUnknown, "object is not part of the release")
+
+	return node, nil
+}
+
+func (b *builder) result() *types.ReleaseTopology {
+	res := &types.ReleaseTopology{
+		Nodes: make([]*types.TopologyNode, 0),
+		Edges: b.edges,
+	}
+
+	if res.Edges == nil {
+		res.Edges = make([]*types.TopologyEdge, 0)
+	}
+
+	for _, id := range b.nodeOrder {
+		res.Nodes = append(res.Nodes, b.nodes[id])
+	}
+
+	return res
+}
+
+func setStatus(node *types.TopologyNode, status types.TopologyNodeStatus, message string) {
+	node.Status = status
+	node.Message = message
+}
+
+// markMissing sets the node status to missing if the error is a not found error, and returns
+// the error otherwise
+func markMissing(node *types.TopologyNode, err error) error {
+	if errors.IsNotFound(err) {
+		setStatus(node, types.TopologyNodeStatusMissing, "object not found in cluster")
+		return nil
+	}
+
+	return err
+}
+
+func (b *builder) resolveDeployment(obj grapher.Object) error {
+	node := b.addNode(obj.Kind, obj.Name, obj.Namespace, true)
+
+	depl, err := b.clientset.AppsV1().Deployments(obj.Namespace).Get(context.TODO(), obj.Name, metav1.GetOptions{})
+
+	if err != nil {
+		return markMissing(node, err)
+	}
+
+	desired := int32(1)
+
+	if depl.Spec.Replicas != nil {
+		desired = *depl.Spec.Replicas
+	}
+
+	node.Status, node.Message = replicaStatus(desired, depl.Status.UpdatedReplicas, depl.Status.AvailableReplicas)
+
+	rsList, err := b.clientset.AppsV1().ReplicaSets(obj.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(depl.Spec.Selector),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range rsList.Items {
+		if !isOwnedBy(rs.OwnerReferences, depl.UID) {
+			continue
+		}
+
+		// skip replicasets from older revisions that have been scaled down
+		if rs.Spec.Replicas != nil && *rs.Spec.Replicas == 0 && rs.Status.Replicas == 0 {
+			continue
+		}
+
+		rsNode := b.addNode("ReplicaSet", rs.Name, rs.Namespace, false)
+
+		rsDesired := int32(1)
+
+		if rs.Spec.Replicas != nil {
+			rsDesired = *rs.Spec.Replicas
+		}
+
+		rsNode.Status, rsNode.Message = replicaStatus(rsDesired, rs.Status.Replicas, rs.Status.ReadyReplicas)
+
+		b.addEdge(node.ID, rsNode.ID, types.TopologyEdgeControl)
+
+		if err := b.addOwnedPods(rsNode.ID, rs.Namespace, rs.Spec.Selector, rs.UID); err != nil {
+			return err
+		}
+	}
+
+	return b.addPodSpecRefs(node.ID, depl.Spec.Template.Spec)
+}
+
+func (b *builder) resolveStatefulSet(obj grapher.Object) error {
+	node := b.addNode(obj.Kind, obj.Name, obj.Namespace, true)
+
+	ss, err := b.clientset.AppsV1().StatefulSets(obj.Namespace).Get(context.TODO(), obj.Name, metav1.GetOptions{})
+
+	if err != nil {
+		return markMissing(node, err)
+	}
+
+	desired := int32(1)
+
+	if ss.Spec.Replicas != nil {
+		desired = *ss.Spec.Replicas
+	}
+
+	node.Status, node.Message = replicaStatus(desired, ss.Status.UpdatedReplicas, ss.Status.ReadyReplicas)
+
+	if err := b.addOwnedPods(node.ID, ss.Namespace, ss.Spec.Selector, ss.UID); err != nil {
+		return err
+	}
+
+	for _, vct := range ss.Spec.VolumeClaimTemplates {
+		for i := int32(0); i < desired; i++ {
+			pvcName := fmt.Sprintf("%s-%s-%d", vct.Name, ss.Name, i)
+
+			if err := b.resolvePVC(pvcName); err != nil {
+				return err
+			}
+
+			b.addEdge(node.ID, nodeID("PersistentVolumeClaim", b.namespace, pvcName), types.TopologyEdgeSpec)
+		}
+	}
+
+	return b.addPodSpecRefs(node.ID, ss.Spec.Template.Spec)
+}
+
+func (b *builder) resolveDaemonSet(obj grapher.Object) error {
+	node := b.addNode(obj.Kind, obj.Name, obj.Namespace, true)
+
+	ds, err := b.clientset.AppsV1().DaemonSets(obj.Namespace).Get(context.TODO(), obj.Name, metav1.GetOptions{})
+
+	if err != nil {
+		return markMissing(node, err)
+	}
+
+	node.Status, node.Message = replicaStatus(ds.Status.DesiredNumberScheduled, ds.Status.UpdatedNumberScheduled, ds.Status.NumberAvailable)
+
+	if err := b.addOwnedPods(node.ID, ds.Namespace, ds.Spec.Selector, ds.UID); err != nil {
+		return err
+	}
+
+	return b.addPodSpecRefs(node.ID, ds.Spec.Template.Spec)
+}
+
+func (b *builder) resolveJob(obj grapher.Object) error {
+	node := b.addNode(obj.Kind, obj.Name, obj.Namespace, true)
+
+	job, err := b.clientset.BatchV1().Jobs(obj.Namespace).Get(context.TODO(), obj.Name, metav1.GetOptions{})
+
+	if err != nil {
+		return markMissing(node, err)
+	}
+
+	switch {
+	case job.Status.Failed > 0 && job.Status.Active == 0 && job.Status.Succeeded == 0:
+		setStatus(node, types.TopologyNodeStatusDegraded, fmt.Sprintf("%d failed pod(s)", job.Status.Failed))
+	case job.Status.Active > 0:
+		setStatus(node, types.TopologyNodeStatusProgressing, fmt.Sprintf("%d active pod(s)", job.Status.Active))
+	default:
+		setStatus(node, types.TopologyNodeStatusHealthy, "")
+	}
+
+	if err := b.addOwnedPods(node.ID, job.Namespace, job.Spec.Selector, job.UID); err != nil {
+		return err
+	}
+
+	return b.addPodSpecRefs(node.ID, job.Spec.Template.Spec)
+}
+
+func (b *builder) resolveCronJob(obj grapher.Object) error {
+	node := b.addNode(obj.Kind, obj.Name, obj.Namespace, true)
+
+	cronJob, err := b.clientset.BatchV1beta1().CronJobs(obj.Namespace).Get(context.TODO(), obj.Name, metav1.GetOptions{})
+
+	if err != nil {
+		return markMissing(node, err)
+	}
+
+	if cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend {
+		setStatus(node, types.TopologyNodeStatusHealthy, "suspended")
+	} else {
+		setStatus(node, types.TopologyNodeStatusHealthy, "")
+	}
+
+	jobs, err := b.clientset.BatchV1().Jobs(obj.Namespace).List(context.TODO(), metav1.ListOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs.Items {
+		if !isOwnedBy(job.OwnerReferences, cronJob.UID) {
+			continue
+		}
+
+		if err := b.resolveJob(grapher.Object{
+			Kind:      "Job",
+			Name:      job.Name,
+			Namespace: job.Namespace,
+		}); err != nil {
+			return err
+		}
+
+		jobID := nodeID("Job", job.Namespace, job.Name)
+		b.nodes[jobID].InChart = false
+		b.addEdge(node.ID, jobID, types.TopologyEdgeControl)
+	}
+
+	return b.addPodSpecRefs(node.ID, cronJob.Spec.JobTemplate.Spec.Template.Spec)
+}
+
+func (b *builder) resolveService(obj grapher.Object) error {
+	node := b.addNode(obj.Kind, obj.Name, obj.Namespace, true)
+
+	svc, err := b.clientset.CoreV1().Services(obj.Namespace).Get(context.TODO(), obj.Name, metav1.GetOptions{})
+
+	if err != nil {
+		return markMissing(node, err)
+	}
+
+	if len(svc.Spec.Selector) == 0 || svc.Spec.Type == v1.ServiceTypeExternalName {
+		setStatus(node, types.TopologyNodeStatusHealthy, "")
+		return nil
+	}
+
+	selector := labels.SelectorFromSet(svc.Spec.Selector)
+	readyPods := 0
+
+	for _, pod := range b.pods {
+		if !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+
+		b.addEdge(node.ID, nodeID("Pod", pod.Namespace, pod.Name), types.TopologyEdgeLabel)
+
+		if isPodReady(&pod) {
+			readyPods++
+		}
+	}
+
+	if readyPods == 0 {
+		setStatus(node, types.TopologyNodeStatusDegraded, "no ready pods match the service selector")
+	} else {
+		setStatus(node, types.TopologyNodeStatusHealthy, fmt.Sprintf("%d ready endpoint(s)", readyPods))
+	}
+
+	return nil
+}
+
+func (b *builder) resolveIngress(obj grapher.Object) error {
+	node := b.addNode(obj.Kind, obj.Name, obj.Namespace, true)
+
+	ing, err := b.clientset.NetworkingV1().Ingresses(obj.Namespace).Get(context.TODO(), obj.Name, metav1.GetOptions{})
+
+	if err != nil {
+		return markMissing(node, err)
+	}
+
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+
+		for _, path := range rule.HTTP.Paths {
+			if path.Backend.Service != nil {
+				target, err := b.addTargetNode("Service", path.Backend.Service.Name, ing.Namespace)
+
+				if err != nil {
+					return err
+				}
+
+				b.addEdge(node.ID, target.ID, types.TopologyEdgeSpec)
+			}
+		}
+	}
+
+	if len(ing.Status.LoadBalancer.Ingress) == 0 {
+		setStatus(node, types.TopologyNodeStatusProgressing, "waiting for load balancer address")
+	} else {
+		setStatus(node, types.TopologyNodeStatusHealthy, "")
+	}
+
+	return nil
+}
+
+func (b *builder) resolveHPA(obj grapher.Object) error {
+	node := b.addNode(obj.Kind, obj.Name, obj.Namespace, true)
+
+	hpa, err := b.clientset.AutoscalingV1().HorizontalPodAutoscalers(obj.Namespace).Get(context.TODO(), obj.Name, metav1.GetOptions{})
+
+	if err != nil {
+		return markMissing(node, err)
+	}
+
+	ref := hpa.Spec.ScaleTargetRef
+	target, err := b.addTargetNode(ref.Kind, ref.Name, hpa.Namespace)
+
+	if err != nil {
+		return err
+	}
+
+	b.addEdge(node.ID, target.ID, types.TopologyEdgeSpec)
+
+	if hpa.Status.CurrentReplicas >= hpa.Spec.MaxReplicas {
+		setStatus(node, types.TopologyNodeStatusHealthy, fmt.Sprintf("scaled to max replicas (%d)", hpa.Spec.MaxReplicas))
+	} else {
+		setStatus(node, types.TopologyNodeStatusHealthy, fmt.Sprintf("%d/%d replicas", hpa.Status.CurrentReplicas, hpa.Spec.MaxReplicas))
+	}
+
+	return nil
+}
+
+func (b *builder) resolvePVC(name string) error {
+	node := b.addNode("PersistentVolumeClaim", name, b.namespace, false)
+
+	pvc, err := b.clientset.CoreV1().PersistentVolumeClaims(b.namespace).Get(context.TODO(), name, metav1.GetOptions{})
+
+	if err != nil {
+		return markMissing(node, err)
+	}
+
+	switch pvc.Status.Phase {
+	case v1.ClaimBound:
+		setStatus(node, types.TopologyNodeStatusHealthy, "")
+	case v1.ClaimPending:
+		setStatus(node, types.TopologyNodeStatusProgressing, "claim is pending")
+	default:
+		setStatus(node, types.TopologyNodeStatusDegraded, fmt.Sprintf("claim is %s", pvc.Status.Phase))
+	}
+
+	return nil
+}
+
+func (b *builder) resolveConfigMap(name string) error {
+	node := b.addNode("ConfigMap", name, b.namespace, false)
+
+	cm, err := b.clientset.CoreV1().ConfigMaps(b.namespace).Get(context.TODO(), name, metav1.GetOptions{})
+
+	if err != nil {
+		return markMissing(node, err)
+	}
+
+	node.EnvGroup = cm.Labels["envgroup"]
+	setStatus(node, types.TopologyNodeStatusHealthy, "")
+
+	return nil
+}
+
+func (b *builder) resolveSecret(name string) error {
+	node := b.addNode("Secret", name, b.namespace, false)
+
+	secret, err := b.clientset.CoreV1().Secrets(b.namespace).Get(context.TODO(), name, metav1.GetOptions{})
+
+	if err != nil {
+		return markMissing(node, err)
+	}
+
+	node.EnvGroup = secret.Labels["envgroup"]
+	setStatus(node, types.TopologyNodeStatusHealthy, "")
+
+	return nil
+}
+
+// addOwnedPods adds the live pods matching the selector and owned by the given controller
+func (b *builder) addOwnedPods(ownerID, namespace string, selector *metav1.LabelSelector, ownerUID k8stypes.UID) error {
+	if selector == nil {
+		return nil
+	}
+
+	podList, err := b.clientset.CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(selector),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, pod := range podList.Items {
+		if !isOwnedBy(pod.OwnerReferences, ownerUID) {
+			continue
+		}
+
+		podNode := b.addNode("Pod", pod.Name, pod.Namespace, false)
+		podNode.Status, podNode.Message = podStatus(&pod)
+
+		b.addEdge(ownerID, podNode.ID, types.TopologyEdgeControl)
+		b.pods = append(b.pods, pod)
+	}
+
+	return nil
+}
+
+// addPodSpecRefs adds edges from a workload to the configmaps, secrets and PVCs referenced by
+// its pod template. Configmaps and secrets which belong to an env group are marked as such.
+func (b *builder) addPodSpecRefs(ownerID string, spec v1.PodSpec) error {
+	configMaps := make(map[string]bool)
+	secrets := make(map[string]bool)
+
+	containers := append([]v1.Container{}, spec.InitContainers...)
+	containers = append(containers, spec.Containers...)
+
+	for _, container := range containers {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				configMaps[envFrom.ConfigMapRef.Name] = true
+			}
+
+			if envFrom.SecretRef != nil {
+				secrets[envFrom.SecretRef.Name] = true
+			}
+		}
+
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+
+			if env.ValueFrom.ConfigMapKeyRef != nil {
+				configMaps[env.ValueFrom.ConfigMapKeyRef.Name] = true
+			}
+
+			if env.ValueFrom.SecretKeyRef != nil {
+				secrets[env.ValueFrom.SecretKeyRef.Name] = true
+			}
+		}
+	}
+
+	for _, volume := range spec.Volumes {
+		if volume.ConfigMap != nil {
+			configMaps[volume.ConfigMap.Name] = true
+		}
+
+		if volume.Secret != nil {
+			secrets[volume.Secret.SecretName] = true
+		}
+
+		if volume.PersistentVolumeClaim != nil {
+			if err := b.resolvePVC(volume.PersistentVolumeClaim.ClaimName); err != nil {
+				return err
+			}
+
+			b.addEdge(ownerID, nodeID("PersistentVolumeClaim", b.namespace, volume.PersistentVolumeClaim.ClaimName), types.TopologyEdgeSpec)
+		}
+	}
+
+	for name := range configMaps {
+		if err := b.resolveConfigMap(name); err != nil {
+			return err
+		}
+
+		b.addRefEdge(ownerID, nodeID("ConfigMap", b.namespace, name))
+	}
+
+	for name := range secrets {
+		if err := b.resolveSecret(name); err != nil {
+			return err
+		}
+
+		b.addRefEdge(ownerID, nodeID("Secret", b.namespace, name))
+	}
+
+	return nil
+}
+
+func (b *builder) addRefEdge(source, target string) {
+	if b.nodes[target].EnvGroup != "" {
+		b.addEdge(source, target, types.TopologyEdgeEnvGroup)
+	} else {
+		b.addEdge(source, target, types.TopologyEdgeSpec)
+	}
+}
+
+func isOwnedBy(refs []metav1.OwnerReference, uid k8stypes.UID) bool {
+	for _, ref := range refs {
+		if ref.UID == uid {
+			return true
+		}
+	}
+
+	return false
+}
+
+func replicaStatus(desired, updated, available int32) (types.TopologyNodeStatus, string) {
+	message := fmt.Sprintf("%d/%d available", available, desired)
+
+	switch {
+	case desired == 0:
+		return types.TopologyNodeStatusHealthy, "scaled to zero"
+	case available == 0:
+		return types.TopologyNodeStatusDegraded, message
+	case updated < desired || available < desired:
+		return types.TopologyNodeStatusProgressing, message
+	default:
+		return types.TopologyNodeStatusHealthy, message
+	}
+}
+
+func podStatus(pod *v1.Pod) (types.TopologyNodeStatus, string) {
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.State.Waiting != nil && cs.State.Waiting.Reason != "" && cs.State.Waiting.Reason != "ContainerCreating" {
+			return types.TopologyNodeStatusDegraded, cs.State.Waiting.Reason
+		}
+
+		if cs.State.Terminated != nil && cs.State.Terminated.ExitCode != 0 {
+			return types.TopologyNodeStatusDegraded, cs.State.Terminated.Reason
+		}
+	}
+
+	switch pod.Status.Phase {
+	case v1.PodSucceeded:
+		return types.TopologyNodeStatusHealthy, "completed"
+	case v1.PodFailed:
+		return types.TopologyNodeStatusDegraded, pod.Status.Reason
+	case v1.PodPending:
+		return types.TopologyNodeStatusProgressing, "pending"
+	case v1.PodRunning:
+		if isPodReady(pod) {
+			return types.TopologyNodeStatusHealthy, ""
+		}
+
+		return types.TopologyNodeStatusProgressing, "not ready"
+	}
+
+	return types.TopologyNodeStatusUnknown, ""
+}
+
+func isPodReady(pod *v1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == v1.PodReady {
+			return cond.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,226 @@
+package topology_test
+
+import (
+	"testing"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/topology"
+	"helm.sh/helm/v3/pkg/release"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const manifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+      - name: web
+        image: nginx
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  selector:
+    app: web
+`
+
+func TestGetReleaseTopology(t *testing.T) {
+	replicas := int32(1)
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+
+	clientset := fake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "depl-uid"},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+				Selector: selector,
+				Template: v1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{{
+							Name: "web",
+							EnvFrom: []v1.EnvFromSource{{
+								ConfigMapRef: &v1.ConfigMapEnvSource{
+									LocalObjectReference: v1.LocalObjectReference{Name: "shared.v2"},
+								},
+							}},
+						}},
+					},
+				},
+			},
+			Status: appsv1.DeploymentStatus{UpdatedReplicas: 1, AvailableReplicas: 1},
+		},
+		&appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "web-abc",
+				Namespace:       "default",
+				UID:             "rs-uid",
+				Labels:          map[string]string{"app": "web"},
+				OwnerReferences: []metav1.OwnerReference{{UID: "depl-uid"}},
+			},
+			Spec:   appsv1.ReplicaSetSpec{Replicas: &replicas, Selector: selector},
+			Status: appsv1.ReplicaSetStatus{Replicas: 1, ReadyReplicas: 1},
+		},
+		&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "web-abc-xyz",
+				Namespace:       "default",
+				Labels:          map[string]string{"app": "web"},
+				OwnerReferences: []metav1.OwnerReference{{UID: "rs-uid"}},
+			},
+			Status: v1.PodStatus{
+				Phase:      v1.PodRunning,
+				Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}},
+			},
+		},
+		&v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       v1.ServiceSpec{Selector: map[string]string{"app": "web"}},
+		},
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "shared.v2",
+				Namespace: "default",
+				Labels:    map[string]string{"envgroup": "shared", "version": "2"},
+			},
+		},
+	)
+
+	res, err := topology.GetReleaseTopology(clientset, &release.Release{
+		Name:      "web",
+		Namespace: "default",
+		Manifest:  manifest,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	nodes := make(map[string]*types.TopologyNode)
+
+	for _, node := range res.Nodes {
+		nodes[node.ID] = node
+	}
+
+	expStatuses := map[string]types.TopologyNodeStatus{
+		"Deployment/default/web":      types.TopologyNodeStatusHealthy,
+		"ReplicaSet/default/web-abc":  types.TopologyNodeStatusHealthy,
+		"Pod/default/web-abc-xyz":     types.TopologyNodeStatusHealthy,
+		"Service/default/web":         types.TopologyNodeStatusHealthy,
+		"ConfigMap/default/shared.v2": types.TopologyNodeStatusHealthy,
+	}
+
+	for id, expStatus := range expStatuses {
+		node, exists := nodes[id]
+
+		if !exists {
+			t.Fatalf("expected node %s to exist", id)
+		}
+
+		if node.Status != expStatus {
+			t.Errorf("node %s: expected status %s, got %s", id, expStatus, node.Status)
+		}
+	}
+
+	if nodes["ConfigMap/default/shared.v2"].EnvGroup != "shared" {
+		t.Errorf("expected configmap to be linked to env group shared")
+	}
+
+	expEdges := []types.TopologyEdge{
+		{Source: "Deployment/default/web", Target: "ReplicaSet/default/web-abc", Type: types.TopologyEdgeControl},
+		{Source: "ReplicaSet/default/web-abc", Target: "Pod/default/web-abc-xyz", Type: types.TopologyEdgeControl},
+		{Source: "Service/default/web", Target: "Pod/default/web-abc-xyz", Type: types.TopologyEdgeLabel},
+		{Source: "Deployment/default/web", Target: "ConfigMap/default/shared.v2", Type: types.TopologyEdgeEnvGroup},
+	}
+
+	for _, expEdge := range expEdges {
+		found := false
+
+		for _, edge := range res.Edges {
+			if *edge == expEdge {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			t.Errorf("expected edge %s -> %s (%s)", expEdge.Source, expEdge.Target, expEdge.Type)
+		}
+	}
+}
+
+const hpaManifest = `apiVersion: autoscaling/v1
+kind: HorizontalPodAutoscaler
+metadata:
+  name: worker
+spec:
+  maxReplicas: 3
+  scaleTargetRef:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: worker
+`
+
+func TestGetReleaseTopologyMissingTarget(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&autoscalingv1.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "default"},
+			Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+				MaxReplicas: 3,
+				ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "worker",
+				},
+			},
+		},
+	)
+
+	res, err := topology.GetReleaseTopology(clientset, &release.Release{
+		Name:      "worker",
+		Namespace: "default",
+		Manifest:  hpaManifest,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	nodes := make(map[string]*types.TopologyNode)
+
+	for _, node := range res.Nodes {
+		nodes[node.ID] = node
+	}
+
+	target, exists := nodes["Deployment/default/worker"]
+
+	if !exists {
+		t.Fatalf("expected the scale target of the HPA to be added as a node")
+	}
+
+	if target.InChart || target.Status != types.TopologyNodeStatusMissing {
+		t.Errorf("expected scale target to be missing and outside the chart, got %v", target)
+	}
+
+	for _, edge := range res.Edges {
+		if nodes[edge.Source] == nil || nodes[edge.Target] == nil {
+			t.Errorf("edge %s -> %s refers to a node which does not exist", edge.Source, edge.Target)
+		}
+	}
+}
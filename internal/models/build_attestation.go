@@ -0,0 +1,42 @@
+package models
+
+import (
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// BuildAttestation stores the digest of an image built for a release, along with the
+// registry refs of its SBOM, provenance attestation and signature
+type BuildAttestation struct {
+	gorm.Model
+
+	ProjectID uint
+	ClusterID uint
+	Name      string
+	Namespace string
+
+	ImageRepoURI string
+	Tag          string
+	Digest       string
+	GitSHA       string
+
+	SBOMFormat    string
+	SBOMRef       string
+	ProvenanceRef string
+	SignatureRef  string
+}
+
+func (a *BuildAttestation) ToBuildAttestationType() *types.BuildAttestation {
+	return &types.BuildAttestation{
+		ID:            a.ID,
+		ImageRepoURI:  a.ImageRepoURI,
+		Tag:           a.Tag,
+		Digest:        a.Digest,
+		GitSHA:        a.GitSHA,
+		SBOMFormat:    types.SBOMFormat(a.SBOMFormat),
+		SBOMRef:       a.SBOMRef,
+		ProvenanceRef: a.ProvenanceRef,
+		SignatureRef:  a.SignatureRef,
+		CreatedAt:     a.CreatedAt,
+	}
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// ClusterCostConfig contains the node prices used to compute costs for a cluster
+type ClusterCostConfig struct {
+	gorm.Model
+
+	ProjectID uint
+	ClusterID uint
+
+	DefaultHourlyPrice float64
+	NodePrices         []NodeTypePrice
+}
+
+// NodeTypePrice is the hourly price of a single node of a given instance type
+type NodeTypePrice struct {
+	gorm.Model
+
+	ClusterCostConfigID uint
+
+	InstanceType string
+	HourlyPrice  float64
+}
+
+func (conf *ClusterCostConfig) ToClusterCostConfigType() *types.ClusterCostConfig {
+	nodePrices := make([]*types.NodeTypePrice, 0)
+
+	for _, price := range conf.NodePrices {
+		nodePrices = append(nodePrices, &types.NodeTypePrice{
+			InstanceType: price.InstanceType,
+			HourlyPrice:  price.HourlyPrice,
+		})
+	}
+
+	return &types.ClusterCostConfig{
+		DefaultHourlyPrice: conf.DefaultHourlyPrice,
+		NodePrices:         nodePrices,
+	}
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// CustomMetric is a saved PromQL query template for the pods of a release
+type CustomMetric struct {
+	gorm.Model
+
+	ProjectID   uint
+	ClusterID   uint
+	ReleaseName string
+	Namespace   string
+
+	Name  string
+	Query string
+	Unit  string
+}
+
+func (m *CustomMetric) ToCustomMetricType() *types.CustomMetric {
+	return &types.CustomMetric{
+		ID:        m.ID,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+		Name:      m.Name,
+		Query:     m.Query,
+		Unit:      m.Unit,
+	}
+}
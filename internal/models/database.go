@@ -15,21 +15,42 @@ type Database struct {
 
 	InfraID uint `json:"infra_id"`
 
+	// Kind is the kind of infra which provisioned the database. Databases which were created
+	// before other kinds were supported have an empty kind, and are RDS instances.
+	Kind types.InfraKind `json:"kind"`
+
 	InstanceID       string `json:"rds_instance_id"`
 	InstanceEndpoint string `json:"rds_connection_endpoint"`
 	InstanceName     string `json:"rds_instance_name"`
 	Status           string
+
+	// EnvGroupName and EnvGroupNamespace identify the env group which contains the connection
+	// info for the database
+	EnvGroupName      string
+	EnvGroupNamespace string
+}
+
+// GetKind returns the infra kind of the database
+func (d *Database) GetKind() types.InfraKind {
+	if d.Kind == "" {
+		return types.InfraRDS
+	}
+
+	return d.Kind
 }
 
 func (d *Database) ToDatabaseType() *types.Database {
 	return &types.Database{
-		ID:               d.ID,
-		ProjectID:        d.ProjectID,
-		ClusterID:        d.ClusterID,
-		InfraID:          d.InfraID,
-		InstanceID:       d.InstanceID,
-		InstanceEndpoint: d.InstanceEndpoint,
-		InstanceName:     d.InstanceName,
-		Status:           d.Status,
+		ID:                d.ID,
+		ProjectID:         d.ProjectID,
+		ClusterID:         d.ClusterID,
+		InfraID:           d.InfraID,
+		Kind:              d.GetKind(),
+		InstanceID:        d.InstanceID,
+		InstanceEndpoint:  d.InstanceEndpoint,
+		InstanceName:      d.InstanceName,
+		Status:            d.Status,
+		EnvGroupName:      d.EnvGroupName,
+		EnvGroupNamespace: d.EnvGroupNamespace,
 	}
 }
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// DatabaseSnapshot is a snapshot of a database, which is created and restored by the
+// provisioner
+type DatabaseSnapshot struct {
+	gorm.Model
+
+	ProjectID  uint `json:"project_id"`
+	DatabaseID uint `json:"database_id"`
+	InfraID    uint `json:"infra_id"`
+
+	Name   string
+	Status types.DatabaseSnapshotStatus
+
+	// OperationUID is the uid of the infra operation which created the snapshot
+	OperationUID string
+}
+
+func (s *DatabaseSnapshot) ToDatabaseSnapshotType() *types.DatabaseSnapshot {
+	return &types.DatabaseSnapshot{
+		ID:          s.ID,
+		CreatedAt:   s.CreatedAt,
+		DatabaseID:  s.DatabaseID,
+		Name:        s.Name,
+		Status:      s.Status,
+		OperationID: s.OperationUID,
+	}
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// DriftDetectionConfig configures a scheduled drift check for a release
+type DriftDetectionConfig struct {
+	gorm.Model
+
+	ProjectID uint
+	ClusterID uint
+	Name      string
+	Namespace string
+
+	Enabled         bool
+	IntervalMinutes uint
+
+	LastCheckedAt     *time.Time
+	LastDriftDetected bool
+
+	// LastDriftDigest is a hash of the last detected drift, used to avoid sending the same
+	// notification on every check
+	LastDriftDigest string
+}
+
+func (conf *DriftDetectionConfig) ToDriftDetectionConfigType() *types.DriftDetectionConfig {
+	return &types.DriftDetectionConfig{
+		Enabled:           conf.Enabled,
+		IntervalMinutes:   conf.IntervalMinutes,
+		LastCheckedAt:     conf.LastCheckedAt,
+		LastDriftDetected: conf.LastDriftDetected,
+	}
+}
+
+// IsDue returns true if the drift check should be run at the given time
+func (conf *DriftDetectionConfig) IsDue(now time.Time) bool {
+	if !conf.Enabled {
+		return false
+	}
+
+	if conf.LastCheckedAt == nil {
+		return true
+	}
+
+	return !conf.LastCheckedAt.Add(time.Duration(conf.IntervalMinutes) * time.Minute).After(now)
+}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"encoding/json"
+
 	"github.com/porter-dev/porter/api/types"
 	"gorm.io/gorm"
 )
@@ -16,6 +18,10 @@ type Environment struct {
 	GitRepoOwner      string
 	GitRepoName       string
 
+	// GitlabIntegrationID is set for environments of GitLab repositories, in which case
+	// GitRepoOwner is the full path of the project's group
+	GitlabIntegrationID uint
+
 	Name string
 	Mode string
 
@@ -33,6 +39,8 @@ func (e *Environment) ToEnvironmentType() *types.Environment {
 		GitRepoOwner:      e.GitRepoOwner,
 		GitRepoName:       e.GitRepoName,
 
+		GitlabIntegrationID: e.GitlabIntegrationID,
+
 		Name: e.Name,
 		Mode: e.Mode,
 	}
@@ -53,6 +61,27 @@ type Deployment struct {
 	CommitSHA      string
 	PRBranchFrom   string
 	PRBranchInto   string
+
+	// Services is the JSON-encoded status of each application and job in the deployment
+	Services []byte
+}
+
+// SetServices stores the status of each application and job in the deployment
+func (d *Deployment) SetServices(services []*types.DeploymentService) error {
+	if services == nil {
+		d.Services = nil
+		return nil
+	}
+
+	servicesBytes, err := json.Marshal(services)
+
+	if err != nil {
+		return err
+	}
+
+	d.Services = servicesBytes
+
+	return nil
 }
 
 func (d *Deployment) ToDeploymentType() *types.Deployment {
@@ -77,5 +106,20 @@ func (d *Deployment) ToDeploymentType() *types.Deployment {
 		Subdomain:      d.Subdomain,
 		PullRequestID:  d.PullRequestID,
 		GitHubMetadata: ghMetadata,
+		Services:       d.getServices(),
+	}
+}
+
+func (d *Deployment) getServices() []*types.DeploymentService {
+	if len(d.Services) == 0 {
+		return nil
 	}
+
+	services := make([]*types.DeploymentService, 0)
+
+	if err := json.Unmarshal(d.Services, &services); err != nil {
+		return nil
+	}
+
+	return services
 }
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"strings"
+
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// ImagePolicy restricts the images which can be deployed to the clusters of a project
+type ImagePolicy struct {
+	gorm.Model
+
+	ProjectID uint
+
+	// ClusterID is 0 if the policy applies to every cluster in the project
+	ClusterID uint
+
+	RequireConnectedRegistry bool
+	DisallowMutableTags      bool
+
+	// MutableTags is a comma-separated list of tags
+	MutableTags string
+
+	RequireSignature bool
+	PublicKey        string
+}
+
+// SetPolicy sets the policy fields from the policy type
+func (p *ImagePolicy) SetPolicy(policy *types.ImagePolicy) {
+	p.RequireConnectedRegistry = policy.RequireConnectedRegistry
+	p.DisallowMutableTags = policy.DisallowMutableTags
+	p.MutableTags = strings.Join(policy.MutableTags, ",")
+	p.RequireSignature = policy.RequireSignature
+	p.PublicKey = policy.PublicKey
+}
+
+func (p *ImagePolicy) ToImagePolicyType() *types.ImagePolicy {
+	res := &types.ImagePolicy{
+		RequireConnectedRegistry: p.RequireConnectedRegistry,
+		DisallowMutableTags:      p.DisallowMutableTags,
+		RequireSignature:         p.RequireSignature,
+		PublicKey:                p.PublicKey,
+	}
+
+	if p.MutableTags != "" {
+		res.MutableTags = strings.Split(p.MutableTags, ",")
+	}
+
+	return res
+}
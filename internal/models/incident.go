@@ -0,0 +1,74 @@
+package models
+
+import (
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// Incident is the Porter-side record of an incident reported by the porter-agent, which
+// tracks the status, assignee and timeline of the incident
+type Incident struct {
+	gorm.Model
+
+	ProjectID uint
+	ClusterID uint
+
+	// AgentIncidentID is the ID of the incident in the porter-agent
+	AgentIncidentID string
+
+	ReleaseName string
+	Namespace   string
+	Status      types.IncidentStatus
+	Summary     string
+
+	AssigneeID    uint
+	AssigneeEmail string
+
+	StartedAt      time.Time
+	AcknowledgedAt *time.Time
+	ResolvedAt     *time.Time
+
+	Notes []IncidentNote
+}
+
+// IncidentNote is an entry in the timeline of an incident
+type IncidentNote struct {
+	gorm.Model
+
+	IncidentID uint
+
+	Kind types.IncidentNoteKind
+	Text string
+
+	UserID    uint
+	UserEmail string
+}
+
+func (i *Incident) ToIncidentRecordType() *types.IncidentRecord {
+	return &types.IncidentRecord{
+		ID:              i.ID,
+		AgentIncidentID: i.AgentIncidentID,
+		ReleaseName:     i.ReleaseName,
+		Namespace:       i.Namespace,
+		Status:          i.Status,
+		Summary:         i.Summary,
+		AssigneeID:      i.AssigneeID,
+		AssigneeEmail:   i.AssigneeEmail,
+		StartedAt:       i.StartedAt,
+		AcknowledgedAt:  i.AcknowledgedAt,
+		ResolvedAt:      i.ResolvedAt,
+	}
+}
+
+func (n *IncidentNote) ToIncidentNoteType() *types.IncidentNote {
+	return &types.IncidentNote{
+		ID:        n.ID,
+		CreatedAt: n.CreatedAt,
+		Kind:      n.Kind,
+		Text:      n.Text,
+		UserID:    n.UserID,
+		UserEmail: n.UserEmail,
+	}
+}
@@ -0,0 +1,51 @@
+package integrations
+
+import (
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// GitlabIntegration is a connection to a GitLab instance, authenticated either through a
+// GitLab OAuth application or a personal access token
+type GitlabIntegration struct {
+	gorm.Model
+
+	// The tokens used to authenticate. For personal access tokens, the token is stored
+	// as the access token and never expires.
+	SharedOAuthModel
+
+	// The project that this integration belongs to
+	ProjectID uint `json:"project_id"`
+
+	// The id of the user that linked this integration
+	UserID uint `json:"user_id"`
+
+	// The URL of the GitLab instance, such as https://gitlab.com
+	InstanceURL string `json:"instance_url"`
+
+	AuthMechanism types.GitlabAuthMechanism `json:"auth_mechanism"`
+
+	// The GitLab username that the integration is authenticated as
+	Username string `json:"username"`
+
+	// ------------------------------------------------------------------
+	// All fields below encrypted before storage.
+	// ------------------------------------------------------------------
+
+	// The secret of the GitLab OAuth application. The application client ID is stored
+	// in the shared ClientID field.
+	AppClientSecret []byte `json:"app_client_secret"`
+}
+
+// ToGitlabIntegrationType generates an external GitlabIntegration to be shared over REST
+func (g *GitlabIntegration) ToGitlabIntegrationType() *types.GitlabIntegration {
+	return &types.GitlabIntegration{
+		CreatedAt:     g.CreatedAt,
+		ID:            g.ID,
+		ProjectID:     g.ProjectID,
+		UserID:        g.UserID,
+		InstanceURL:   g.InstanceURL,
+		AuthMechanism: g.AuthMechanism,
+		Username:      g.Username,
+	}
+}
@@ -19,6 +19,9 @@ type Invite struct {
 	// Kind is the role kind that this refers to
 	Kind string
 
+	// TeamID is the team the user is added to when the invite is accepted
+	TeamID uint
+
 	ProjectID uint
 	UserID    uint
 }
@@ -32,6 +35,7 @@ func (i *Invite) ToInviteType() *types.Invite {
 		Expired:  i.IsExpired(),
 		Accepted: i.IsAccepted(),
 		Kind:     i.Kind,
+		TeamID:   i.TeamID,
 	}
 }
 
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// MetricAlertRule is an alert on a metric of a release, which is evaluated by the scheduler
+type MetricAlertRule struct {
+	gorm.Model
+
+	ProjectID   uint
+	ClusterID   uint
+	ReleaseName string
+	Namespace   string
+
+	Name             string
+	Metric           types.MetricAlertMetric
+	CustomMetricName string
+	Comparator       types.MetricAlertComparator
+	Threshold        float64
+	ForMinutes       uint
+	Enabled          bool
+
+	State           types.MetricAlertState
+	LastValue       *float64
+	LastEvaluatedAt *time.Time
+
+	// PendingSince is when the threshold was first crossed, and FiringSince is when the alert
+	// started firing
+	PendingSince *time.Time
+	FiringSince  *time.Time
+
+	// LastNotifiedState is the state of the alert when a notification was last sent, so that
+	// notifications are only sent once per firing and once per resolution
+	LastNotifiedState types.MetricAlertState
+}
+
+func (r *MetricAlertRule) ToMetricAlertRuleType() *types.MetricAlertRule {
+	return &types.MetricAlertRule{
+		ID:               r.ID,
+		Name:             r.Name,
+		Metric:           r.Metric,
+		CustomMetricName: r.CustomMetricName,
+		Comparator:       r.Comparator,
+		Threshold:        r.Threshold,
+		ForMinutes:       r.ForMinutes,
+		Enabled:          r.Enabled,
+		State:            r.State,
+		LastValue:        r.LastValue,
+		LastEvaluatedAt:  r.LastEvaluatedAt,
+		FiringSince:      r.FiringSince,
+	}
+}
@@ -0,0 +1,87 @@
+package models
+
+import (
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// NamespacePolicy is the resource quota and limit range which Porter maintains for a namespace
+type NamespacePolicy struct {
+	gorm.Model
+
+	ProjectID uint
+	ClusterID uint
+	Namespace string
+
+	QuotaCPU                    string
+	QuotaMemory                 string
+	QuotaPods                   uint
+	QuotaPersistentVolumeClaims uint
+	QuotaStorage                string
+
+	DefaultCPURequest    string
+	DefaultMemoryRequest string
+	DefaultCPULimit      string
+	DefaultMemoryLimit   string
+	MaxCPU               string
+	MaxMemory            string
+}
+
+// SetPolicy sets the quota and limit range fields from the policy type
+func (p *NamespacePolicy) SetPolicy(policy *types.NamespacePolicy) {
+	quota := policy.Quota
+
+	if quota == nil {
+		quota = &types.NamespaceQuota{}
+	}
+
+	p.QuotaCPU = quota.CPU
+	p.QuotaMemory = quota.Memory
+	p.QuotaPods = quota.Pods
+	p.QuotaPersistentVolumeClaims = quota.PersistentVolumeClaims
+	p.QuotaStorage = quota.Storage
+
+	limitRange := policy.LimitRange
+
+	if limitRange == nil {
+		limitRange = &types.NamespaceLimitRange{}
+	}
+
+	p.DefaultCPURequest = limitRange.DefaultCPURequest
+	p.DefaultMemoryRequest = limitRange.DefaultMemoryRequest
+	p.DefaultCPULimit = limitRange.DefaultCPULimit
+	p.DefaultMemoryLimit = limitRange.DefaultMemoryLimit
+	p.MaxCPU = limitRange.MaxCPU
+	p.MaxMemory = limitRange.MaxMemory
+}
+
+func (p *NamespacePolicy) ToNamespacePolicyType() *types.NamespacePolicy {
+	res := &types.NamespacePolicy{}
+
+	quota := types.NamespaceQuota{
+		CPU:                    p.QuotaCPU,
+		Memory:                 p.QuotaMemory,
+		Pods:                   p.QuotaPods,
+		PersistentVolumeClaims: p.QuotaPersistentVolumeClaims,
+		Storage:                p.QuotaStorage,
+	}
+
+	if quota != (types.NamespaceQuota{}) {
+		res.Quota = &quota
+	}
+
+	limitRange := types.NamespaceLimitRange{
+		DefaultCPURequest:    p.DefaultCPURequest,
+		DefaultMemoryRequest: p.DefaultMemoryRequest,
+		DefaultCPULimit:      p.DefaultCPULimit,
+		DefaultMemoryLimit:   p.DefaultMemoryLimit,
+		MaxCPU:               p.MaxCPU,
+		MaxMemory:            p.MaxMemory,
+	}
+
+	if limitRange != (types.NamespaceLimitRange{}) {
+		res.LimitRange = &limitRange
+	}
+
+	return res
+}
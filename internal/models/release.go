@@ -1,6 +1,8 @@
 package models
 
 import (
+	"encoding/json"
+
 	"github.com/porter-dev/porter/api/types"
 	"gorm.io/gorm"
 )
@@ -26,6 +28,66 @@ type Release struct {
 	NotificationConfig uint
 	BuildConfig        uint
 	Tags               []*Tag `json:"tags" gorm:"many2many:release_tags"`
+
+	// Hooks is the JSON-encoded config of the pre-deploy and post-deploy hooks of the release
+	Hooks []byte `json:"hooks"`
+
+	// AutoRollbackEnabled rolls back the release when the rollout of an upgrade fails its
+	// health checks within the window, or when its containers restart more often than the
+	// crash threshold
+	AutoRollbackEnabled        bool `json:"auto_rollback_enabled"`
+	AutoRollbackWindow         uint `json:"auto_rollback_window"`
+	AutoRollbackCrashThreshold uint `json:"auto_rollback_crash_threshold"`
+}
+
+// GetAutoRollbackConfig returns the auto-rollback config of the release
+func (r *Release) GetAutoRollbackConfig() *types.AutoRollbackConfig {
+	return &types.AutoRollbackConfig{
+		Enabled:        r.AutoRollbackEnabled,
+		WindowSeconds:  r.AutoRollbackWindow,
+		CrashThreshold: r.AutoRollbackCrashThreshold,
+	}
+}
+
+// SetAutoRollbackConfig sets the auto-rollback config of the release
+func (r *Release) SetAutoRollbackConfig(conf *types.AutoRollbackConfig) {
+	r.AutoRollbackEnabled = conf.Enabled
+	r.AutoRollbackWindow = conf.WindowSeconds
+	r.AutoRollbackCrashThreshold = conf.CrashThreshold
+}
+
+// SetHooks stores the pre-deploy and post-deploy hooks of the release
+func (r *Release) SetHooks(hooks *types.ReleaseHooks) error {
+	if hooks == nil || (hooks.PreDeploy == nil && hooks.PostDeploy == nil) {
+		r.Hooks = nil
+		return nil
+	}
+
+	hooksBytes, err := json.Marshal(hooks)
+
+	if err != nil {
+		return err
+	}
+
+	r.Hooks = hooksBytes
+
+	return nil
+}
+
+// GetHooks returns the pre-deploy and post-deploy hooks of the release, which is nil if no
+// hooks are set
+func (r *Release) GetHooks() *types.ReleaseHooks {
+	if len(r.Hooks) == 0 {
+		return nil
+	}
+
+	hooks := &types.ReleaseHooks{}
+
+	if err := json.Unmarshal(r.Hooks, hooks); err != nil {
+		return nil
+	}
+
+	return hooks
 }
 
 func (r *Release) ToReleaseType() *types.PorterRelease {
@@ -33,6 +95,11 @@ func (r *Release) ToReleaseType() *types.PorterRelease {
 		ID:           r.ID,
 		WebhookToken: r.WebhookToken,
 		ImageRepoURI: r.ImageRepoURI,
+		DeployHooks:  r.GetHooks(),
+	}
+
+	if r.AutoRollbackEnabled {
+		res.AutoRollback = r.GetAutoRollbackConfig()
 	}
 
 	if r.GitActionConfig != nil {
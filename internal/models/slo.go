@@ -0,0 +1,35 @@
+package models
+
+import (
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// SLO is a service level objective on the requests to the ingresses of a release
+type SLO struct {
+	gorm.Model
+
+	ProjectID   uint
+	ClusterID   uint
+	ReleaseName string
+	Namespace   string
+
+	Name               string
+	Kind               types.SLOKind
+	Objective          float64
+	LatencyThresholdMS uint
+	WindowDays         uint
+	BlockDeploys       bool
+}
+
+func (s *SLO) ToSLOType() *types.SLO {
+	return &types.SLO{
+		ID:                 s.ID,
+		Name:               s.Name,
+		Kind:               s.Kind,
+		Objective:          s.Objective,
+		LatencyThresholdMS: s.LatencyThresholdMS,
+		WindowDays:         s.WindowDays,
+		BlockDeploys:       s.BlockDeploys,
+	}
+}
@@ -0,0 +1,179 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// SSOConfig is the configuration of a single sign-on identity provider of a project. The
+// configuration of the identity provider of the instance is not stored, and has an ID and a
+// project ID of 0.
+type SSOConfig struct {
+	gorm.Model
+
+	ProjectID uint
+
+	Kind types.SSOKind
+
+	Domains []SSODomain
+
+	// DomainsVerified is set once the TXT records of all domains contain the verification
+	// token, and users can only log in with the identity provider once the domains are verified
+	DomainsVerified   bool
+	VerificationToken string
+
+	EnforceSSO      bool
+	DefaultRoleKind types.RoleKind
+
+	GroupsClaim   string
+	GroupMappings []SSOGroupMapping
+
+	OIDCIssuerURL string
+	OIDCClientID  string
+
+	// OIDCAuthURL, OIDCTokenURL and OIDCJWKSURI are read from the discovery document of the
+	// issuer when the config is saved
+	OIDCAuthURL  string
+	OIDCTokenURL string
+	OIDCJWKSURI  string
+
+	SAMLIdPSSOURL      string
+	SAMLIdPEntityID    string
+	SAMLIdPCertificate string
+
+	// ------------------------------------------------------------------
+	// All fields below encrypted before storage.
+	// ------------------------------------------------------------------
+
+	OIDCClientSecret []byte
+}
+
+// SSODomain is an email domain whose users log in with the identity provider of a config
+type SSODomain struct {
+	gorm.Model
+
+	SSOConfigID uint
+	Domain      string `gorm:"index"`
+}
+
+// SSOGroupMapping maps a group of an identity provider to a role kind or to a team
+type SSOGroupMapping struct {
+	gorm.Model
+
+	SSOConfigID uint
+
+	Group    string
+	RoleKind types.RoleKind
+	TeamID   uint
+}
+
+// SSOUsedID is the id of a request or an assertion which a user logged in with. Ids cannot
+// be used again until they expire, so that responses of identity providers cannot be replayed.
+type SSOUsedID struct {
+	ID        string    `gorm:"primaryKey"`
+	ExpiresAt time.Time `gorm:"index"`
+}
+
+// GetDomains returns the domains of the config
+func (c *SSOConfig) GetDomains() []string {
+	res := make([]string, 0)
+
+	for _, domain := range c.Domains {
+		res = append(res, domain.Domain)
+	}
+
+	return res
+}
+
+// HasEmail returns true if the domain of an email is one of the domains of the config
+func (c *SSOConfig) HasEmail(email string) bool {
+	domain := GetEmailDomain(email)
+
+	for _, d := range c.Domains {
+		if domain != "" && d.Domain == domain {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetEmailDomain returns the lowercase domain of an email, or an empty string if the email
+// is invalid
+func GetEmailDomain(email string) string {
+	i := strings.LastIndex(email, "@")
+
+	if i < 0 {
+		return ""
+	}
+
+	return strings.ToLower(email[i+1:])
+}
+
+// GetVerificationRecord returns the value of the TXT record which verifies the domains of
+// the config
+func (c *SSOConfig) GetVerificationRecord() string {
+	return "porter-verification=" + c.VerificationToken
+}
+
+// GetOIDCRedirectURL returns the url the identity provider redirects users to after login
+func (c *SSOConfig) GetOIDCRedirectURL(serverURL string) string {
+	return serverURL + "/api/sso/oidc/callback"
+}
+
+// GetSAMLEntityID returns the entity id of Porter as the service provider of the config,
+// which is the url of the metadata of the service provider
+func (c *SSOConfig) GetSAMLEntityID(serverURL string) string {
+	return fmt.Sprintf("%s/api/sso/saml/%d/metadata", serverURL, c.ID)
+}
+
+// GetSAMLACSURL returns the url the identity provider posts responses to
+func (c *SSOConfig) GetSAMLACSURL(serverURL string) string {
+	return fmt.Sprintf("%s/api/sso/saml/%d/acs", serverURL, c.ID)
+}
+
+// ToSSOConfigType generates an external SSOConfig to be shared over REST
+func (c *SSOConfig) ToSSOConfigType(serverURL string) *types.SSOConfig {
+	mappings := make([]*types.SSOGroupMapping, 0)
+
+	for _, mapping := range c.GroupMappings {
+		mappings = append(mappings, &types.SSOGroupMapping{
+			Group:    mapping.Group,
+			RoleKind: mapping.RoleKind,
+			TeamID:   mapping.TeamID,
+		})
+	}
+
+	res := &types.SSOConfig{
+		ID:                 c.ID,
+		CreatedAt:          c.CreatedAt,
+		ProjectID:          c.ProjectID,
+		Kind:               c.Kind,
+		Domains:            c.GetDomains(),
+		DomainsVerified:    c.DomainsVerified,
+		VerificationRecord: c.GetVerificationRecord(),
+		EnforceSSO:         c.EnforceSSO,
+		DefaultRoleKind:    c.DefaultRoleKind,
+		GroupsClaim:        c.GroupsClaim,
+		GroupMappings:      mappings,
+	}
+
+	switch c.Kind {
+	case types.SSOKindOIDC:
+		res.OIDCIssuerURL = c.OIDCIssuerURL
+		res.OIDCClientID = c.OIDCClientID
+		res.OIDCRedirectURL = c.GetOIDCRedirectURL(serverURL)
+	case types.SSOKindSAML:
+		res.SAMLIdPSSOURL = c.SAMLIdPSSOURL
+		res.SAMLIdPEntityID = c.SAMLIdPEntityID
+		res.SAMLIdPCertificate = c.SAMLIdPCertificate
+		res.SAMLEntityID = c.GetSAMLEntityID(serverURL)
+		res.SAMLACSURL = c.GetSAMLACSURL(serverURL)
+	}
+
+	return res
+}
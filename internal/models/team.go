@@ -0,0 +1,49 @@
+package models
+
+import (
+	"github.com/porter-dev/porter/api/types"
+	"gorm.io/gorm"
+)
+
+// Team is a group of users in a project, whose members inherit the permissions of the
+// role kind of the team
+type Team struct {
+	gorm.Model
+
+	ProjectID uint
+
+	Name string
+	Kind types.RoleKind
+
+	Members []TeamMember
+}
+
+// TeamMember is the membership of a user in a team
+type TeamMember struct {
+	gorm.Model
+
+	TeamID uint
+	UserID uint
+}
+
+// ToTeamType generates an external Team to be shared over REST. The emails of the members
+// are looked up in the map of user ids to emails.
+func (t *Team) ToTeamType(emails map[uint]string) *types.Team {
+	members := make([]*types.TeamMember, 0)
+
+	for _, member := range t.Members {
+		members = append(members, &types.TeamMember{
+			UserID: member.UserID,
+			Email:  emails[member.UserID],
+		})
+	}
+
+	return &types.Team{
+		ID:        t.ID,
+		CreatedAt: t.CreatedAt,
+		ProjectID: t.ProjectID,
+		Name:      t.Name,
+		Kind:      t.Kind,
+		Members:   members,
+	}
+}
@@ -1280,3 +1280,40 @@ func (r *Registry) getACRDockerConfigFile(
 func generateAuthToken(username, password string) string {
 	return base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
 }
+
+// FindRegistryForImage returns the registry that an image repository belongs to, or nil if the
+// image repository does not belong to any of the registries
+func FindRegistryForImage(regs []*models.Registry, imageRepoURI string) *models.Registry {
+	imageRepoURI = strings.TrimPrefix(strings.TrimPrefix(imageRepoURI, "https://"), "http://")
+
+	var res *models.Registry
+	longestMatch := 0
+
+	for _, reg := range regs {
+		regURL := reg.URL
+
+		if !strings.Contains(regURL, "http") {
+			regURL = "https://" + regURL
+		}
+
+		parsedRegURL, err := url.Parse(regURL)
+
+		if err != nil {
+			continue
+		}
+
+		prefix := parsedRegURL.Host
+
+		if parsedRegURL.Path != "" {
+			prefix += "/" + strings.Trim(parsedRegURL.Path, "/")
+		}
+
+		// prefer the most specific registry, in case several registries share a host
+		if (imageRepoURI == prefix || strings.HasPrefix(imageRepoURI, prefix+"/")) && len(prefix) > longestMatch {
+			res = reg
+			longestMatch = len(prefix)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,414 @@
+package releasehooks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/releasesteps"
+	"github.com/porter-dev/porter/internal/repository"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const (
+	// DefaultTimeout is the maximum run time of a hook job which does not set a timeout
+	DefaultTimeout = 10 * time.Minute
+
+	// HookLabel is set on hook jobs and their pods to the type of the hook
+	HookLabel = "porter.run/hook"
+
+	// logTailLines is the number of log lines of a hook job which are recorded in its step
+	logTailLines = 50
+)
+
+// Runner runs the hooks of a release as Kubernetes jobs, and records the status and logs of
+// each hook in the steps of the release
+type Runner struct {
+	repo     repository.Repository
+	k8sAgent *kubernetes.Agent
+	release  *models.Release
+	hooks    *types.ReleaseHooks
+}
+
+// NewRunner returns a Runner for the hooks of a release, or nil if the release has no hooks
+func NewRunner(repo repository.Repository, k8sAgent *kubernetes.Agent, release *models.Release) *Runner {
+	if release == nil {
+		return nil
+	}
+
+	hooks := release.GetHooks()
+
+	if hooks == nil {
+		return nil
+	}
+
+	// the hooks run in the background and record their steps on the release, so the runner
+	// gets its own copy of the release
+	runnerRelease := *release
+
+	return &Runner{
+		repo:     repo,
+		k8sAgent: k8sAgent,
+		release:  &runnerRelease,
+		hooks:    hooks,
+	}
+}
+
+// HasPreDeploy returns true if the release has a pre-deploy hook
+func (r *Runner) HasPreDeploy() bool {
+	return r != nil && r.hooks.PreDeploy != nil
+}
+
+// RecordUpgrade records the result of an upgrade which was run in the background, since the
+// pre-deploy hook can run for longer than an API request
+func (r *Runner) RecordUpgrade(err error) {
+	if r == nil {
+		return
+	}
+
+	if err != nil {
+		r.recordEvent(types.ReleaseStepUpgradeEventID, "Upgrade", types.ReleaseStepUpgradeIndex, types.EventStatusFailed, err.Error())
+		return
+	}
+
+	r.recordEvent(types.ReleaseStepUpgradeEventID, "Upgrade", types.ReleaseStepUpgradeIndex, types.EventStatusSuccess, "")
+}
+
+// PreDeploy runs the pre-deploy hook of the release with the rendered manifest of the new
+// revision, so that the hook uses the new image and env groups. It returns an error if the
+// hook fails, in which case the release must not be upgraded.
+func (r *Runner) PreDeploy(manifest string) error {
+	if r == nil || r.hooks.PreDeploy == nil {
+		return nil
+	}
+
+	return r.run(types.ReleaseHookPreDeploy, r.hooks.PreDeploy, manifest, 280)
+}
+
+// PostDeploy waits for the workloads of the new revision to roll out, and then runs the
+// post-deploy hook of the release
+func (r *Runner) PostDeploy(manifest string) error {
+	if r == nil || r.hooks.PostDeploy == nil {
+		return nil
+	}
+
+	timeout := getTimeout(r.hooks.PostDeploy)
+
+	if err := r.waitForRollout(manifest, timeout); err != nil {
+		r.recordStep(types.ReleaseHookPostDeploy, 400, types.EventStatusFailed, err.Error())
+		return err
+	}
+
+	return r.run(types.ReleaseHookPostDeploy, r.hooks.PostDeploy, manifest, 400)
+}
+
+func (r *Runner) run(hookType types.ReleaseHookType, hook *types.ReleaseHook, manifest string, index int64) error {
+	job, err := GetHookJob(r.release.Name, r.release.Namespace, hookType, hook, manifest)
+
+	if err != nil {
+		r.recordStep(hookType, index, types.EventStatusFailed, err.Error())
+		return err
+	}
+
+	r.recordStep(hookType, index, types.EventStatusInProgress, fmt.Sprintf("Running job %s", job.Name))
+
+	jobs := r.k8sAgent.Clientset.BatchV1().Jobs(job.Namespace)
+
+	job, err = jobs.Create(context.Background(), job, metav1.CreateOptions{})
+
+	if err != nil {
+		err = fmt.Errorf("could not create %s hook job: %w", getHookName(hookType), err)
+		r.recordStep(hookType, index+10, types.EventStatusFailed, err.Error())
+		return err
+	}
+
+	runErr := r.waitForJob(job, getTimeout(hook))
+	logs := r.getJobLogs(job)
+
+	if runErr != nil {
+		// stop the job if it is still running once it has timed out
+		propagation := metav1.DeletePropagationBackground
+
+		jobs.Delete(context.Background(), job.Name, metav1.DeleteOptions{
+			PropagationPolicy: &propagation,
+		})
+
+		runErr = fmt.Errorf("%s hook failed: %w", getHookName(hookType), runErr)
+		r.recordStep(hookType, index+10, types.EventStatusFailed, joinInfo(runErr.Error(), logs))
+
+		return runErr
+	}
+
+	r.recordStep(hookType, index+10, types.EventStatusSuccess, logs)
+
+	return nil
+}
+
+// GetHookJob returns the job which runs a hook, using the pod spec of the first workload in
+// the rendered manifest of the release. Only the main container of the workload is run, and
+// the pods of the job are not selected by the services of the release.
+func GetHookJob(
+	name, namespace string,
+	hookType types.ReleaseHookType,
+	hook *types.ReleaseHook,
+	manifest string,
+) (*batchv1.Job, error) {
+	template, err := getPodTemplate(manifest)
+
+	if err != nil {
+		return nil, err
+	}
+
+	podSpec := template.Spec.DeepCopy()
+
+	if len(podSpec.Containers) == 0 {
+		return nil, fmt.Errorf("release workload does not have any containers")
+	}
+
+	container := podSpec.Containers[0]
+	container.Command = []string{"sh", "-c", hook.Command}
+	container.Args = nil
+	container.Ports = nil
+	container.LivenessProbe = nil
+	container.ReadinessProbe = nil
+	container.StartupProbe = nil
+	container.Lifecycle = nil
+
+	podSpec.Containers = []v1.Container{container}
+	podSpec.RestartPolicy = v1.RestartPolicyNever
+
+	labels := map[string]string{
+		"app.kubernetes.io/managed-by": "porter",
+		"porter.run/release":           name,
+		HookLabel:                      string(hookType),
+	}
+
+	backoffLimit := int32(0)
+	ttl := int32(time.Hour.Seconds())
+	deadline := int64(getTimeout(hook).Seconds())
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getJobName(name, hookType),
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            &backoffLimit,
+			TTLSecondsAfterFinished: &ttl,
+			ActiveDeadlineSeconds:   &deadline,
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      labels,
+					Annotations: template.Annotations,
+				},
+				Spec: *podSpec,
+			},
+		},
+	}, nil
+}
+
+// getPodTemplate returns the pod template of the first deployment in the manifest, or of the
+// first statefulset if the manifest does not contain a deployment
+func getPodTemplate(manifest string) (*v1.PodTemplateSpec, error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(manifest), 4096)
+
+	var statefulSetTemplate *v1.PodTemplateSpec
+
+	for {
+		obj := make(map[string]interface{})
+
+		err := decoder.Decode(&obj)
+
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		switch obj["kind"] {
+		case "Deployment":
+			depl := &appsv1.Deployment{}
+
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj, depl); err != nil {
+				return nil, err
+			}
+
+			return &depl.Spec.Template, nil
+		case "StatefulSet":
+			if statefulSetTemplate != nil {
+				continue
+			}
+
+			statefulSet := &appsv1.StatefulSet{}
+
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj, statefulSet); err != nil {
+				return nil, err
+			}
+
+			statefulSetTemplate = &statefulSet.Spec.Template
+		}
+	}
+
+	if statefulSetTemplate != nil {
+		return statefulSetTemplate, nil
+	}
+
+	return nil, fmt.Errorf("release does not have a deployment or statefulset to run hooks with")
+}
+
+func (r *Runner) waitForJob(job *batchv1.Job, timeout time.Duration) error {
+	timeWait := time.Now().Add(timeout)
+
+	for time.Now().Before(timeWait) {
+		current, err := r.k8sAgent.Clientset.BatchV1().Jobs(job.Namespace).Get(
+			context.Background(),
+			job.Name,
+			metav1.GetOptions{},
+		)
+
+		if err != nil {
+			return err
+		}
+
+		if current.Status.Succeeded > 0 {
+			return nil
+		}
+
+		if current.Status.Failed > 0 {
+			return fmt.Errorf("job %s failed", job.Name)
+		}
+
+		time.Sleep(5 * time.Second)
+	}
+
+	return fmt.Errorf("timed out waiting for job %s", job.Name)
+}
+
+// waitForRollout waits for every deployment in the manifest to finish rolling out
+func (r *Runner) waitForRollout(manifest string, timeout time.Duration) error {
+	names, err := releasesteps.GetManifestDeployments(manifest)
+
+	if err != nil {
+		return err
+	}
+
+	timeWait := time.Now().Add(timeout)
+
+	for _, name := range names {
+		for {
+			depl, err := r.k8sAgent.Clientset.AppsV1().Deployments(r.release.Namespace).Get(
+				context.Background(),
+				name,
+				metav1.GetOptions{},
+			)
+
+			if err != nil {
+				return err
+			}
+
+			if kubernetes.IsDeploymentRolledOut(depl) {
+				break
+			}
+
+			if time.Now().After(timeWait) {
+				return fmt.Errorf("timed out waiting for deployment %s to roll out", name)
+			}
+
+			time.Sleep(5 * time.Second)
+		}
+	}
+
+	return nil
+}
+
+// getJobLogs returns the last lines of the logs of the pods of a job
+func (r *Runner) getJobLogs(job *batchv1.Job) string {
+	pods, err := r.k8sAgent.GetJobPods(job.Namespace, job.Name)
+
+	if err != nil {
+		return ""
+	}
+
+	tailLines := int64(logTailLines)
+	logs := make([]string, 0)
+
+	for _, pod := range pods {
+		raw, err := r.k8sAgent.Clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &v1.PodLogOptions{
+			TailLines: &tailLines,
+		}).DoRaw(context.Background())
+
+		if err != nil {
+			continue
+		}
+
+		logs = append(logs, strings.TrimSpace(string(raw)))
+	}
+
+	return strings.Join(logs, "\n")
+}
+
+func (r *Runner) recordStep(hookType types.ReleaseHookType, index int64, status types.EventStatus, info string) {
+	r.recordEvent(string(hookType), getHookName(hookType), index, status, info)
+}
+
+func (r *Runner) recordEvent(eventID, name string, index int64, status types.EventStatus, info string) {
+	releasesteps.AppendEvent(r.repo, r.release, &models.SubEvent{
+		EventID: eventID,
+		Name:    name,
+		Index:   index,
+		Status:  status,
+		Info:    info,
+	})
+}
+
+func getTimeout(hook *types.ReleaseHook) time.Duration {
+	if hook.TimeoutSeconds == 0 {
+		return DefaultTimeout
+	}
+
+	return time.Duration(hook.TimeoutSeconds) * time.Second
+}
+
+func getHookName(hookType types.ReleaseHookType) string {
+	if hookType == types.ReleaseHookPreDeploy {
+		return "Pre-deploy"
+	}
+
+	return "Post-deploy"
+}
+
+// getJobName returns a unique job name for a hook, which is at most 63 characters
+func getJobName(name string, hookType types.ReleaseHookType) string {
+	suffix := fmt.Sprintf(
+		"-%s-%s",
+		strings.ReplaceAll(string(hookType), "_", "-"),
+		strconv.FormatInt(time.Now().Unix(), 36),
+	)
+
+	if len(name)+len(suffix) > 63 {
+		name = strings.TrimSuffix(name[:63-len(suffix)], "-")
+	}
+
+	return name + suffix
+}
+
+func joinInfo(msg, logs string) string {
+	if logs == "" {
+		return msg
+	}
+
+	return msg + "\n" + logs
+}
@@ -0,0 +1,102 @@
+package releasehooks_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/releasehooks"
+	v1 "k8s.io/api/core/v1"
+)
+
+const manifest = `apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  selector:
+    app.kubernetes.io/instance: web
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  selector:
+    matchLabels:
+      app.kubernetes.io/instance: web
+  template:
+    metadata:
+      labels:
+        app.kubernetes.io/instance: web
+    spec:
+      serviceAccountName: web
+      containers:
+      - name: web
+        image: registry.example.com/web:v2
+        args: ["serve"]
+        ports:
+        - containerPort: 80
+        envFrom:
+        - configMapRef:
+            name: web-env
+        readinessProbe:
+          httpGet:
+            path: /healthz
+            port: 80
+      - name: cloudsql-proxy
+        image: gcr.io/cloudsql-docker/gce-proxy
+`
+
+func TestGetHookJob(t *testing.T) {
+	job, err := releasehooks.GetHookJob("web", "default", types.ReleaseHookPreDeploy, &types.ReleaseHook{
+		Command: "rails db:migrate",
+	}, manifest)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.HasPrefix(job.Name, "web-pre-deploy-") {
+		t.Errorf("unexpected job name %s", job.Name)
+	}
+
+	podSpec := job.Spec.Template.Spec
+
+	if len(podSpec.Containers) != 1 {
+		t.Fatalf("expected 1 container, got %d", len(podSpec.Containers))
+	}
+
+	container := podSpec.Containers[0]
+
+	if container.Image != "registry.example.com/web:v2" {
+		t.Errorf("expected hook to use the new image, got %s", container.Image)
+	}
+
+	if strings.Join(container.Command, " ") != "sh -c rails db:migrate" || len(container.Args) != 0 {
+		t.Errorf("unexpected command %v %v", container.Command, container.Args)
+	}
+
+	if len(container.EnvFrom) != 1 || container.EnvFrom[0].ConfigMapRef.Name != "web-env" {
+		t.Errorf("expected hook to use the env of the release, got %v", container.EnvFrom)
+	}
+
+	if container.ReadinessProbe != nil || len(container.Ports) != 0 {
+		t.Errorf("expected probes and ports to be removed")
+	}
+
+	if podSpec.RestartPolicy != v1.RestartPolicyNever || podSpec.ServiceAccountName != "web" {
+		t.Errorf("unexpected pod spec %v", podSpec)
+	}
+
+	// the hook pods must not be selected by the services of the release
+	if _, ok := job.Spec.Template.Labels["app.kubernetes.io/instance"]; ok {
+		t.Errorf("expected hook pods to not have the release selector labels")
+	}
+
+	if _, err := releasehooks.GetHookJob("web", "default", types.ReleaseHookPreDeploy, &types.ReleaseHook{
+		Command: "true",
+	}, "apiVersion: v1\nkind: Service\nmetadata:\n  name: web\n"); err == nil {
+		t.Errorf("expected error for a release without a workload")
+	}
+}
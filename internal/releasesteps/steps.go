@@ -0,0 +1,73 @@
+package releasesteps
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// AppendEvent appends an event to the steps of a release, and creates the event container of
+// the release if it does not have one yet. The event container is attached to the release
+// without saving the rest of the release, so that steps can be recorded in the background
+// while the release is updated by a request. The release is updated with the id of the event
+// container, so it must not be shared with other goroutines.
+func AppendEvent(repo repository.Repository, release *models.Release, event *models.SubEvent) error {
+	if release.EventContainer == 0 {
+		container, err := repo.BuildEvent().CreateEventContainer(&models.EventContainer{ReleaseID: release.ID})
+
+		if err != nil {
+			return err
+		}
+
+		// if another goroutine attached a container first, its container is used and the new
+		// container stays empty
+		containerID, err := repo.Release().AttachEventContainer(release.ID, container.ID)
+
+		if err != nil {
+			return err
+		}
+
+		release.EventContainer = containerID
+	}
+
+	container, err := repo.BuildEvent().ReadEventContainer(release.EventContainer)
+
+	if err != nil {
+		return err
+	}
+
+	event.EventContainerID = container.ID
+
+	return repo.BuildEvent().AppendEvent(container, event)
+}
+
+// GetManifestDeployments returns the names of the deployments in a release manifest
+func GetManifestDeployments(manifest string) ([]string, error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(manifest), 4096)
+	names := make([]string, 0)
+
+	for {
+		obj := make(map[string]interface{})
+
+		err := decoder.Decode(&obj)
+
+		if err == io.EOF {
+			return names, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		if obj["kind"] != "Deployment" {
+			continue
+		}
+
+		if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+			if name, ok := metadata["name"].(string); ok {
+				names = append(names, name)
+			}
+		}
+	}
+}
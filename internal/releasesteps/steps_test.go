@@ -0,0 +1,34 @@
+package releasesteps
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetManifestDeployments(t *testing.T) {
+	manifest := `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: worker
+`
+
+	names, err := GetManifestDeployments(manifest)
+
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if !reflect.DeepEqual(names, []string{"web", "worker"}) {
+		t.Errorf("expected deployments web and worker, got %v", names)
+	}
+}
@@ -0,0 +1,10 @@
+package repository
+
+import "github.com/porter-dev/porter/internal/models"
+
+// BuildAttestationRepository represents the set of queries on the BuildAttestation model
+type BuildAttestationRepository interface {
+	CreateBuildAttestation(attestation *models.BuildAttestation) (*models.BuildAttestation, error)
+	ListBuildAttestationsForRelease(projID, clusterID uint, name, namespace string) ([]*models.BuildAttestation, error)
+	ListBuildAttestationsForImage(projID, clusterID uint, name, namespace, imageRepoURI, tag string) ([]*models.BuildAttestation, error)
+}
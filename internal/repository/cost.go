@@ -0,0 +1,10 @@
+package repository
+
+import "github.com/porter-dev/porter/internal/models"
+
+// ClusterCostConfigRepository represents the set of queries on the ClusterCostConfig model
+type ClusterCostConfigRepository interface {
+	CreateClusterCostConfig(conf *models.ClusterCostConfig) (*models.ClusterCostConfig, error)
+	ReadClusterCostConfig(projID, clusterID uint) (*models.ClusterCostConfig, error)
+	UpdateClusterCostConfig(conf *models.ClusterCostConfig) (*models.ClusterCostConfig, error)
+}
@@ -0,0 +1,12 @@
+package repository
+
+import "github.com/porter-dev/porter/internal/models"
+
+// CustomMetricRepository represents the set of queries on the CustomMetric model
+type CustomMetricRepository interface {
+	CreateCustomMetric(metric *models.CustomMetric) (*models.CustomMetric, error)
+	ReadCustomMetric(projID, clusterID uint, releaseName, namespace, name string) (*models.CustomMetric, error)
+	ListCustomMetrics(projID, clusterID uint, releaseName, namespace string) ([]*models.CustomMetric, error)
+	UpdateCustomMetric(metric *models.CustomMetric) (*models.CustomMetric, error)
+	DeleteCustomMetric(metric *models.CustomMetric) error
+}
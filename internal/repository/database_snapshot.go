@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// DatabaseSnapshotRepository represents the set of queries on the DatabaseSnapshot model
+type DatabaseSnapshotRepository interface {
+	CreateDatabaseSnapshot(snapshot *models.DatabaseSnapshot) (*models.DatabaseSnapshot, error)
+	ReadDatabaseSnapshot(projectID, databaseID, snapshotID uint) (*models.DatabaseSnapshot, error)
+	ReadDatabaseSnapshotByOperationUID(infraID uint, operationUID string) (*models.DatabaseSnapshot, error)
+	ListDatabaseSnapshots(projectID, databaseID uint) ([]*models.DatabaseSnapshot, error)
+	UpdateDatabaseSnapshot(snapshot *models.DatabaseSnapshot) (*models.DatabaseSnapshot, error)
+}
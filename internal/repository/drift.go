@@ -0,0 +1,11 @@
+package repository
+
+import "github.com/porter-dev/porter/internal/models"
+
+// DriftDetectionConfigRepository represents the set of queries on the DriftDetectionConfig model
+type DriftDetectionConfigRepository interface {
+	CreateDriftDetectionConfig(conf *models.DriftDetectionConfig) (*models.DriftDetectionConfig, error)
+	ReadDriftDetectionConfig(projID, clusterID uint, name, namespace string) (*models.DriftDetectionConfig, error)
+	ListEnabledDriftDetectionConfigs() ([]*models.DriftDetectionConfig, error)
+	UpdateDriftDetectionConfig(conf *models.DriftDetectionConfig) (*models.DriftDetectionConfig, error)
+}
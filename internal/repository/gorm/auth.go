@@ -1570,3 +1570,136 @@ func (repo *AzureIntegrationRepository) DecryptAzureIntegrationData(
 
 	return nil
 }
+
+// GitlabIntegrationRepository uses gorm.DB for querying the database
+type GitlabIntegrationRepository struct {
+	db  *gorm.DB
+	key *[32]byte
+}
+
+// NewGitlabIntegrationRepository returns a GitlabIntegrationRepository which uses
+// gorm.DB for querying the database. It accepts an encryption key to encrypt
+// sensitive data
+func NewGitlabIntegrationRepository(db *gorm.DB, key *[32]byte) repository.GitlabIntegrationRepository {
+	return &GitlabIntegrationRepository{db, key}
+}
+
+// CreateGitlabIntegration creates a new GitLab integration
+func (repo *GitlabIntegrationRepository) CreateGitlabIntegration(
+	gi *ints.GitlabIntegration,
+) (*ints.GitlabIntegration, error) {
+	err := repo.EncryptGitlabIntegrationData(gi, repo.key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.Create(gi).Error; err != nil {
+		return nil, err
+	}
+
+	err = repo.DecryptGitlabIntegrationData(gi, repo.key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gi, nil
+}
+
+// ReadGitlabIntegration finds a GitLab integration by id
+func (repo *GitlabIntegrationRepository) ReadGitlabIntegration(
+	projectID, id uint,
+) (*ints.GitlabIntegration, error) {
+	gi := &ints.GitlabIntegration{}
+
+	if err := repo.db.Where("project_id = ? AND id = ?", projectID, id).First(&gi).Error; err != nil {
+		return nil, err
+	}
+
+	err := repo.DecryptGitlabIntegrationData(gi, repo.key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gi, nil
+}
+
+// ListGitlabIntegrationsByProjectID finds all GitLab integrations for a given project id
+func (repo *GitlabIntegrationRepository) ListGitlabIntegrationsByProjectID(
+	projectID uint,
+) ([]*ints.GitlabIntegration, error) {
+	gis := []*ints.GitlabIntegration{}
+
+	if err := repo.db.Where("project_id = ?", projectID).Find(&gis).Error; err != nil {
+		return nil, err
+	}
+
+	return gis, nil
+}
+
+// UpdateGitlabIntegration modifies an existing GitLab integration in the database
+func (repo *GitlabIntegrationRepository) UpdateGitlabIntegration(
+	gi *ints.GitlabIntegration,
+) (*ints.GitlabIntegration, error) {
+	err := repo.EncryptGitlabIntegrationData(gi, repo.key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.Save(gi).Error; err != nil {
+		return nil, err
+	}
+
+	err = repo.DecryptGitlabIntegrationData(gi, repo.key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gi, nil
+}
+
+// EncryptGitlabIntegrationData will encrypt the GitLab integration data before
+// writing to the DB
+func (repo *GitlabIntegrationRepository) EncryptGitlabIntegrationData(
+	gi *ints.GitlabIntegration,
+	key *[32]byte,
+) error {
+	for _, field := range []*[]byte{&gi.ClientID, &gi.AccessToken, &gi.RefreshToken, &gi.AppClientSecret} {
+		if len(*field) > 0 {
+			cipherData, err := encryption.Encrypt(*field, key)
+
+			if err != nil {
+				return err
+			}
+
+			*field = cipherData
+		}
+	}
+
+	return nil
+}
+
+// DecryptGitlabIntegrationData will decrypt the GitLab integration data before
+// returning it from the DB
+func (repo *GitlabIntegrationRepository) DecryptGitlabIntegrationData(
+	gi *ints.GitlabIntegration,
+	key *[32]byte,
+) error {
+	for _, field := range []*[]byte{&gi.ClientID, &gi.AccessToken, &gi.RefreshToken, &gi.AppClientSecret} {
+		if len(*field) > 0 {
+			plaintext, err := encryption.Decrypt(*field, key)
+
+			if err != nil {
+				return err
+			}
+
+			*field = plaintext
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package gorm
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// BuildAttestationRepository uses gorm.DB for querying the database
+type BuildAttestationRepository struct {
+	db *gorm.DB
+}
+
+// NewBuildAttestationRepository returns a BuildAttestationRepository which uses
+// gorm.DB for querying the database
+func NewBuildAttestationRepository(db *gorm.DB) repository.BuildAttestationRepository {
+	return &BuildAttestationRepository{db}
+}
+
+// CreateBuildAttestation creates a new build attestation for a release
+func (repo *BuildAttestationRepository) CreateBuildAttestation(attestation *models.BuildAttestation) (*models.BuildAttestation, error) {
+	if err := repo.db.Create(attestation).Error; err != nil {
+		return nil, err
+	}
+
+	return attestation, nil
+}
+
+// ListBuildAttestationsForRelease lists all build attestations for a release, newest first
+func (repo *BuildAttestationRepository) ListBuildAttestationsForRelease(projID, clusterID uint, name, namespace string) ([]*models.BuildAttestation, error) {
+	attestations := make([]*models.BuildAttestation, 0)
+
+	if err := repo.db.Where(
+		"project_id = ? AND cluster_id = ? AND name = ? AND namespace = ?",
+		projID, clusterID, name, namespace,
+	).Order("id desc").Find(&attestations).Error; err != nil {
+		return nil, err
+	}
+
+	return attestations, nil
+}
+
+// ListBuildAttestationsForImage lists the build attestations for a specific image of a release,
+// newest first
+func (repo *BuildAttestationRepository) ListBuildAttestationsForImage(
+	projID, clusterID uint,
+	name, namespace, imageRepoURI, tag string,
+) ([]*models.BuildAttestation, error) {
+	attestations := make([]*models.BuildAttestation, 0)
+
+	if err := repo.db.Where(
+		"project_id = ? AND cluster_id = ? AND name = ? AND namespace = ? AND image_repo_uri = ? AND tag = ?",
+		projID, clusterID, name, namespace, imageRepoURI, tag,
+	).Order("id desc").Find(&attestations).Error; err != nil {
+		return nil, err
+	}
+
+	return attestations, nil
+}
@@ -0,0 +1,61 @@
+package gorm
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// ClusterCostConfigRepository uses gorm.DB for querying the database
+type ClusterCostConfigRepository struct {
+	db *gorm.DB
+}
+
+// NewClusterCostConfigRepository returns a ClusterCostConfigRepository which uses
+// gorm.DB for querying the database
+func NewClusterCostConfigRepository(db *gorm.DB) repository.ClusterCostConfigRepository {
+	return &ClusterCostConfigRepository{db}
+}
+
+// CreateClusterCostConfig creates a new cost config for a cluster
+func (repo *ClusterCostConfigRepository) CreateClusterCostConfig(conf *models.ClusterCostConfig) (*models.ClusterCostConfig, error) {
+	if err := repo.db.Create(conf).Error; err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+// ReadClusterCostConfig reads the cost config for a cluster
+func (repo *ClusterCostConfigRepository) ReadClusterCostConfig(projID, clusterID uint) (*models.ClusterCostConfig, error) {
+	conf := &models.ClusterCostConfig{}
+
+	if err := repo.db.Preload("NodePrices").Where(
+		"project_id = ? AND cluster_id = ?", projID, clusterID,
+	).First(conf).Error; err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+// UpdateClusterCostConfig updates a cluster cost config, replacing the existing node prices
+func (repo *ClusterCostConfigRepository) UpdateClusterCostConfig(conf *models.ClusterCostConfig) (*models.ClusterCostConfig, error) {
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("cluster_cost_config_id = ?", conf.ID).Delete(&models.NodeTypePrice{}).Error; err != nil {
+			return err
+		}
+
+		for i := range conf.NodePrices {
+			conf.NodePrices[i].ID = 0
+		}
+
+		return tx.Save(conf).Error
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
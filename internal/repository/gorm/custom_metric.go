@@ -0,0 +1,75 @@
+package gorm
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// CustomMetricRepository uses gorm.DB for querying the database
+type CustomMetricRepository struct {
+	db *gorm.DB
+}
+
+// NewCustomMetricRepository returns a CustomMetricRepository which uses gorm.DB for
+// querying the database
+func NewCustomMetricRepository(db *gorm.DB) repository.CustomMetricRepository {
+	return &CustomMetricRepository{db}
+}
+
+// CreateCustomMetric creates a new custom metric for a release
+func (repo *CustomMetricRepository) CreateCustomMetric(metric *models.CustomMetric) (*models.CustomMetric, error) {
+	if err := repo.db.Create(metric).Error; err != nil {
+		return nil, err
+	}
+
+	return metric, nil
+}
+
+// ReadCustomMetric reads a custom metric of a release by name
+func (repo *CustomMetricRepository) ReadCustomMetric(
+	projID, clusterID uint,
+	releaseName, namespace, name string,
+) (*models.CustomMetric, error) {
+	metric := &models.CustomMetric{}
+
+	if err := repo.db.Where(
+		"project_id = ? AND cluster_id = ? AND release_name = ? AND namespace = ? AND name = ?",
+		projID, clusterID, releaseName, namespace, name,
+	).First(metric).Error; err != nil {
+		return nil, err
+	}
+
+	return metric, nil
+}
+
+// ListCustomMetrics lists the custom metrics of a release
+func (repo *CustomMetricRepository) ListCustomMetrics(
+	projID, clusterID uint,
+	releaseName, namespace string,
+) ([]*models.CustomMetric, error) {
+	metrics := make([]*models.CustomMetric, 0)
+
+	if err := repo.db.Where(
+		"project_id = ? AND cluster_id = ? AND release_name = ? AND namespace = ?",
+		projID, clusterID, releaseName, namespace,
+	).Order("name asc").Find(&metrics).Error; err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// UpdateCustomMetric updates a custom metric
+func (repo *CustomMetricRepository) UpdateCustomMetric(metric *models.CustomMetric) (*models.CustomMetric, error) {
+	if err := repo.db.Save(metric).Error; err != nil {
+		return nil, err
+	}
+
+	return metric, nil
+}
+
+// DeleteCustomMetric deletes a custom metric
+func (repo *CustomMetricRepository) DeleteCustomMetric(metric *models.CustomMetric) error {
+	return repo.db.Delete(metric).Error
+}
@@ -0,0 +1,78 @@
+package gorm
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// DatabaseSnapshotRepository uses gorm.DB for querying the database
+type DatabaseSnapshotRepository struct {
+	db *gorm.DB
+}
+
+// NewDatabaseSnapshotRepository returns a DatabaseSnapshotRepository which uses gorm.DB
+// for querying the database
+func NewDatabaseSnapshotRepository(db *gorm.DB) repository.DatabaseSnapshotRepository {
+	return &DatabaseSnapshotRepository{db}
+}
+
+// CreateDatabaseSnapshot creates a new database snapshot
+func (repo *DatabaseSnapshotRepository) CreateDatabaseSnapshot(snapshot *models.DatabaseSnapshot) (*models.DatabaseSnapshot, error) {
+	if err := repo.db.Create(snapshot).Error; err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// ReadDatabaseSnapshot reads a snapshot of a database by id
+func (repo *DatabaseSnapshotRepository) ReadDatabaseSnapshot(projectID, databaseID, snapshotID uint) (*models.DatabaseSnapshot, error) {
+	snapshot := &models.DatabaseSnapshot{}
+
+	if err := repo.db.Where(
+		"project_id = ? AND database_id = ? AND id = ?",
+		projectID, databaseID, snapshotID,
+	).First(snapshot).Error; err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// ReadDatabaseSnapshotByOperationUID reads the snapshot which was created by an infra operation
+func (repo *DatabaseSnapshotRepository) ReadDatabaseSnapshotByOperationUID(infraID uint, operationUID string) (*models.DatabaseSnapshot, error) {
+	snapshot := &models.DatabaseSnapshot{}
+
+	if err := repo.db.Where(
+		"infra_id = ? AND operation_uid = ?",
+		infraID, operationUID,
+	).First(snapshot).Error; err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// ListDatabaseSnapshots lists the snapshots of a database, most recent first
+func (repo *DatabaseSnapshotRepository) ListDatabaseSnapshots(projectID, databaseID uint) ([]*models.DatabaseSnapshot, error) {
+	snapshots := make([]*models.DatabaseSnapshot, 0)
+
+	if err := repo.db.Where(
+		"project_id = ? AND database_id = ?",
+		projectID, databaseID,
+	).Order("created_at desc").Find(&snapshots).Error; err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// UpdateDatabaseSnapshot updates a database snapshot
+func (repo *DatabaseSnapshotRepository) UpdateDatabaseSnapshot(snapshot *models.DatabaseSnapshot) (*models.DatabaseSnapshot, error) {
+	if err := repo.db.Save(snapshot).Error; err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
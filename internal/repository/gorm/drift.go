@@ -0,0 +1,61 @@
+package gorm
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// DriftDetectionConfigRepository uses gorm.DB for querying the database
+type DriftDetectionConfigRepository struct {
+	db *gorm.DB
+}
+
+// NewDriftDetectionConfigRepository returns a DriftDetectionConfigRepository which uses
+// gorm.DB for querying the database
+func NewDriftDetectionConfigRepository(db *gorm.DB) repository.DriftDetectionConfigRepository {
+	return &DriftDetectionConfigRepository{db}
+}
+
+// CreateDriftDetectionConfig creates a new drift detection config for a release
+func (repo *DriftDetectionConfigRepository) CreateDriftDetectionConfig(conf *models.DriftDetectionConfig) (*models.DriftDetectionConfig, error) {
+	if err := repo.db.Create(conf).Error; err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+// ReadDriftDetectionConfig reads the drift detection config for a release
+func (repo *DriftDetectionConfigRepository) ReadDriftDetectionConfig(projID, clusterID uint, name, namespace string) (*models.DriftDetectionConfig, error) {
+	conf := &models.DriftDetectionConfig{}
+
+	if err := repo.db.Where(
+		"project_id = ? AND cluster_id = ? AND name = ? AND namespace = ?",
+		projID, clusterID, name, namespace,
+	).First(conf).Error; err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+// ListEnabledDriftDetectionConfigs lists all drift detection configs which are enabled
+func (repo *DriftDetectionConfigRepository) ListEnabledDriftDetectionConfigs() ([]*models.DriftDetectionConfig, error) {
+	confs := make([]*models.DriftDetectionConfig, 0)
+
+	if err := repo.db.Where("enabled = ?", true).Find(&confs).Error; err != nil {
+		return nil, err
+	}
+
+	return confs, nil
+}
+
+// UpdateDriftDetectionConfig updates a drift detection config
+func (repo *DriftDetectionConfigRepository) UpdateDriftDetectionConfig(conf *models.DriftDetectionConfig) (*models.DriftDetectionConfig, error) {
+	if err := repo.db.Save(conf).Error; err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
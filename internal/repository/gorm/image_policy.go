@@ -0,0 +1,56 @@
+package gorm
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// ImagePolicyRepository uses gorm.DB for querying the database
+type ImagePolicyRepository struct {
+	db *gorm.DB
+}
+
+// NewImagePolicyRepository returns an ImagePolicyRepository which uses
+// gorm.DB for querying the database
+func NewImagePolicyRepository(db *gorm.DB) repository.ImagePolicyRepository {
+	return &ImagePolicyRepository{db}
+}
+
+// CreateImagePolicy creates a new image policy for a project or cluster
+func (repo *ImagePolicyRepository) CreateImagePolicy(policy *models.ImagePolicy) (*models.ImagePolicy, error) {
+	if err := repo.db.Create(policy).Error; err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// ReadImagePolicy reads the image policy for a cluster, or for the project if the cluster
+// id is 0
+func (repo *ImagePolicyRepository) ReadImagePolicy(projID, clusterID uint) (*models.ImagePolicy, error) {
+	policy := &models.ImagePolicy{}
+
+	if err := repo.db.Where(
+		"project_id = ? AND cluster_id = ?",
+		projID, clusterID,
+	).First(policy).Error; err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// UpdateImagePolicy updates an image policy
+func (repo *ImagePolicyRepository) UpdateImagePolicy(policy *models.ImagePolicy) (*models.ImagePolicy, error) {
+	if err := repo.db.Save(policy).Error; err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// DeleteImagePolicy deletes an image policy
+func (repo *ImagePolicyRepository) DeleteImagePolicy(policy *models.ImagePolicy) error {
+	return repo.db.Delete(policy).Error
+}
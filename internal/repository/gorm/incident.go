@@ -0,0 +1,108 @@
+package gorm
+
+import (
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// IncidentRepository uses gorm.DB for querying the database
+type IncidentRepository struct {
+	db *gorm.DB
+}
+
+// NewIncidentRepository returns an IncidentRepository which uses gorm.DB for querying the
+// database
+func NewIncidentRepository(db *gorm.DB) repository.IncidentRepository {
+	return &IncidentRepository{db}
+}
+
+// CreateIncident creates a new incident record
+func (repo *IncidentRepository) CreateIncident(incident *models.Incident) (*models.Incident, error) {
+	if err := repo.db.Create(incident).Error; err != nil {
+		return nil, err
+	}
+
+	return incident, nil
+}
+
+// ReadIncident reads an incident record by its ID, along with its timeline
+func (repo *IncidentRepository) ReadIncident(projectID, clusterID, id uint) (*models.Incident, error) {
+	incident := &models.Incident{}
+
+	if err := repo.db.Preload("Notes", func(db *gorm.DB) *gorm.DB {
+		return db.Order("incident_notes.created_at ASC")
+	}).Where(
+		"project_id = ? AND cluster_id = ? AND id = ?",
+		projectID, clusterID, id,
+	).First(incident).Error; err != nil {
+		return nil, err
+	}
+
+	return incident, nil
+}
+
+// ReadIncidentByAgentID reads an incident record by the ID of the incident in the porter-agent
+func (repo *IncidentRepository) ReadIncidentByAgentID(clusterID uint, agentIncidentID string) (*models.Incident, error) {
+	incident := &models.Incident{}
+
+	if err := repo.db.Where(
+		"cluster_id = ? AND agent_incident_id = ?",
+		clusterID, agentIncidentID,
+	).First(incident).Error; err != nil {
+		return nil, err
+	}
+
+	return incident, nil
+}
+
+// ListIncidents lists the incident records of a cluster, newest first
+func (repo *IncidentRepository) ListIncidents(
+	projectID, clusterID uint,
+	filter *types.ListIncidentRecordsRequest,
+) ([]*models.Incident, error) {
+	incidents := make([]*models.Incident, 0)
+
+	query := repo.db.Where("project_id = ? AND cluster_id = ?", projectID, clusterID)
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	if filter.ReleaseName != "" {
+		query = query.Where("release_name = ?", filter.ReleaseName)
+	}
+
+	if filter.Namespace != "" {
+		query = query.Where("namespace = ?", filter.Namespace)
+	}
+
+	if err := query.Order("started_at DESC").Find(&incidents).Error; err != nil {
+		return nil, err
+	}
+
+	return incidents, nil
+}
+
+// UpdateIncident updates an incident record
+func (repo *IncidentRepository) UpdateIncident(incident *models.Incident) (*models.Incident, error) {
+	if err := repo.db.Omit("Notes").Save(incident).Error; err != nil {
+		return nil, err
+	}
+
+	return incident, nil
+}
+
+// AppendIncidentNote adds an entry to the timeline of an incident
+func (repo *IncidentRepository) AppendIncidentNote(incident *models.Incident, note *models.IncidentNote) (*models.IncidentNote, error) {
+	note.IncidentID = incident.ID
+
+	if err := repo.db.Create(note).Error; err != nil {
+		return nil, err
+	}
+
+	incident.Notes = append(incident.Notes, *note)
+
+	return note, nil
+}
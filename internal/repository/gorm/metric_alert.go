@@ -0,0 +1,83 @@
+package gorm
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// MetricAlertRuleRepository uses gorm.DB for querying the database
+type MetricAlertRuleRepository struct {
+	db *gorm.DB
+}
+
+// NewMetricAlertRuleRepository returns a MetricAlertRuleRepository which uses gorm.DB for
+// querying the database
+func NewMetricAlertRuleRepository(db *gorm.DB) repository.MetricAlertRuleRepository {
+	return &MetricAlertRuleRepository{db}
+}
+
+// CreateMetricAlertRule creates a new alert rule for a release
+func (repo *MetricAlertRuleRepository) CreateMetricAlertRule(rule *models.MetricAlertRule) (*models.MetricAlertRule, error) {
+	if err := repo.db.Create(rule).Error; err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// ReadMetricAlertRule reads an alert rule by its id
+func (repo *MetricAlertRuleRepository) ReadMetricAlertRule(projID, clusterID, id uint) (*models.MetricAlertRule, error) {
+	rule := &models.MetricAlertRule{}
+
+	if err := repo.db.Where(
+		"project_id = ? AND cluster_id = ? AND id = ?",
+		projID, clusterID, id,
+	).First(rule).Error; err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// ListMetricAlertRules lists the alert rules of a release
+func (repo *MetricAlertRuleRepository) ListMetricAlertRules(
+	projID, clusterID uint,
+	releaseName, namespace string,
+) ([]*models.MetricAlertRule, error) {
+	rules := make([]*models.MetricAlertRule, 0)
+
+	if err := repo.db.Where(
+		"project_id = ? AND cluster_id = ? AND release_name = ? AND namespace = ?",
+		projID, clusterID, releaseName, namespace,
+	).Order("id asc").Find(&rules).Error; err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// ListEnabledMetricAlertRules lists all alert rules which are enabled
+func (repo *MetricAlertRuleRepository) ListEnabledMetricAlertRules() ([]*models.MetricAlertRule, error) {
+	rules := make([]*models.MetricAlertRule, 0)
+
+	if err := repo.db.Where("enabled = ?", true).Order("cluster_id asc").Find(&rules).Error; err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// UpdateMetricAlertRule updates an alert rule
+func (repo *MetricAlertRuleRepository) UpdateMetricAlertRule(rule *models.MetricAlertRule) (*models.MetricAlertRule, error) {
+	if err := repo.db.Save(rule).Error; err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// DeleteMetricAlertRule deletes an alert rule
+func (repo *MetricAlertRuleRepository) DeleteMetricAlertRule(rule *models.MetricAlertRule) error {
+	return repo.db.Delete(rule).Error
+}
@@ -49,6 +49,25 @@ func AutoMigrate(db *gorm.DB, debug bool) error {
 		&models.BuildConfig{},
 		&models.Allowlist{},
 		&models.Tag{},
+		&models.DriftDetectionConfig{},
+		&models.ClusterCostConfig{},
+		&models.NodeTypePrice{},
+		&models.NamespacePolicy{},
+		&ints.GitlabIntegration{},
+		&models.BuildAttestation{},
+		&models.ImagePolicy{},
+		&models.Incident{},
+		&models.IncidentNote{},
+		&models.CustomMetric{},
+		&models.MetricAlertRule{},
+		&models.SLO{},
+		&models.DatabaseSnapshot{},
+		&models.Team{},
+		&models.TeamMember{},
+		&models.SSOConfig{},
+		&models.SSODomain{},
+		&models.SSOGroupMapping{},
+		&models.SSOUsedID{},
 		&ints.KubeIntegration{},
 		&ints.BasicIntegration{},
 		&ints.OIDCIntegration{},
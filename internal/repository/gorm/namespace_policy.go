@@ -0,0 +1,61 @@
+package gorm
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// NamespacePolicyRepository uses gorm.DB for querying the database
+type NamespacePolicyRepository struct {
+	db *gorm.DB
+}
+
+// NewNamespacePolicyRepository returns a NamespacePolicyRepository which uses
+// gorm.DB for querying the database
+func NewNamespacePolicyRepository(db *gorm.DB) repository.NamespacePolicyRepository {
+	return &NamespacePolicyRepository{db}
+}
+
+// CreateNamespacePolicy creates a new policy for a namespace
+func (repo *NamespacePolicyRepository) CreateNamespacePolicy(policy *models.NamespacePolicy) (*models.NamespacePolicy, error) {
+	if err := repo.db.Create(policy).Error; err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// ReadNamespacePolicy reads the policy for a namespace
+func (repo *NamespacePolicyRepository) ReadNamespacePolicy(projID, clusterID uint, namespace string) (*models.NamespacePolicy, error) {
+	policy := &models.NamespacePolicy{}
+
+	if err := repo.db.Where(
+		"project_id = ? AND cluster_id = ? AND namespace = ?",
+		projID, clusterID, namespace,
+	).First(policy).Error; err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// ListNamespacePolicies lists all namespace policies
+func (repo *NamespacePolicyRepository) ListNamespacePolicies() ([]*models.NamespacePolicy, error) {
+	policies := make([]*models.NamespacePolicy, 0)
+
+	if err := repo.db.Find(&policies).Error; err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// UpdateNamespacePolicy updates a namespace policy
+func (repo *NamespacePolicyRepository) UpdateNamespacePolicy(policy *models.NamespacePolicy) (*models.NamespacePolicy, error) {
+	if err := repo.db.Save(policy).Error; err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
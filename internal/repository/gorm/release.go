@@ -67,6 +67,27 @@ func (repo *ReleaseRepository) UpdateRelease(release *models.Release) (*models.R
 	return release, nil
 }
 
+// AttachEventContainer sets the event container of a release if the release does not have one
+// yet, and returns the event container of the release. Only the event container column is
+// written, so that concurrent updates to other fields of the release are not overwritten.
+func (repo *ReleaseRepository) AttachEventContainer(releaseID, containerID uint) (uint, error) {
+	err := repo.db.Model(&models.Release{}).
+		Where("id = ? AND event_container = ?", releaseID, 0).
+		UpdateColumn("event_container", containerID).Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	release := &models.Release{}
+
+	if err := repo.db.Select("event_container").Where("id = ?", releaseID).First(release).Error; err != nil {
+		return 0, err
+	}
+
+	return release.EventContainer, nil
+}
+
 // DeleteRelease deletes a single user using their unique name and namespace pair
 func (repo *ReleaseRepository) DeleteRelease(release *models.Release) (*models.Release, error) {
 	if err := repo.db.Delete(&release).Error; err != nil {
@@ -43,6 +43,19 @@ type GormRepository struct {
 	buildConfig               repository.BuildConfigRepository
 	allowlist                 repository.AllowlistRepository
 	tag                       repository.TagRepository
+	driftDetectionConfig      repository.DriftDetectionConfigRepository
+	clusterCostConfig         repository.ClusterCostConfigRepository
+	namespacePolicy           repository.NamespacePolicyRepository
+	gitlabIntegration         repository.GitlabIntegrationRepository
+	buildAttestation          repository.BuildAttestationRepository
+	imagePolicy               repository.ImagePolicyRepository
+	incident                  repository.IncidentRepository
+	customMetric              repository.CustomMetricRepository
+	metricAlertRule           repository.MetricAlertRuleRepository
+	slo                       repository.SLORepository
+	databaseSnapshot          repository.DatabaseSnapshotRepository
+	team                      repository.TeamRepository
+	ssoConfig                 repository.SSOConfigRepository
 }
 
 func (t *GormRepository) User() repository.UserRepository {
@@ -189,6 +202,58 @@ func (t *GormRepository) Tag() repository.TagRepository {
 	return t.tag
 }
 
+func (t *GormRepository) DriftDetectionConfig() repository.DriftDetectionConfigRepository {
+	return t.driftDetectionConfig
+}
+
+func (t *GormRepository) ClusterCostConfig() repository.ClusterCostConfigRepository {
+	return t.clusterCostConfig
+}
+
+func (t *GormRepository) NamespacePolicy() repository.NamespacePolicyRepository {
+	return t.namespacePolicy
+}
+
+func (t *GormRepository) GitlabIntegration() repository.GitlabIntegrationRepository {
+	return t.gitlabIntegration
+}
+
+func (t *GormRepository) BuildAttestation() repository.BuildAttestationRepository {
+	return t.buildAttestation
+}
+
+func (t *GormRepository) ImagePolicy() repository.ImagePolicyRepository {
+	return t.imagePolicy
+}
+
+func (t *GormRepository) Incident() repository.IncidentRepository {
+	return t.incident
+}
+
+func (t *GormRepository) CustomMetric() repository.CustomMetricRepository {
+	return t.customMetric
+}
+
+func (t *GormRepository) MetricAlertRule() repository.MetricAlertRuleRepository {
+	return t.metricAlertRule
+}
+
+func (t *GormRepository) SLO() repository.SLORepository {
+	return t.slo
+}
+
+func (t *GormRepository) DatabaseSnapshot() repository.DatabaseSnapshotRepository {
+	return t.databaseSnapshot
+}
+
+func (t *GormRepository) Team() repository.TeamRepository {
+	return t.team
+}
+
+func (t *GormRepository) SSOConfig() repository.SSOConfigRepository {
+	return t.ssoConfig
+}
+
 // NewRepository returns a Repository which persists users in memory
 // and accepts a parameter that can trigger read/write errors
 func NewRepository(db *gorm.DB, key *[32]byte, storageBackend credentials.CredentialStorage) repository.Repository {
@@ -229,5 +294,18 @@ func NewRepository(db *gorm.DB, key *[32]byte, storageBackend credentials.Creden
 		buildConfig:               NewBuildConfigRepository(db),
 		allowlist:                 NewAllowlistRepository(db),
 		tag:                       NewTagRepository(db),
+		driftDetectionConfig:      NewDriftDetectionConfigRepository(db),
+		clusterCostConfig:         NewClusterCostConfigRepository(db),
+		namespacePolicy:           NewNamespacePolicyRepository(db),
+		gitlabIntegration:         NewGitlabIntegrationRepository(db, key),
+		buildAttestation:          NewBuildAttestationRepository(db),
+		imagePolicy:               NewImagePolicyRepository(db),
+		incident:                  NewIncidentRepository(db),
+		customMetric:              NewCustomMetricRepository(db),
+		metricAlertRule:           NewMetricAlertRuleRepository(db),
+		slo:                       NewSLORepository(db),
+		databaseSnapshot:          NewDatabaseSnapshotRepository(db),
+		team:                      NewTeamRepository(db),
+		ssoConfig:                 NewSSOConfigRepository(db, key),
 	}
 }
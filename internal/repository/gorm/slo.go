@@ -0,0 +1,68 @@
+package gorm
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// SLORepository uses gorm.DB for querying the database
+type SLORepository struct {
+	db *gorm.DB
+}
+
+// NewSLORepository returns a SLORepository which uses gorm.DB for querying the database
+func NewSLORepository(db *gorm.DB) repository.SLORepository {
+	return &SLORepository{db}
+}
+
+// CreateSLO creates a new SLO for a release
+func (repo *SLORepository) CreateSLO(slo *models.SLO) (*models.SLO, error) {
+	if err := repo.db.Create(slo).Error; err != nil {
+		return nil, err
+	}
+
+	return slo, nil
+}
+
+// ReadSLO reads an SLO by its id
+func (repo *SLORepository) ReadSLO(projID, clusterID, id uint) (*models.SLO, error) {
+	slo := &models.SLO{}
+
+	if err := repo.db.Where(
+		"project_id = ? AND cluster_id = ? AND id = ?",
+		projID, clusterID, id,
+	).First(slo).Error; err != nil {
+		return nil, err
+	}
+
+	return slo, nil
+}
+
+// ListSLOs lists the SLOs of a release
+func (repo *SLORepository) ListSLOs(projID, clusterID uint, releaseName, namespace string) ([]*models.SLO, error) {
+	slos := make([]*models.SLO, 0)
+
+	if err := repo.db.Where(
+		"project_id = ? AND cluster_id = ? AND release_name = ? AND namespace = ?",
+		projID, clusterID, releaseName, namespace,
+	).Order("id asc").Find(&slos).Error; err != nil {
+		return nil, err
+	}
+
+	return slos, nil
+}
+
+// UpdateSLO updates an SLO
+func (repo *SLORepository) UpdateSLO(slo *models.SLO) (*models.SLO, error) {
+	if err := repo.db.Save(slo).Error; err != nil {
+		return nil, err
+	}
+
+	return slo, nil
+}
+
+// DeleteSLO deletes an SLO
+func (repo *SLORepository) DeleteSLO(slo *models.SLO) error {
+	return repo.db.Delete(slo).Error
+}
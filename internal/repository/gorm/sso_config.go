@@ -0,0 +1,175 @@
+package gorm
+
+import (
+	"time"
+
+	"github.com/porter-dev/porter/internal/encryption"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SSOConfigRepository uses gorm.DB for querying the database
+type SSOConfigRepository struct {
+	db  *gorm.DB
+	key *[32]byte
+}
+
+// NewSSOConfigRepository returns a SSOConfigRepository which uses gorm.DB for querying the
+// database. It accepts an encryption key to encrypt sensitive data
+func NewSSOConfigRepository(db *gorm.DB, key *[32]byte) repository.SSOConfigRepository {
+	return &SSOConfigRepository{db, key}
+}
+
+// CreateSSOConfig creates a new SSO config, along with its domains and group mappings
+func (repo *SSOConfigRepository) CreateSSOConfig(config *models.SSOConfig) (*models.SSOConfig, error) {
+	if err := repo.encryptSSOConfigData(config); err != nil {
+		return nil, err
+	}
+
+	if err := repo.db.Create(config).Error; err != nil {
+		return nil, err
+	}
+
+	return config, repo.decryptSSOConfigData(config)
+}
+
+// ReadSSOConfig reads an SSO config of a project by id
+func (repo *SSOConfigRepository) ReadSSOConfig(projectID, configID uint) (*models.SSOConfig, error) {
+	return repo.readSSOConfig(repo.db.Where("project_id = ? AND id = ?", projectID, configID))
+}
+
+// ReadSSOConfigByID reads an SSO config by id, regardless of its project
+func (repo *SSOConfigRepository) ReadSSOConfigByID(configID uint) (*models.SSOConfig, error) {
+	return repo.readSSOConfig(repo.db.Where("id = ?", configID))
+}
+
+// ReadVerifiedSSOConfigByDomain reads the SSO config whose verified domains contain a domain
+func (repo *SSOConfigRepository) ReadVerifiedSSOConfigByDomain(domain string) (*models.SSOConfig, error) {
+	subQuery := repo.db.Model(&models.SSODomain{}).Where("domain = ?", domain).Select("sso_config_id")
+
+	return repo.readSSOConfig(repo.db.Where("id IN (?) AND domains_verified = ?", subQuery, true))
+}
+
+func (repo *SSOConfigRepository) readSSOConfig(query *gorm.DB) (*models.SSOConfig, error) {
+	config := &models.SSOConfig{}
+
+	if err := query.Preload("Domains").Preload("GroupMappings").First(config).Error; err != nil {
+		return nil, err
+	}
+
+	return config, repo.decryptSSOConfigData(config)
+}
+
+// ListSSOConfigs lists the SSO configs of a project
+func (repo *SSOConfigRepository) ListSSOConfigs(projectID uint) ([]*models.SSOConfig, error) {
+	configs := make([]*models.SSOConfig, 0)
+
+	if err := repo.db.Preload("Domains").Preload("GroupMappings").Where(
+		"project_id = ?",
+		projectID,
+	).Find(&configs).Error; err != nil {
+		return nil, err
+	}
+
+	for _, config := range configs {
+		if err := repo.decryptSSOConfigData(config); err != nil {
+			return nil, err
+		}
+	}
+
+	return configs, nil
+}
+
+// UpdateSSOConfig updates an SSO config, and replaces its domains and group mappings
+func (repo *SSOConfigRepository) UpdateSSOConfig(config *models.SSOConfig) (*models.SSOConfig, error) {
+	if err := repo.encryptSSOConfigData(config); err != nil {
+		return nil, err
+	}
+
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := repo.deleteAssociations(tx, config); err != nil {
+			return err
+		}
+
+		for i := range config.Domains {
+			config.Domains[i].ID = 0
+		}
+
+		for i := range config.GroupMappings {
+			config.GroupMappings[i].ID = 0
+		}
+
+		return tx.Save(config).Error
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return config, repo.decryptSSOConfigData(config)
+}
+
+// DeleteSSOConfig deletes an SSO config, along with its domains and group mappings
+func (repo *SSOConfigRepository) DeleteSSOConfig(config *models.SSOConfig) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := repo.deleteAssociations(tx, config); err != nil {
+			return err
+		}
+
+		return tx.Delete(config).Error
+	})
+}
+
+// CreateSSOUsedID records a used id, and returns false if the id was already used. Expired
+// ids are deleted, since they are rejected regardless of whether they were used.
+func (repo *SSOConfigRepository) CreateSSOUsedID(usedID *models.SSOUsedID) (bool, error) {
+	if err := repo.db.Where("expires_at < ?", time.Now()).Delete(&models.SSOUsedID{}).Error; err != nil {
+		return false, err
+	}
+
+	res := repo.db.Clauses(clause.OnConflict{DoNothing: true}).Create(usedID)
+
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	return res.RowsAffected == 1, nil
+}
+
+func (repo *SSOConfigRepository) deleteAssociations(tx *gorm.DB, config *models.SSOConfig) error {
+	if err := tx.Unscoped().Where("sso_config_id = ?", config.ID).Delete(&models.SSODomain{}).Error; err != nil {
+		return err
+	}
+
+	return tx.Unscoped().Where("sso_config_id = ?", config.ID).Delete(&models.SSOGroupMapping{}).Error
+}
+
+func (repo *SSOConfigRepository) encryptSSOConfigData(config *models.SSOConfig) error {
+	if len(config.OIDCClientSecret) > 0 {
+		cipherData, err := encryption.Encrypt(config.OIDCClientSecret, repo.key)
+
+		if err != nil {
+			return err
+		}
+
+		config.OIDCClientSecret = cipherData
+	}
+
+	return nil
+}
+
+func (repo *SSOConfigRepository) decryptSSOConfigData(config *models.SSOConfig) error {
+	if len(config.OIDCClientSecret) > 0 {
+		plaintext, err := encryption.Decrypt(config.OIDCClientSecret, repo.key)
+
+		if err != nil {
+			return err
+		}
+
+		config.OIDCClientSecret = plaintext
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package gorm
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// TeamRepository uses gorm.DB for querying the database
+type TeamRepository struct {
+	db *gorm.DB
+}
+
+// NewTeamRepository returns a TeamRepository which uses gorm.DB for querying the database
+func NewTeamRepository(db *gorm.DB) repository.TeamRepository {
+	return &TeamRepository{db}
+}
+
+// CreateTeam creates a new team in a project
+func (repo *TeamRepository) CreateTeam(team *models.Team) (*models.Team, error) {
+	if err := repo.db.Create(team).Error; err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
+
+// ReadTeam reads a team of a project by id, along with its members
+func (repo *TeamRepository) ReadTeam(projectID, teamID uint) (*models.Team, error) {
+	team := &models.Team{}
+
+	if err := repo.db.Preload("Members").Where(
+		"project_id = ? AND id = ?",
+		projectID, teamID,
+	).First(team).Error; err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
+
+// ListTeams lists the teams of a project, along with their members
+func (repo *TeamRepository) ListTeams(projectID uint) ([]*models.Team, error) {
+	teams := make([]*models.Team, 0)
+
+	if err := repo.db.Preload("Members").Where(
+		"project_id = ?",
+		projectID,
+	).Order("name asc").Find(&teams).Error; err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+// ListTeamsByUserID lists the teams of a project which a user is a member of
+func (repo *TeamRepository) ListTeamsByUserID(projectID, userID uint) ([]*models.Team, error) {
+	teams := make([]*models.Team, 0)
+
+	subQuery := repo.db.Model(&models.TeamMember{}).Where("user_id = ?", userID).Select("team_id")
+
+	if err := repo.db.Where(
+		"project_id = ? AND id IN (?)",
+		projectID, subQuery,
+	).Find(&teams).Error; err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+// UpdateTeam updates the name and the role kind of a team
+func (repo *TeamRepository) UpdateTeam(team *models.Team) (*models.Team, error) {
+	if err := repo.db.Omit("Members").Save(team).Error; err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
+
+// DeleteTeam deletes a team and its memberships
+func (repo *TeamRepository) DeleteTeam(team *models.Team) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("team_id = ?", team.ID).Delete(&models.TeamMember{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(team).Error
+	})
+}
+
+// AddTeamMember adds a user to a team, if the user is not already a member
+func (repo *TeamRepository) AddTeamMember(team *models.Team, userID uint) (*models.Team, error) {
+	member := &models.TeamMember{}
+
+	if err := repo.db.Where(models.TeamMember{
+		TeamID: team.ID,
+		UserID: userID,
+	}).FirstOrCreate(member).Error; err != nil {
+		return nil, err
+	}
+
+	return repo.ReadTeam(team.ProjectID, team.ID)
+}
+
+// RemoveTeamMember removes a user from a team
+func (repo *TeamRepository) RemoveTeamMember(team *models.Team, userID uint) (*models.Team, error) {
+	if err := repo.db.Where(
+		"team_id = ? AND user_id = ?",
+		team.ID, userID,
+	).Delete(&models.TeamMember{}).Error; err != nil {
+		return nil, err
+	}
+
+	return repo.ReadTeam(team.ProjectID, team.ID)
+}
+
+// RemoveUserFromTeams removes a user from all teams of a project
+func (repo *TeamRepository) RemoveUserFromTeams(projectID, userID uint) error {
+	subQuery := repo.db.Model(&models.Team{}).Where("project_id = ?", projectID).Select("id")
+
+	return repo.db.Where(
+		"user_id = ? AND team_id IN (?)",
+		userID, subQuery,
+	).Delete(&models.TeamMember{}).Error
+}
@@ -0,0 +1,11 @@
+package repository
+
+import "github.com/porter-dev/porter/internal/models"
+
+// ImagePolicyRepository represents the set of queries on the ImagePolicy model
+type ImagePolicyRepository interface {
+	CreateImagePolicy(policy *models.ImagePolicy) (*models.ImagePolicy, error)
+	ReadImagePolicy(projID, clusterID uint) (*models.ImagePolicy, error)
+	UpdateImagePolicy(policy *models.ImagePolicy) (*models.ImagePolicy, error)
+	DeleteImagePolicy(policy *models.ImagePolicy) error
+}
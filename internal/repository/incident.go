@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// IncidentRepository represents the set of queries on the Incident model
+type IncidentRepository interface {
+	CreateIncident(incident *models.Incident) (*models.Incident, error)
+	ReadIncident(projectID, clusterID, id uint) (*models.Incident, error)
+	ReadIncidentByAgentID(clusterID uint, agentIncidentID string) (*models.Incident, error)
+	ListIncidents(projectID, clusterID uint, filter *types.ListIncidentRecordsRequest) ([]*models.Incident, error)
+	UpdateIncident(incident *models.Incident) (*models.Incident, error)
+	AppendIncidentNote(incident *models.Incident, note *models.IncidentNote) (*models.IncidentNote, error)
+}
@@ -45,6 +45,14 @@ type GithubAppOAuthIntegrationRepository interface {
 	UpdateGithubAppOauthIntegration(am *ints.GithubAppOAuthIntegration) (*ints.GithubAppOAuthIntegration, error)
 }
 
+// GitlabIntegrationRepository represents the set of queries on a GitLab integration
+type GitlabIntegrationRepository interface {
+	CreateGitlabIntegration(gi *ints.GitlabIntegration) (*ints.GitlabIntegration, error)
+	ReadGitlabIntegration(projectID, id uint) (*ints.GitlabIntegration, error)
+	ListGitlabIntegrationsByProjectID(projectID uint) ([]*ints.GitlabIntegration, error)
+	UpdateGitlabIntegration(gi *ints.GitlabIntegration) (*ints.GitlabIntegration, error)
+}
+
 // SlackIntegrationRepository represents the set of queries on a Slack integration
 type SlackIntegrationRepository interface {
 	CreateSlackIntegration(slackInt *ints.SlackIntegration) (*ints.SlackIntegration, error)
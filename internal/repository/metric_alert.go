@@ -0,0 +1,13 @@
+package repository
+
+import "github.com/porter-dev/porter/internal/models"
+
+// MetricAlertRuleRepository represents the set of queries on the MetricAlertRule model
+type MetricAlertRuleRepository interface {
+	CreateMetricAlertRule(rule *models.MetricAlertRule) (*models.MetricAlertRule, error)
+	ReadMetricAlertRule(projID, clusterID, id uint) (*models.MetricAlertRule, error)
+	ListMetricAlertRules(projID, clusterID uint, releaseName, namespace string) ([]*models.MetricAlertRule, error)
+	ListEnabledMetricAlertRules() ([]*models.MetricAlertRule, error)
+	UpdateMetricAlertRule(rule *models.MetricAlertRule) (*models.MetricAlertRule, error)
+	DeleteMetricAlertRule(rule *models.MetricAlertRule) error
+}
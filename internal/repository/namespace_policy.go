@@ -0,0 +1,11 @@
+package repository
+
+import "github.com/porter-dev/porter/internal/models"
+
+// NamespacePolicyRepository represents the set of queries on the NamespacePolicy model
+type NamespacePolicyRepository interface {
+	CreateNamespacePolicy(policy *models.NamespacePolicy) (*models.NamespacePolicy, error)
+	ReadNamespacePolicy(projID, clusterID uint, namespace string) (*models.NamespacePolicy, error)
+	ListNamespacePolicies() ([]*models.NamespacePolicy, error)
+	UpdateNamespacePolicy(policy *models.NamespacePolicy) (*models.NamespacePolicy, error)
+}
@@ -14,5 +14,6 @@ type ReleaseRepository interface {
 	ReadReleaseByWebhookToken(token string) (*models.Release, error)
 	ListReleasesByImageRepoURI(clusterID uint, imageRepoURI string) ([]*models.Release, error)
 	UpdateRelease(release *models.Release) (*models.Release, error)
+	AttachEventContainer(releaseID, containerID uint) (uint, error)
 	DeleteRelease(release *models.Release) (*models.Release, error)
 }
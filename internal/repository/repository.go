@@ -37,4 +37,17 @@ type Repository interface {
 	BuildConfig() BuildConfigRepository
 	Allowlist() AllowlistRepository
 	Tag() TagRepository
+	DriftDetectionConfig() DriftDetectionConfigRepository
+	ClusterCostConfig() ClusterCostConfigRepository
+	NamespacePolicy() NamespacePolicyRepository
+	GitlabIntegration() GitlabIntegrationRepository
+	BuildAttestation() BuildAttestationRepository
+	ImagePolicy() ImagePolicyRepository
+	Incident() IncidentRepository
+	CustomMetric() CustomMetricRepository
+	MetricAlertRule() MetricAlertRuleRepository
+	SLO() SLORepository
+	DatabaseSnapshot() DatabaseSnapshotRepository
+	Team() TeamRepository
+	SSOConfig() SSOConfigRepository
 }
@@ -0,0 +1,12 @@
+package repository
+
+import "github.com/porter-dev/porter/internal/models"
+
+// SLORepository represents the set of queries on the SLO model
+type SLORepository interface {
+	CreateSLO(slo *models.SLO) (*models.SLO, error)
+	ReadSLO(projID, clusterID, id uint) (*models.SLO, error)
+	ListSLOs(projID, clusterID uint, releaseName, namespace string) ([]*models.SLO, error)
+	UpdateSLO(slo *models.SLO) (*models.SLO, error)
+	DeleteSLO(slo *models.SLO) error
+}
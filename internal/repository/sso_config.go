@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// SSOConfigRepository represents the set of queries on the SSOConfig model
+type SSOConfigRepository interface {
+	CreateSSOConfig(config *models.SSOConfig) (*models.SSOConfig, error)
+	ReadSSOConfig(projectID, configID uint) (*models.SSOConfig, error)
+	ReadSSOConfigByID(configID uint) (*models.SSOConfig, error)
+	ReadVerifiedSSOConfigByDomain(domain string) (*models.SSOConfig, error)
+	ListSSOConfigs(projectID uint) ([]*models.SSOConfig, error)
+	UpdateSSOConfig(config *models.SSOConfig) (*models.SSOConfig, error)
+	DeleteSSOConfig(config *models.SSOConfig) error
+	CreateSSOUsedID(usedID *models.SSOUsedID) (bool, error)
+}
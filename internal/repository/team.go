@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+)
+
+// TeamRepository represents the set of queries on the Team model
+type TeamRepository interface {
+	CreateTeam(team *models.Team) (*models.Team, error)
+	ReadTeam(projectID, teamID uint) (*models.Team, error)
+	ListTeams(projectID uint) ([]*models.Team, error)
+	ListTeamsByUserID(projectID, userID uint) ([]*models.Team, error)
+	UpdateTeam(team *models.Team) (*models.Team, error)
+	DeleteTeam(team *models.Team) error
+	AddTeamMember(team *models.Team, userID uint) (*models.Team, error)
+	RemoveTeamMember(team *models.Team, userID uint) (*models.Team, error)
+	RemoveUserFromTeams(projectID, userID uint) error
+}
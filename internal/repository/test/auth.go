@@ -606,3 +606,83 @@ func (repo *AzureIntegrationRepository) ListAzureIntegrationsByProjectID(
 ) ([]*ints.AzureIntegration, error) {
 	panic("unimplemented")
 }
+
+// GitlabIntegrationRepository implements repository.GitlabIntegrationRepository
+type GitlabIntegrationRepository struct {
+	canQuery           bool
+	gitlabIntegrations []*ints.GitlabIntegration
+}
+
+// NewGitlabIntegrationRepository will return errors if canQuery is false
+func NewGitlabIntegrationRepository(canQuery bool) repository.GitlabIntegrationRepository {
+	return &GitlabIntegrationRepository{
+		canQuery,
+		[]*ints.GitlabIntegration{},
+	}
+}
+
+// CreateGitlabIntegration creates a new GitLab integration
+func (repo *GitlabIntegrationRepository) CreateGitlabIntegration(
+	gi *ints.GitlabIntegration,
+) (*ints.GitlabIntegration, error) {
+	if !repo.canQuery {
+		return nil, errors.New("cannot write database")
+	}
+
+	repo.gitlabIntegrations = append(repo.gitlabIntegrations, gi)
+	gi.ID = uint(len(repo.gitlabIntegrations))
+
+	return gi, nil
+}
+
+// ReadGitlabIntegration finds a GitLab integration by id
+func (repo *GitlabIntegrationRepository) ReadGitlabIntegration(
+	projectID, id uint,
+) (*ints.GitlabIntegration, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	if int(id-1) >= len(repo.gitlabIntegrations) || repo.gitlabIntegrations[id-1] == nil ||
+		repo.gitlabIntegrations[id-1].ProjectID != projectID {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return repo.gitlabIntegrations[id-1], nil
+}
+
+// ListGitlabIntegrationsByProjectID finds all GitLab integrations for a given project id
+func (repo *GitlabIntegrationRepository) ListGitlabIntegrationsByProjectID(
+	projectID uint,
+) ([]*ints.GitlabIntegration, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*ints.GitlabIntegration, 0)
+
+	for _, gi := range repo.gitlabIntegrations {
+		if gi.ProjectID == projectID {
+			res = append(res, gi)
+		}
+	}
+
+	return res, nil
+}
+
+// UpdateGitlabIntegration updates a GitLab integration in the DB
+func (repo *GitlabIntegrationRepository) UpdateGitlabIntegration(
+	gi *ints.GitlabIntegration,
+) (*ints.GitlabIntegration, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(gi.ID-1) >= len(repo.gitlabIntegrations) || repo.gitlabIntegrations[gi.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.gitlabIntegrations[gi.ID-1] = gi
+
+	return gi, nil
+}
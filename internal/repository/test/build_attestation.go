@@ -0,0 +1,27 @@
+package test
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+)
+
+type BuildAttestationRepository struct{}
+
+func NewBuildAttestationRepository(canQuery bool) repository.BuildAttestationRepository {
+	return &BuildAttestationRepository{}
+}
+
+func (repo *BuildAttestationRepository) CreateBuildAttestation(attestation *models.BuildAttestation) (*models.BuildAttestation, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *BuildAttestationRepository) ListBuildAttestationsForRelease(projID, clusterID uint, name, namespace string) ([]*models.BuildAttestation, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *BuildAttestationRepository) ListBuildAttestationsForImage(
+	projID, clusterID uint,
+	name, namespace, imageRepoURI, tag string,
+) ([]*models.BuildAttestation, error) {
+	panic("not implemented") // TODO: Implement
+}
@@ -0,0 +1,24 @@
+package test
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+)
+
+type ClusterCostConfigRepository struct{}
+
+func NewClusterCostConfigRepository(canQuery bool) repository.ClusterCostConfigRepository {
+	return &ClusterCostConfigRepository{}
+}
+
+func (repo *ClusterCostConfigRepository) CreateClusterCostConfig(conf *models.ClusterCostConfig) (*models.ClusterCostConfig, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *ClusterCostConfigRepository) ReadClusterCostConfig(projID, clusterID uint) (*models.ClusterCostConfig, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *ClusterCostConfigRepository) UpdateClusterCostConfig(conf *models.ClusterCostConfig) (*models.ClusterCostConfig, error) {
+	panic("not implemented") // TODO: Implement
+}
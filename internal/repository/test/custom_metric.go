@@ -0,0 +1,32 @@
+package test
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+)
+
+type CustomMetricRepository struct{}
+
+func NewCustomMetricRepository(canQuery bool) repository.CustomMetricRepository {
+	return &CustomMetricRepository{}
+}
+
+func (repo *CustomMetricRepository) CreateCustomMetric(metric *models.CustomMetric) (*models.CustomMetric, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *CustomMetricRepository) ReadCustomMetric(projID, clusterID uint, releaseName, namespace, name string) (*models.CustomMetric, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *CustomMetricRepository) ListCustomMetrics(projID, clusterID uint, releaseName, namespace string) ([]*models.CustomMetric, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *CustomMetricRepository) UpdateCustomMetric(metric *models.CustomMetric) (*models.CustomMetric, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *CustomMetricRepository) DeleteCustomMetric(metric *models.CustomMetric) error {
+	panic("not implemented") // TODO: Implement
+}
@@ -0,0 +1,32 @@
+package test
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+)
+
+type DatabaseSnapshotRepository struct{}
+
+func NewDatabaseSnapshotRepository(canQuery bool) repository.DatabaseSnapshotRepository {
+	return &DatabaseSnapshotRepository{}
+}
+
+func (repo *DatabaseSnapshotRepository) CreateDatabaseSnapshot(snapshot *models.DatabaseSnapshot) (*models.DatabaseSnapshot, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *DatabaseSnapshotRepository) ReadDatabaseSnapshot(projectID, databaseID, snapshotID uint) (*models.DatabaseSnapshot, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *DatabaseSnapshotRepository) ReadDatabaseSnapshotByOperationUID(infraID uint, operationUID string) (*models.DatabaseSnapshot, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *DatabaseSnapshotRepository) ListDatabaseSnapshots(projectID, databaseID uint) ([]*models.DatabaseSnapshot, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *DatabaseSnapshotRepository) UpdateDatabaseSnapshot(snapshot *models.DatabaseSnapshot) (*models.DatabaseSnapshot, error) {
+	panic("not implemented") // TODO: Implement
+}
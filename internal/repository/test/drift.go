@@ -0,0 +1,28 @@
+package test
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+)
+
+type DriftDetectionConfigRepository struct{}
+
+func NewDriftDetectionConfigRepository(canQuery bool) repository.DriftDetectionConfigRepository {
+	return &DriftDetectionConfigRepository{}
+}
+
+func (repo *DriftDetectionConfigRepository) CreateDriftDetectionConfig(conf *models.DriftDetectionConfig) (*models.DriftDetectionConfig, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *DriftDetectionConfigRepository) ReadDriftDetectionConfig(projID, clusterID uint, name, namespace string) (*models.DriftDetectionConfig, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *DriftDetectionConfigRepository) ListEnabledDriftDetectionConfigs() ([]*models.DriftDetectionConfig, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *DriftDetectionConfigRepository) UpdateDriftDetectionConfig(conf *models.DriftDetectionConfig) (*models.DriftDetectionConfig, error) {
+	panic("not implemented") // TODO: Implement
+}
@@ -0,0 +1,28 @@
+package test
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+)
+
+type ImagePolicyRepository struct{}
+
+func NewImagePolicyRepository(canQuery bool) repository.ImagePolicyRepository {
+	return &ImagePolicyRepository{}
+}
+
+func (repo *ImagePolicyRepository) CreateImagePolicy(policy *models.ImagePolicy) (*models.ImagePolicy, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *ImagePolicyRepository) ReadImagePolicy(projID, clusterID uint) (*models.ImagePolicy, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *ImagePolicyRepository) UpdateImagePolicy(policy *models.ImagePolicy) (*models.ImagePolicy, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *ImagePolicyRepository) DeleteImagePolicy(policy *models.ImagePolicy) error {
+	panic("not implemented") // TODO: Implement
+}
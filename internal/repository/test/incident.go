@@ -0,0 +1,40 @@
+package test
+
+import (
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+)
+
+type IncidentRepository struct{}
+
+func NewIncidentRepository(canQuery bool) repository.IncidentRepository {
+	return &IncidentRepository{}
+}
+
+func (repo *IncidentRepository) CreateIncident(incident *models.Incident) (*models.Incident, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *IncidentRepository) ReadIncident(projectID, clusterID, id uint) (*models.Incident, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *IncidentRepository) ReadIncidentByAgentID(clusterID uint, agentIncidentID string) (*models.Incident, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *IncidentRepository) ListIncidents(
+	projectID, clusterID uint,
+	filter *types.ListIncidentRecordsRequest,
+) ([]*models.Incident, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *IncidentRepository) UpdateIncident(incident *models.Incident) (*models.Incident, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *IncidentRepository) AppendIncidentNote(incident *models.Incident, note *models.IncidentNote) (*models.IncidentNote, error) {
+	panic("not implemented") // TODO: Implement
+}
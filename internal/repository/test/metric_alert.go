@@ -0,0 +1,36 @@
+package test
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+)
+
+type MetricAlertRuleRepository struct{}
+
+func NewMetricAlertRuleRepository(canQuery bool) repository.MetricAlertRuleRepository {
+	return &MetricAlertRuleRepository{}
+}
+
+func (repo *MetricAlertRuleRepository) CreateMetricAlertRule(rule *models.MetricAlertRule) (*models.MetricAlertRule, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *MetricAlertRuleRepository) ReadMetricAlertRule(projID, clusterID, id uint) (*models.MetricAlertRule, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *MetricAlertRuleRepository) ListMetricAlertRules(projID, clusterID uint, releaseName, namespace string) ([]*models.MetricAlertRule, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *MetricAlertRuleRepository) ListEnabledMetricAlertRules() ([]*models.MetricAlertRule, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *MetricAlertRuleRepository) UpdateMetricAlertRule(rule *models.MetricAlertRule) (*models.MetricAlertRule, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *MetricAlertRuleRepository) DeleteMetricAlertRule(rule *models.MetricAlertRule) error {
+	panic("not implemented") // TODO: Implement
+}
@@ -0,0 +1,28 @@
+package test
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+)
+
+type NamespacePolicyRepository struct{}
+
+func NewNamespacePolicyRepository(canQuery bool) repository.NamespacePolicyRepository {
+	return &NamespacePolicyRepository{}
+}
+
+func (repo *NamespacePolicyRepository) CreateNamespacePolicy(policy *models.NamespacePolicy) (*models.NamespacePolicy, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *NamespacePolicyRepository) ReadNamespacePolicy(projID, clusterID uint, namespace string) (*models.NamespacePolicy, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *NamespacePolicyRepository) ListNamespacePolicies() ([]*models.NamespacePolicy, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *NamespacePolicyRepository) UpdateNamespacePolicy(policy *models.NamespacePolicy) (*models.NamespacePolicy, error) {
+	panic("not implemented") // TODO: Implement
+}
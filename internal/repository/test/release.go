@@ -111,6 +111,25 @@ func (repo *ReleaseRepository) UpdateRelease(
 	return release, nil
 }
 
+// AttachEventContainer sets the event container of a release if it does not have one yet
+func (repo *ReleaseRepository) AttachEventContainer(releaseID, containerID uint) (uint, error) {
+	if !repo.canQuery {
+		return 0, errors.New("Cannot write database")
+	}
+
+	if int(releaseID-1) >= len(repo.releases) || repo.releases[releaseID-1] == nil {
+		return 0, gorm.ErrRecordNotFound
+	}
+
+	release := repo.releases[releaseID-1]
+
+	if release.EventContainer == 0 {
+		release.EventContainer = containerID
+	}
+
+	return release.EventContainer, nil
+}
+
 // DeleteRelease removes a release from the array by setting it to nil
 func (repo *ReleaseRepository) DeleteRelease(
 	release *models.Release,
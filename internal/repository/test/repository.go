@@ -41,6 +41,19 @@ type TestRepository struct {
 	database                  repository.DatabaseRepository
 	allowlist                 repository.AllowlistRepository
 	tag                       repository.TagRepository
+	driftDetectionConfig      repository.DriftDetectionConfigRepository
+	clusterCostConfig         repository.ClusterCostConfigRepository
+	namespacePolicy           repository.NamespacePolicyRepository
+	gitlabIntegration         repository.GitlabIntegrationRepository
+	buildAttestation          repository.BuildAttestationRepository
+	imagePolicy               repository.ImagePolicyRepository
+	incident                  repository.IncidentRepository
+	customMetric              repository.CustomMetricRepository
+	metricAlertRule           repository.MetricAlertRuleRepository
+	slo                       repository.SLORepository
+	databaseSnapshot          repository.DatabaseSnapshotRepository
+	team                      repository.TeamRepository
+	ssoConfig                 repository.SSOConfigRepository
 }
 
 func (t *TestRepository) User() repository.UserRepository {
@@ -189,6 +202,58 @@ func (t *TestRepository) Tag() repository.TagRepository {
 
 // NewRepository returns a Repository which persists users in memory
 // and accepts a parameter that can trigger read/write errors
+func (t *TestRepository) DriftDetectionConfig() repository.DriftDetectionConfigRepository {
+	return t.driftDetectionConfig
+}
+
+func (t *TestRepository) ClusterCostConfig() repository.ClusterCostConfigRepository {
+	return t.clusterCostConfig
+}
+
+func (t *TestRepository) NamespacePolicy() repository.NamespacePolicyRepository {
+	return t.namespacePolicy
+}
+
+func (t *TestRepository) GitlabIntegration() repository.GitlabIntegrationRepository {
+	return t.gitlabIntegration
+}
+
+func (t *TestRepository) BuildAttestation() repository.BuildAttestationRepository {
+	return t.buildAttestation
+}
+
+func (t *TestRepository) ImagePolicy() repository.ImagePolicyRepository {
+	return t.imagePolicy
+}
+
+func (t *TestRepository) Incident() repository.IncidentRepository {
+	return t.incident
+}
+
+func (t *TestRepository) CustomMetric() repository.CustomMetricRepository {
+	return t.customMetric
+}
+
+func (t *TestRepository) MetricAlertRule() repository.MetricAlertRuleRepository {
+	return t.metricAlertRule
+}
+
+func (t *TestRepository) SLO() repository.SLORepository {
+	return t.slo
+}
+
+func (t *TestRepository) DatabaseSnapshot() repository.DatabaseSnapshotRepository {
+	return t.databaseSnapshot
+}
+
+func (t *TestRepository) Team() repository.TeamRepository {
+	return t.team
+}
+
+func (t *TestRepository) SSOConfig() repository.SSOConfigRepository {
+	return t.ssoConfig
+}
+
 func NewRepository(canQuery bool, failingMethods ...string) repository.Repository {
 	return &TestRepository{
 		user:                      NewUserRepository(canQuery, failingMethods...),
@@ -227,5 +292,18 @@ func NewRepository(canQuery bool, failingMethods ...string) repository.Repositor
 		database:                  NewDatabaseRepository(),
 		allowlist:                 NewAllowlistRepository(canQuery),
 		tag:                       NewTagRepository(),
+		driftDetectionConfig:      NewDriftDetectionConfigRepository(canQuery),
+		clusterCostConfig:         NewClusterCostConfigRepository(canQuery),
+		namespacePolicy:           NewNamespacePolicyRepository(canQuery),
+		gitlabIntegration:         NewGitlabIntegrationRepository(canQuery),
+		buildAttestation:          NewBuildAttestationRepository(canQuery),
+		imagePolicy:               NewImagePolicyRepository(canQuery),
+		incident:                  NewIncidentRepository(canQuery),
+		customMetric:              NewCustomMetricRepository(canQuery),
+		metricAlertRule:           NewMetricAlertRuleRepository(canQuery),
+		slo:                       NewSLORepository(canQuery),
+		databaseSnapshot:          NewDatabaseSnapshotRepository(canQuery),
+		team:                      NewTeamRepository(canQuery),
+		ssoConfig:                 NewSSOConfigRepository(canQuery),
 	}
 }
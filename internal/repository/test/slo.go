@@ -0,0 +1,32 @@
+package test
+
+import (
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+)
+
+type SLORepository struct{}
+
+func NewSLORepository(canQuery bool) repository.SLORepository {
+	return &SLORepository{}
+}
+
+func (repo *SLORepository) CreateSLO(slo *models.SLO) (*models.SLO, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *SLORepository) ReadSLO(projID, clusterID, id uint) (*models.SLO, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *SLORepository) ListSLOs(projID, clusterID uint, releaseName, namespace string) ([]*models.SLO, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *SLORepository) UpdateSLO(slo *models.SLO) (*models.SLO, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (repo *SLORepository) DeleteSLO(slo *models.SLO) error {
+	panic("not implemented") // TODO: Implement
+}
@@ -0,0 +1,138 @@
+package test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// SSOConfigRepository will return errors on queries if canQuery is false and only stores
+// SSO configs in-memory, indexed by their array index + 1
+type SSOConfigRepository struct {
+	canQuery bool
+	configs  []*models.SSOConfig
+	usedIDs  map[string]time.Time
+}
+
+// NewSSOConfigRepository will return errors if canQuery is false
+func NewSSOConfigRepository(canQuery bool) repository.SSOConfigRepository {
+	return &SSOConfigRepository{canQuery, []*models.SSOConfig{}, make(map[string]time.Time)}
+}
+
+func (repo *SSOConfigRepository) CreateSSOConfig(config *models.SSOConfig) (*models.SSOConfig, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.configs = append(repo.configs, config)
+	config.ID = uint(len(repo.configs))
+
+	return config, nil
+}
+
+func (repo *SSOConfigRepository) ReadSSOConfig(projectID, configID uint) (*models.SSOConfig, error) {
+	config, err := repo.ReadSSOConfigByID(configID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if config.ProjectID != projectID {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return config, nil
+}
+
+func (repo *SSOConfigRepository) ReadSSOConfigByID(configID uint) (*models.SSOConfig, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	if configID == 0 || int(configID-1) >= len(repo.configs) || repo.configs[configID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return repo.configs[configID-1], nil
+}
+
+func (repo *SSOConfigRepository) ReadVerifiedSSOConfigByDomain(domain string) (*models.SSOConfig, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	for _, config := range repo.configs {
+		if config == nil || !config.DomainsVerified {
+			continue
+		}
+
+		for _, d := range config.Domains {
+			if d.Domain == domain {
+				return config, nil
+			}
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (repo *SSOConfigRepository) ListSSOConfigs(projectID uint) ([]*models.SSOConfig, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.SSOConfig, 0)
+
+	for _, config := range repo.configs {
+		if config != nil && config.ProjectID == projectID {
+			res = append(res, config)
+		}
+	}
+
+	return res, nil
+}
+
+func (repo *SSOConfigRepository) UpdateSSOConfig(config *models.SSOConfig) (*models.SSOConfig, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if config.ID == 0 || int(config.ID-1) >= len(repo.configs) || repo.configs[config.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.configs[config.ID-1] = config
+
+	return config, nil
+}
+
+func (repo *SSOConfigRepository) DeleteSSOConfig(config *models.SSOConfig) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if config.ID == 0 || int(config.ID-1) >= len(repo.configs) || repo.configs[config.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.configs[config.ID-1] = nil
+
+	return nil
+}
+
+func (repo *SSOConfigRepository) CreateSSOUsedID(usedID *models.SSOUsedID) (bool, error) {
+	if !repo.canQuery {
+		return false, errors.New("Cannot write database")
+	}
+
+	if expiry, ok := repo.usedIDs[usedID.ID]; ok && !expiry.Before(time.Now()) {
+		return false, nil
+	}
+
+	repo.usedIDs[usedID.ID] = usedID.ExpiresAt
+
+	return true, nil
+}
@@ -0,0 +1,163 @@
+package test
+
+import (
+	"errors"
+
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/internal/repository"
+	"gorm.io/gorm"
+)
+
+// TeamRepository will return errors on queries if canQuery is false and only stores
+// teams in-memory, indexed by their array index + 1
+type TeamRepository struct {
+	canQuery bool
+	teams    []*models.Team
+}
+
+// NewTeamRepository will return errors if canQuery is false
+func NewTeamRepository(canQuery bool) repository.TeamRepository {
+	return &TeamRepository{canQuery, []*models.Team{}}
+}
+
+func (repo *TeamRepository) CreateTeam(team *models.Team) (*models.Team, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	repo.teams = append(repo.teams, team)
+	team.ID = uint(len(repo.teams))
+
+	return team, nil
+}
+
+func (repo *TeamRepository) ReadTeam(projectID, teamID uint) (*models.Team, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	if int(teamID-1) >= len(repo.teams) || repo.teams[teamID-1] == nil ||
+		repo.teams[teamID-1].ProjectID != projectID {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return repo.teams[teamID-1], nil
+}
+
+func (repo *TeamRepository) ListTeams(projectID uint) ([]*models.Team, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot read from database")
+	}
+
+	res := make([]*models.Team, 0)
+
+	for _, team := range repo.teams {
+		if team != nil && team.ProjectID == projectID {
+			res = append(res, team)
+		}
+	}
+
+	return res, nil
+}
+
+func (repo *TeamRepository) ListTeamsByUserID(projectID, userID uint) ([]*models.Team, error) {
+	teams, err := repo.ListTeams(projectID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*models.Team, 0)
+
+	for _, team := range teams {
+		for _, member := range team.Members {
+			if member.UserID == userID {
+				res = append(res, team)
+				break
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (repo *TeamRepository) UpdateTeam(team *models.Team) (*models.Team, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	if int(team.ID-1) >= len(repo.teams) || repo.teams[team.ID-1] == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	repo.teams[team.ID-1] = team
+
+	return team, nil
+}
+
+func (repo *TeamRepository) DeleteTeam(team *models.Team) error {
+	if !repo.canQuery {
+		return errors.New("Cannot write database")
+	}
+
+	if int(team.ID-1) >= len(repo.teams) || repo.teams[team.ID-1] == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	repo.teams[team.ID-1] = nil
+
+	return nil
+}
+
+func (repo *TeamRepository) AddTeamMember(team *models.Team, userID uint) (*models.Team, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	for _, member := range team.Members {
+		if member.UserID == userID {
+			return team, nil
+		}
+	}
+
+	team.Members = append(team.Members, models.TeamMember{
+		TeamID: team.ID,
+		UserID: userID,
+	})
+
+	return repo.UpdateTeam(team)
+}
+
+func (repo *TeamRepository) RemoveTeamMember(team *models.Team, userID uint) (*models.Team, error) {
+	if !repo.canQuery {
+		return nil, errors.New("Cannot write database")
+	}
+
+	members := make([]models.TeamMember, 0)
+
+	for _, member := range team.Members {
+		if member.UserID != userID {
+			members = append(members, member)
+		}
+	}
+
+	team.Members = members
+
+	return repo.UpdateTeam(team)
+}
+
+func (repo *TeamRepository) RemoveUserFromTeams(projectID, userID uint) error {
+	teams, err := repo.ListTeamsByUserID(projectID, userID)
+
+	if err != nil {
+		return err
+	}
+
+	for _, team := range teams {
+		if _, err := repo.RemoveTeamMember(team, userID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package slo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/helm/grapher"
+	"github.com/porter-dev/porter/internal/kubernetes/prometheus"
+	"github.com/porter-dev/porter/internal/models"
+	"helm.sh/helm/v3/pkg/release"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Validate checks the definition of an SLO of a release and sets the default window
+func Validate(slo *models.SLO, helmRelease *release.Release) error {
+	if _, err := getIngressRegex(helmRelease); err != nil {
+		return err
+	}
+
+	if slo.WindowDays == 0 {
+		slo.WindowDays = types.DefaultSLOWindowDays
+	}
+
+	switch slo.Kind {
+	case types.SLOKindAvailability:
+		slo.LatencyThresholdMS = 0
+	case types.SLOKindLatency:
+		if slo.LatencyThresholdMS == 0 {
+			return fmt.Errorf("latency_threshold_ms is required for latency objectives")
+		}
+	default:
+		return fmt.Errorf("unsupported SLO kind %s", slo.Kind)
+	}
+
+	return nil
+}
+
+// getIngressRegex returns a regex matching the ingresses of a release
+func getIngressRegex(helmRelease *release.Release) (string, error) {
+	ingresses := make([]string, 0)
+
+	yamlArr := grapher.ImportMultiDocYAML([]byte(helmRelease.Manifest))
+
+	for _, obj := range grapher.ParseObjs(yamlArr, helmRelease.Namespace) {
+		if obj.Kind == "Ingress" {
+			ingresses = append(ingresses, obj.Name)
+		}
+	}
+
+	if len(ingresses) == 0 {
+		return "", fmt.Errorf("release %s has no ingresses to compute objectives for", helmRelease.Name)
+	}
+
+	return strings.Join(ingresses, "|"), nil
+}
+
+// GetStatus computes the error budget and the burn rates of an SLO from the nginx series of
+// the release's ingresses
+func GetStatus(
+	clientset kubernetes.Interface,
+	service *v1.Service,
+	slo *models.SLO,
+	helmRelease *release.Release,
+	now time.Time,
+) (*types.SLOStatus, error) {
+	ingressRegex, err := getIngressRegex(helmRelease)
+
+	if err != nil {
+		return nil, err
+	}
+
+	getCounts := func(window time.Duration) (*prometheus.RequestCounts, error) {
+		return prometheus.GetErrorCounts(clientset, service, helmRelease.Namespace, ingressRegex, window)
+	}
+
+	if slo.Kind == types.SLOKindLatency {
+		bucket, err := prometheus.GetLatencyBucket(
+			clientset, service, helmRelease.Namespace, ingressRegex,
+			time.Duration(slo.LatencyThresholdMS)*time.Millisecond,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		getCounts = func(window time.Duration) (*prometheus.RequestCounts, error) {
+			return prometheus.GetLatencyCounts(clientset, service, helmRelease.Namespace, ingressRegex, bucket, window)
+		}
+	}
+
+	windowCounts, err := getCounts(time.Duration(slo.WindowDays) * 24 * time.Hour)
+
+	if err != nil {
+		return nil, err
+	}
+
+	oneHourCounts, err := getCounts(time.Hour)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sixHourCounts, err := getCounts(6 * time.Hour)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return computeStatus(slo, windowCounts, oneHourCounts, sixHourCounts, now), nil
+}
+
+func computeStatus(
+	slo *models.SLO,
+	windowCounts, oneHourCounts, sixHourCounts *prometheus.RequestCounts,
+	now time.Time,
+) *types.SLOStatus {
+	// the error budget is the fraction of requests which are allowed to be bad
+	budget := 1 - slo.Objective/100
+
+	res := &types.SLOStatus{
+		SLO:                  slo.ToSLOType(),
+		TotalRequests:        windowCounts.Total,
+		ErrorBudgetRemaining: 1,
+		EvaluatedAt:          now,
+	}
+
+	if windowCounts.Total > 0 {
+		errorRatio := windowCounts.Bad / windowCounts.Total
+		compliance := (1 - errorRatio) * 100
+
+		res.Compliance = &compliance
+		res.ErrorBudgetRemaining = 1 - errorRatio/budget
+		res.BudgetExhausted = res.ErrorBudgetRemaining <= 0
+	}
+
+	res.BurnRate1h = getBurnRate(oneHourCounts, budget)
+	res.BurnRate6h = getBurnRate(sixHourCounts, budget)
+
+	res.Burning = (res.BurnRate1h != nil && *res.BurnRate1h >= types.FastBurnRateThreshold) ||
+		(res.BurnRate6h != nil && *res.BurnRate6h >= types.SlowBurnRateThreshold)
+
+	return res
+}
+
+// getBurnRate returns how many times faster than sustainable the error budget was spent, which
+// is nil if there were no requests
+func getBurnRate(counts *prometheus.RequestCounts, budget float64) *float64 {
+	if counts.Total == 0 {
+		return nil
+	}
+
+	rate := (counts.Bad / counts.Total) / budget
+
+	return &rate
+}
@@ -0,0 +1,70 @@
+package slo
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes/prometheus"
+	"github.com/porter-dev/porter/internal/models"
+)
+
+func TestComputeStatus(t *testing.T) {
+	slo := &models.SLO{
+		Kind:       types.SLOKindAvailability,
+		Objective:  99,
+		WindowDays: 30,
+	}
+
+	now := time.Now()
+
+	// 0.5% of requests failed in the window, which is half of the 1% budget
+	status := computeStatus(
+		slo,
+		&prometheus.RequestCounts{Total: 10000, Bad: 50},
+		&prometheus.RequestCounts{Total: 100, Bad: 20},
+		&prometheus.RequestCounts{Total: 600, Bad: 6},
+		now,
+	)
+
+	if math.Abs(status.ErrorBudgetRemaining-0.5) > 1e-9 {
+		t.Errorf("expected half of the budget to remain, got %f", status.ErrorBudgetRemaining)
+	}
+
+	if status.BudgetExhausted {
+		t.Errorf("expected the budget not to be exhausted")
+	}
+
+	if status.Compliance == nil || math.Abs(*status.Compliance-99.5) > 1e-9 {
+		t.Errorf("expected compliance of 99.5%%, got %v", status.Compliance)
+	}
+
+	if status.BurnRate1h == nil || math.Abs(*status.BurnRate1h-20) > 1e-9 {
+		t.Errorf("expected a 1h burn rate of 20, got %v", status.BurnRate1h)
+	}
+
+	if status.BurnRate6h == nil || math.Abs(*status.BurnRate6h-1) > 1e-9 {
+		t.Errorf("expected a 6h burn rate of 1, got %v", status.BurnRate6h)
+	}
+
+	if !status.Burning {
+		t.Errorf("expected the fast burn rate to be detected")
+	}
+
+	status = computeStatus(
+		slo,
+		&prometheus.RequestCounts{Total: 1000, Bad: 20},
+		&prometheus.RequestCounts{},
+		&prometheus.RequestCounts{},
+		now,
+	)
+
+	if !status.BudgetExhausted || status.ErrorBudgetRemaining >= 0 {
+		t.Errorf("expected the budget to be overspent, got %f", status.ErrorBudgetRemaining)
+	}
+
+	if status.BurnRate1h != nil || status.Burning {
+		t.Errorf("expected no burn rate without requests")
+	}
+}
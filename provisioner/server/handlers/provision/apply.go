@@ -122,7 +122,7 @@ func (c *ProvisionApplyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	// update the infrastructure as either "updating" or "creating"
 	if req.OperationKind == "create" || req.OperationKind == "retry_create" {
 		infra.Status = types.InfraStatus("creating")
-	} else if req.OperationKind == "update" {
+	} else if req.OperationKind == "update" || req.OperationKind == "snapshot" || req.OperationKind == "restore" {
 		infra.Status = types.InfraStatus("updating")
 	}
 
@@ -2,20 +2,16 @@ package state
 
 import (
 	"encoding/base64"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/porter-dev/porter/api/server/shared"
 	"github.com/porter-dev/porter/api/server/shared/apierrors"
 	"github.com/porter-dev/porter/api/types"
 	"github.com/porter-dev/porter/internal/analytics"
-	"github.com/porter-dev/porter/internal/kubernetes"
-	"github.com/porter-dev/porter/internal/kubernetes/envgroup"
 	"github.com/porter-dev/porter/internal/models"
 	"github.com/porter-dev/porter/provisioner/integrations/redis_stream"
 	"github.com/porter-dev/porter/provisioner/server/config"
@@ -102,8 +98,8 @@ func (c *CreateResourceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		}
 	case string(types.InfraECR):
 		_, err = createECRRegistry(c.Config, infra, operation, req.Output)
-	case string(types.InfraRDS):
-		_, err = createRDSDatabase(c.Config, infra, operation, req.Output)
+	case string(types.InfraRDS), string(types.InfraCloudSQL), string(types.InfraDOPG):
+		_, err = createDatabase(c.Config, infra, operation, req.Output)
 	case string(types.InfraDOCR):
 		_, err = createDOCRRegistry(c.Config, infra, operation, req.Output)
 	case string(types.InfraGCR):
@@ -158,65 +154,6 @@ func createECRRegistry(config *config.Config, infra *models.Infra, operation *mo
 	return reg, nil
 }
 
-func createRDSDatabase(config *config.Config, infra *models.Infra, operation *models.Operation, output map[string]interface{}) (*models.Database, error) {
-	// check for infra id being 0 as a safeguard so that all non-provisioned
-	// clusters are not matched by read
-	if infra.ID == 0 {
-		return nil, fmt.Errorf("infra id cannot be 0")
-	}
-
-	var database *models.Database
-	var err error
-	var isNotFound bool
-
-	database, err = config.Repo.Database().ReadDatabaseByInfraID(infra.ProjectID, infra.ID)
-
-	isNotFound = err != nil && errors.Is(err, gorm.ErrRecordNotFound)
-
-	if isNotFound {
-		database = &models.Database{
-			ProjectID: infra.ProjectID,
-			ClusterID: infra.ParentClusterID,
-			InfraID:   infra.ID,
-			Status:    "Running",
-		}
-	} else if err != nil {
-		return nil, err
-	}
-
-	database.InstanceID = output["rds_instance_id"].(string)
-	database.InstanceEndpoint = output["rds_connection_endpoint"].(string)
-	database.InstanceName = output["rds_instance_name"].(string)
-
-	if isNotFound {
-		database, err = config.Repo.Database().CreateDatabase(database)
-	} else {
-		database, err = config.Repo.Database().UpdateDatabase(database)
-	}
-
-	infra.DatabaseID = database.ID
-	infra, err = config.Repo.Infra().UpdateInfra(infra)
-
-	if err != nil {
-		return nil, err
-	}
-	lastApplied := make(map[string]interface{})
-
-	err = json.Unmarshal(operation.LastApplied, &lastApplied)
-
-	if err != nil {
-		return nil, err
-	}
-
-	err = createRDSEnvGroup(config, infra, database, lastApplied)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return database, nil
-}
-
 func createCluster(config *config.Config, infra *models.Infra, operation *models.Operation, output map[string]interface{}) (*models.Cluster, error) {
 	// check for infra id being 0 as a safeguard so that all non-provisioned
 	// clusters are not matched by read
@@ -359,78 +296,3 @@ func createACRRegistry(config *config.Config, infra *models.Infra, operation *mo
 
 	return config.Repo.Registry().CreateRegistry(reg)
 }
-
-func createRDSEnvGroup(config *config.Config, infra *models.Infra, database *models.Database, lastApplied map[string]interface{}) error {
-	cluster, err := config.Repo.Cluster().ReadCluster(infra.ProjectID, infra.ParentClusterID)
-
-	if err != nil {
-		return err
-	}
-
-	ooc := &kubernetes.OutOfClusterConfig{
-		Repo:              config.Repo,
-		DigitalOceanOAuth: config.DOConf,
-		Cluster:           cluster,
-	}
-
-	agent, err := kubernetes.GetAgentOutOfClusterConfig(ooc)
-
-	if err != nil {
-		return fmt.Errorf("failed to get agent: %s", err.Error())
-	}
-
-	// split the instance endpoint on the port
-	port := "5432"
-	host := database.InstanceEndpoint
-
-	if strArr := strings.Split(database.InstanceEndpoint, ":"); len(strArr) == 2 {
-		host = strArr[0]
-		port = strArr[1]
-	}
-
-	_, err = envgroup.CreateEnvGroup(agent, types.ConfigMapInput{
-		Name:      fmt.Sprintf("rds-credentials-%s", lastApplied["db_name"].(string)),
-		Namespace: "default",
-		Variables: map[string]string{},
-		SecretVariables: map[string]string{
-			"PGPORT":     port,
-			"PGHOST":     host,
-			"PGPASSWORD": lastApplied["db_passwd"].(string),
-			"PGUSER":     lastApplied["db_user"].(string),
-		},
-	})
-
-	if err != nil {
-		return fmt.Errorf("failed to create RDS env group: %s", err.Error())
-	}
-
-	return nil
-}
-
-func deleteRDSEnvGroup(config *config.Config, infra *models.Infra, lastApplied map[string]interface{}) error {
-	cluster, err := config.Repo.Cluster().ReadCluster(infra.ProjectID, infra.ParentClusterID)
-
-	if err != nil {
-		return err
-	}
-
-	ooc := &kubernetes.OutOfClusterConfig{
-		Repo:              config.Repo,
-		DigitalOceanOAuth: config.DOConf,
-		Cluster:           cluster,
-	}
-
-	agent, err := kubernetes.GetAgentOutOfClusterConfig(ooc)
-
-	if err != nil {
-		return fmt.Errorf("failed to get agent: %s", err.Error())
-	}
-
-	err = envgroup.DeleteEnvGroup(agent, fmt.Sprintf("rds-credentials-%s", lastApplied["db_name"].(string)), "default")
-
-	if err != nil {
-		return fmt.Errorf("failed to create RDS env group: %s", err.Error())
-	}
-
-	return nil
-}
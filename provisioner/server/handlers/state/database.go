@@ -0,0 +1,373 @@
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/porter-dev/porter/api/types"
+	"github.com/porter-dev/porter/internal/kubernetes"
+	"github.com/porter-dev/porter/internal/kubernetes/envgroup"
+	"github.com/porter-dev/porter/internal/models"
+	"github.com/porter-dev/porter/provisioner/server/config"
+	"gorm.io/gorm"
+)
+
+// the operation kinds which create and restore database snapshots
+const (
+	operationSnapshot = "snapshot"
+	operationRestore  = "restore"
+)
+
+// restoreSnapshotValue is the value which sets the snapshot a restore operation restores from
+const restoreSnapshotValue = "restore_snapshot"
+
+// defaultDatabasePorts are the ports the connection endpoint of a database defaults to when
+// the provider does not output a port
+var defaultDatabasePorts = map[types.InfraKind]string{
+	types.InfraRDS:      "5432",
+	types.InfraCloudSQL: "5432",
+	types.InfraDOPG:     "25060",
+}
+
+// databaseOutput is the output of a database module. Every database module outputs the id,
+// the name and the connection endpoint of the instance with the kind of the infra as a prefix,
+// for example "rds_instance_id" or "cloudsql_instance_id".
+type databaseOutput struct {
+	InstanceID       string
+	InstanceName     string
+	InstanceEndpoint string
+}
+
+func getDatabaseOutput(kind types.InfraKind, output map[string]interface{}) (*databaseOutput, error) {
+	res := &databaseOutput{}
+
+	fields := map[string]*string{
+		"instance_id":         &res.InstanceID,
+		"instance_name":       &res.InstanceName,
+		"connection_endpoint": &res.InstanceEndpoint,
+	}
+
+	for key, field := range fields {
+		fullKey := fmt.Sprintf("%s_%s", kind, key)
+
+		val, ok := output[fullKey].(string)
+
+		if !ok {
+			return nil, fmt.Errorf("output %s not found for %s database", fullKey, kind)
+		}
+
+		*field = val
+	}
+
+	return res, nil
+}
+
+func createDatabase(config *config.Config, infra *models.Infra, operation *models.Operation, output map[string]interface{}) (*models.Database, error) {
+	// check for infra id being 0 as a safeguard so that all non-provisioned
+	// clusters are not matched by read
+	if infra.ID == 0 {
+		return nil, fmt.Errorf("infra id cannot be 0")
+	}
+
+	dbOutput, err := getDatabaseOutput(infra.Kind, output)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var database *models.Database
+	var isNotFound bool
+
+	database, err = config.Repo.Database().ReadDatabaseByInfraID(infra.ProjectID, infra.ID)
+
+	isNotFound = err != nil && errors.Is(err, gorm.ErrRecordNotFound)
+
+	if isNotFound {
+		database = &models.Database{
+			ProjectID: infra.ProjectID,
+			ClusterID: infra.ParentClusterID,
+			InfraID:   infra.ID,
+			Kind:      infra.Kind,
+			Status:    "Running",
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	database.InstanceID = dbOutput.InstanceID
+	database.InstanceEndpoint = dbOutput.InstanceEndpoint
+	database.InstanceName = dbOutput.InstanceName
+
+	// a restored database is running again once the operation completes
+	if operation.Type == operationRestore {
+		database.Status = "Running"
+
+		if err := clearRestoreSnapshot(config, operation); err != nil {
+			return nil, err
+		}
+	}
+
+	lastApplied := make(map[string]interface{})
+
+	err = json.Unmarshal(operation.LastApplied, &lastApplied)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the connection info of the database is written to an env group on every apply, as the
+	// endpoint may change when the database is updated or restored from a snapshot
+	err = createDatabaseEnvGroup(config, infra, database, output, lastApplied)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if isNotFound {
+		database, err = config.Repo.Database().CreateDatabase(database)
+	} else {
+		database, err = config.Repo.Database().UpdateDatabase(database)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	infra.DatabaseID = database.ID
+	infra, err = config.Repo.Infra().UpdateInfra(infra)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if operation.Type == operationSnapshot {
+		err = updateDatabaseSnapshotStatus(config, infra, operation, types.DatabaseSnapshotAvailable)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return database, nil
+}
+
+// updateDatabaseSnapshotStatus updates the status of the snapshot created by an operation
+func updateDatabaseSnapshotStatus(
+	config *config.Config,
+	infra *models.Infra,
+	operation *models.Operation,
+	status types.DatabaseSnapshotStatus,
+) error {
+	snapshot, err := config.Repo.DatabaseSnapshot().ReadDatabaseSnapshotByOperationUID(infra.ID, operation.UID)
+
+	if err != nil {
+		return err
+	}
+
+	snapshot.Status = status
+
+	_, err = config.Repo.DatabaseSnapshot().UpdateDatabaseSnapshot(snapshot)
+
+	return err
+}
+
+// clearRestoreSnapshot removes the snapshot to restore from the last applied values of a
+// restore operation once it has finished, since the values of the last operation are the base
+// of the next operation, which would otherwise restore the database again
+func clearRestoreSnapshot(config *config.Config, operation *models.Operation) error {
+	lastApplied := make(map[string]interface{})
+
+	if err := json.Unmarshal(operation.LastApplied, &lastApplied); err != nil {
+		return err
+	}
+
+	if _, ok := lastApplied[restoreSnapshotValue]; !ok {
+		return nil
+	}
+
+	delete(lastApplied, restoreSnapshotValue)
+
+	lastAppliedJSON, err := json.Marshal(lastApplied)
+
+	if err != nil {
+		return err
+	}
+
+	operation.LastApplied = lastAppliedJSON
+
+	_, err = config.Repo.Infra().UpdateOperation(operation)
+
+	return err
+}
+
+// reportDatabaseError updates the snapshot or the database affected by a failed operation
+func reportDatabaseError(config *config.Config, infra *models.Infra, operation *models.Operation) error {
+	switch operation.Type {
+	case operationSnapshot:
+		return updateDatabaseSnapshotStatus(config, infra, operation, types.DatabaseSnapshotFailed)
+	case operationRestore:
+		if err := clearRestoreSnapshot(config, operation); err != nil {
+			return err
+		}
+
+		database, err := config.Repo.Database().ReadDatabaseByInfraID(infra.ProjectID, infra.ID)
+
+		if err != nil {
+			return err
+		}
+
+		database.Status = "errored"
+
+		_, err = config.Repo.Database().UpdateDatabase(database)
+
+		return err
+	}
+
+	return nil
+}
+
+// getDatabaseCredentials returns the user and the password of a database. Providers which
+// generate the credentials output them, otherwise the credentials are the ones the database
+// was created with.
+func getDatabaseCredentials(output, lastApplied map[string]interface{}) (string, string, error) {
+	res := make([]string, 0)
+
+	for _, key := range []string{"db_user", "db_passwd"} {
+		if val, ok := output[key].(string); ok && val != "" {
+			res = append(res, val)
+		} else if val, ok := lastApplied[key].(string); ok && val != "" {
+			res = append(res, val)
+		} else {
+			return "", "", fmt.Errorf("%s not found for database", key)
+		}
+	}
+
+	return res[0], res[1], nil
+}
+
+func getDatabaseAgent(config *config.Config, infra *models.Infra) (*kubernetes.Agent, error) {
+	cluster, err := config.Repo.Cluster().ReadCluster(infra.ProjectID, infra.ParentClusterID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ooc := &kubernetes.OutOfClusterConfig{
+		Repo:              config.Repo,
+		DigitalOceanOAuth: config.DOConf,
+		Cluster:           cluster,
+	}
+
+	agent, err := kubernetes.GetAgentOutOfClusterConfig(ooc)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get agent: %s", err.Error())
+	}
+
+	return agent, nil
+}
+
+// createDatabaseEnvGroup writes the connection info of a database to an env group in the
+// default namespace, and stores the name of the env group on the database
+func createDatabaseEnvGroup(
+	config *config.Config,
+	infra *models.Infra,
+	database *models.Database,
+	output, lastApplied map[string]interface{},
+) error {
+	dbName, ok := lastApplied["db_name"].(string)
+
+	if !ok {
+		return fmt.Errorf("db_name not found for database")
+	}
+
+	user, password, err := getDatabaseCredentials(output, lastApplied)
+
+	if err != nil {
+		return err
+	}
+
+	agent, err := getDatabaseAgent(config, infra)
+
+	if err != nil {
+		return err
+	}
+
+	// split the instance endpoint on the port
+	port := defaultDatabasePorts[database.GetKind()]
+	host := database.InstanceEndpoint
+
+	if strArr := strings.Split(database.InstanceEndpoint, ":"); len(strArr) == 2 {
+		host = strArr[0]
+		port = strArr[1]
+	}
+
+	database.EnvGroupName = fmt.Sprintf("%s-credentials-%s", database.GetKind(), dbName)
+	database.EnvGroupNamespace = "default"
+
+	_, err = envgroup.CreateEnvGroup(agent, types.ConfigMapInput{
+		Name:      database.EnvGroupName,
+		Namespace: database.EnvGroupNamespace,
+		Variables: map[string]string{},
+		SecretVariables: map[string]string{
+			"PGPORT":     port,
+			"PGHOST":     host,
+			"PGPASSWORD": password,
+			"PGUSER":     user,
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to create %s env group: %s", database.GetKind(), err.Error())
+	}
+
+	return nil
+}
+
+// deleteDatabaseEnvGroup deletes the env group of a database. Databases which were created
+// before the name of the env group was stored use the legacy name of RDS env groups, which is
+// derived from the db_name of the operation.
+func deleteDatabaseEnvGroup(
+	config *config.Config,
+	infra *models.Infra,
+	operation *models.Operation,
+	database *models.Database,
+) error {
+	name, namespace := database.EnvGroupName, database.EnvGroupNamespace
+
+	if name == "" {
+		if len(operation.LastApplied) == 0 {
+			return nil
+		}
+
+		lastApplied := make(map[string]interface{})
+
+		if err := json.Unmarshal(operation.LastApplied, &lastApplied); err != nil {
+			return fmt.Errorf("failed to unmarshal last applied values: %s", err.Error())
+		}
+
+		dbName, ok := lastApplied["db_name"].(string)
+
+		if !ok || dbName == "" {
+			return nil
+		}
+
+		name, namespace = fmt.Sprintf("rds-credentials-%s", dbName), "default"
+	}
+
+	agent, err := getDatabaseAgent(config, infra)
+
+	if err != nil {
+		return err
+	}
+
+	err = envgroup.DeleteEnvGroup(agent, name, namespace)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete %s env group: %s", database.GetKind(), err.Error())
+	}
+
+	return nil
+}
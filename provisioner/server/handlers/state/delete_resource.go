@@ -72,7 +72,7 @@ func (c *DeleteResourceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		_, err = deleteRegistry(c.Config, infra, operation)
 	case types.InfraEKS, types.InfraDOKS, types.InfraGKE, types.InfraAKS:
 		_, err = deleteCluster(c.Config, infra, operation)
-	case types.InfraRDS:
+	case types.InfraRDS, types.InfraCloudSQL, types.InfraDOPG:
 		_, err = deleteDatabase(c.Config, infra, operation)
 	}
 
@@ -127,7 +127,11 @@ func deleteDatabase(config *config.Config, infra *models.Infra, operation *model
 		return nil, err
 	}
 
-	// TODO: add delete env group here
+	err = deleteDatabaseEnvGroup(config, infra, operation, database)
+
+	if err != nil {
+		return nil, err
+	}
 
 	return database, nil
 }
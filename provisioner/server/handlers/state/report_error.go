@@ -82,6 +82,14 @@ func (c *ReportErrorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		fmt.Errorf(req.Error),
 	), false)
 
+	if types.IsDatabaseKind(infra.Kind) {
+		err = reportDatabaseError(c.Config, infra, operation)
+
+		if err != nil {
+			apierrors.HandleAPIError(c.Config.Logger, c.Config.Alerter, w, r, apierrors.NewErrInternal(err), false)
+		}
+	}
+
 	switch infra.Kind {
 	case types.InfraEKS, types.InfraDOKS, types.InfraGKE:
 		var cluster *models.Cluster